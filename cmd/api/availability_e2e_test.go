@@ -0,0 +1,125 @@
+package main_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestAvailabilityE2E(t *testing.T) {
+	genre := "availability-" + uuid.NewString()[:8]
+	contentID := uuid.NewString()
+	videoID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/upload/videos/licensed.mp4", SizeInKb: 1, Duration: 60},
+		&postgres.ContentModel{ID: contentID, Title: "Licensed Movie", ContentType: "MOVIE"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: contentID, VideoID: videoID},
+		&postgres.ContentGenreModel{ContentID: contentID, Genre: genre},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	editorToken := registerEditor(t)
+	viewerToken := registerSubscriber(t, "BASIC")
+	availabilityPath := "/contents/" + contentID + "/availability"
+
+	// getFrom requests path as a subscribed viewer in country, returning
+	// the status code and the number of listed items.
+	getFrom := func(t *testing.T, path, country string) (int, int) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+path, nil)
+		req.Header.Set("Authorization", "Bearer "+viewerToken)
+		if country != "" {
+			req.Header.Set("X-Country-Code", country)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var respBody struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&respBody)
+		return resp.StatusCode, len(respBody.Items)
+	}
+	listPath := "/contents?genre=" + genre
+	streamPath := "/videos/" + videoID + "/stream"
+
+	t.Run("should reject invalid country codes", func(t *testing.T) {
+		body := map[string]any{"allowed_countries": []string{"Brazil"}}
+		if status := doJSON(t, http.MethodPut, availabilityPath, editorToken, body, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+
+	t.Run("should only list and stream the content in allowed countries", func(t *testing.T) {
+		body := map[string]any{"allowed_countries": []string{"br", "PT"}}
+		if status := doJSON(t, http.MethodPut, availabilityPath, editorToken, body, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		if _, count := getFrom(t, listPath, "BR"); count != 1 {
+			t.Errorf("expected the content to be listed in BR, but got %d items", count)
+		}
+		for _, country := range []string{"US", ""} {
+			if _, count := getFrom(t, listPath, country); count != 0 {
+				t.Errorf("expected the content to be hidden from %q, but got %d items", country, count)
+			}
+		}
+		if status, _ := getFrom(t, streamPath, "US"); status != http.StatusUnavailableForLegalReasons {
+			t.Errorf("expected status code 451 when streaming from US, but got %d", status)
+		}
+	})
+
+	t.Run("should deny listed countries", func(t *testing.T) {
+		body := map[string]any{"denied_countries": []string{"US"}}
+		if status := doJSON(t, http.MethodPut, availabilityPath, editorToken, body, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		if _, count := getFrom(t, listPath, "US"); count != 0 {
+			t.Errorf("expected the content to be hidden from US, but got %d items", count)
+		}
+		if _, count := getFrom(t, listPath, "BR"); count != 1 {
+			t.Errorf("expected the content to be listed in BR, but got %d items", count)
+		}
+	})
+
+	t.Run("should hide the content outside its window", func(t *testing.T) {
+		body := map[string]any{"starts_at": time.Now().Add(time.Hour).UTC()}
+		if status := doJSON(t, http.MethodPut, availabilityPath, editorToken, body, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		if _, count := getFrom(t, listPath, "BR"); count != 0 {
+			t.Errorf("expected the content to be hidden before its window, but got %d items", count)
+		}
+		if status, _ := getFrom(t, streamPath, "BR"); status != http.StatusNotFound {
+			t.Errorf("expected status code 404 when streaming before the window, but got %d", status)
+		}
+	})
+
+	t.Run("should make the content available everywhere once cleared", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, availabilityPath, editorToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if _, count := getFrom(t, listPath, "US"); count != 1 {
+			t.Errorf("expected the content to be listed again, but got %d items", count)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package main_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestBandwidthShapingE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(destVideoPath) })
+
+	fileStat, _ := os.Stat(destVideoPath)
+
+	// A long duration keeps the bitrate, and with it the burst allowance,
+	// negligible next to the file.
+	if err := db.Create(&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 3600, Height: 720}).Error; err != nil {
+		t.Fatalf("Failed to seed video in test database: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	token := registerSubscriber(t, "BASIC")
+
+	// download reads the whole stream and returns how long it took.
+	download := func(t *testing.T, token string) time.Duration {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		start := time.Now()
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		read, _ := io.Copy(io.Discard, resp.Body)
+		if resp.StatusCode != http.StatusOK || read != fileStat.Size() {
+			t.Fatalf("expected %d bytes with status 200, but got %d bytes and status %d", fileStat.Size(), read, resp.StatusCode)
+		}
+		return time.Since(start)
+	}
+
+	// The test API allows sessions 1 MiB/s.
+	minimum := time.Duration(float64(fileStat.Size()-(64<<10)) / (1 << 20) * float64(time.Second))
+
+	t.Run("should shape streams to the session budget", func(t *testing.T) {
+		if elapsed := download(t, token); elapsed < minimum {
+			t.Errorf("expected the download to take at least %s, but it took %s", minimum, elapsed)
+		}
+	})
+
+	t.Run("should refuse anonymous streams instead of serving them unshaped", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/videos/"+videoID+"/stream", "", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401, but got %d", status)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestCollectionsE2E(t *testing.T) {
+	slug := "oscar-winners-" + uuid.NewString()[:8]
+	firstContentID, secondContentID, matureContentID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	contentIDs := []string{firstContentID, secondContentID, matureContentID}
+
+	seeds := []any{
+		&postgres.ContentModel{ID: firstContentID, Title: "Winner One", ContentType: "MOVIE"},
+		&postgres.ContentModel{ID: secondContentID, Title: "Winner Two", ContentType: "MOVIE"},
+		&postgres.ContentModel{ID: matureContentID, Title: "Mature Winner", ContentType: "MOVIE", MaturityLevel: 18},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Delete(&postgres.CollectionModel{}, "slug = ?", slug)
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", contentIDs)
+	})
+
+	editorToken := registerEditor(t)
+	viewerToken := registerAndLogin(t)
+
+	type collection struct {
+		Slug       string   `json:"slug"`
+		ArtworkURL string   `json:"artwork_url"`
+		ContentIDs []string `json:"content_ids"`
+		Published  bool     `json:"published"`
+		Items      []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+	}
+
+	future := time.Now().Add(time.Hour).UTC()
+	body := map[string]any{
+		"slug":         slug,
+		"title":        "Oscar winners",
+		"content_ids":  []string{secondContentID, firstContentID, matureContentID},
+		"publish_from": future,
+	}
+
+	t.Run("should only let editors create collections", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPost, "/collections", "", body, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for anonymous requests, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPost, "/collections", viewerToken, body, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 for viewers, but got %d", status)
+		}
+	})
+
+	t.Run("should create a scheduled collection", func(t *testing.T) {
+		var respBody collection
+		if status := doJSON(t, http.MethodPost, "/collections", editorToken, body, &respBody); status != http.StatusCreated {
+			t.Fatalf("expected status code 201, but got %d", status)
+		}
+		if respBody.Published || len(respBody.ContentIDs) != 3 || respBody.ContentIDs[0] != secondContentID {
+			t.Errorf("expected an unpublished collection keeping the given order, but got %+v", respBody)
+		}
+
+		if status := doJSON(t, http.MethodPost, "/collections", editorToken, body, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409 for a duplicated slug, but got %d", status)
+		}
+	})
+
+	t.Run("should hide collections outside their publish window", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/collections/"+slug, "", nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should reject unknown contents", func(t *testing.T) {
+		update := map[string]any{"title": "Oscar winners", "content_ids": []string{uuid.NewString()}}
+		if status := doJSON(t, http.MethodPut, "/collections/"+slug, editorToken, update, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should publish the collection and serve its contents in order", func(t *testing.T) {
+		update := map[string]any{"title": "Oscar winners", "content_ids": []string{secondContentID, firstContentID, matureContentID}}
+		if status := doJSON(t, http.MethodPut, "/collections/"+slug, editorToken, update, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		var respBody collection
+		if status := doJSON(t, http.MethodGet, "/collections/"+slug, "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 3 || respBody.Items[0].ID != secondContentID || respBody.Items[1].ID != firstContentID {
+			t.Errorf("expected the contents in collection order, but got %+v", respBody.Items)
+		}
+	})
+
+	t.Run("should leave out contents above the profile maturity level", func(t *testing.T) {
+		token := registerAndLogin(t)
+		token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Kid", "kids": true}))
+
+		var respBody collection
+		if status := doJSON(t, http.MethodGet, "/collections/"+slug, token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		for _, item := range respBody.Items {
+			if item.ID == matureContentID {
+				t.Errorf("expected the mature content to be left out for a kids profile")
+			}
+		}
+	})
+
+	t.Run("should upload artwork", func(t *testing.T) {
+		form := &bytes.Buffer{}
+		writer := multipart.NewWriter(form)
+		addFileToMultipart(t, writer, "artwork", filepath.Join("..", "..", "testdata", "sample.jpg"))
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Failed to close multipart writer: %v", err)
+		}
+
+		req, _ := http.NewRequest(http.MethodPut, baseAPIURL+"/collections/"+slug+"/artwork", form)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+editorToken)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var respBody collection
+		json.NewDecoder(resp.Body).Decode(&respBody)
+		if resp.StatusCode != http.StatusOK || respBody.ArtworkURL == "" {
+			t.Errorf("expected status code 200 with an artwork url, but got %d and %+v", resp.StatusCode, respBody)
+		}
+	})
+
+	t.Run("should delete the collection", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/collections/"+slug, editorToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodGet, "/collections/"+slug, "", nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404 after deleting, but got %d", status)
+		}
+	})
+}
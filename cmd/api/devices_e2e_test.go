@@ -0,0 +1,214 @@
+package main_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestDevicesE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	contentID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(destVideoPath) })
+
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30, Height: 720},
+		&postgres.ContentModel{ID: contentID, Title: "Devices Movie", ContentType: "MOVIE"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: contentID, VideoID: videoID},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	credentials := map[string]any{
+		"email":    fmt.Sprintf("%s@fakeflix.test", uuid.NewString()),
+		"password": "super-secret",
+	}
+	var account struct {
+		ID string `json:"id"`
+	}
+	if status := doJSON(t, http.MethodPost, "/accounts", "", credentials, &account); status != http.StatusCreated {
+		t.Fatalf("Expected status code 201 when registering, but got %d", status)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.AccountModel{}, "id = ?", account.ID)
+	})
+
+	type tokens struct {
+		AccessToken  string `json:"access_token"`
+		DeviceID     string `json:"device_id"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	login := func(t *testing.T, deviceType, deviceName string, out any) int {
+		t.Helper()
+		body := map[string]any{"device_type": deviceType, "device_name": deviceName}
+		for key, value := range credentials {
+			body[key] = value
+		}
+		return doJSON(t, http.MethodPost, "/auth/login", "", body, out)
+	}
+	type deviceList struct {
+		Items []struct {
+			ID      string `json:"id"`
+			Type    string `json:"type"`
+			Name    string `json:"name"`
+			Current bool   `json:"current"`
+		} `json:"items"`
+	}
+
+	var laptop, tv tokens
+	var streamURL string
+
+	t.Run("should register a device on login", func(t *testing.T) {
+		if status := login(t, "WEB", "Laptop", &laptop); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if laptop.DeviceID == "" || laptop.RefreshToken == "" {
+			t.Fatalf("expected a device and a refresh token, but got %+v", laptop)
+		}
+		subscribe(t, laptop.AccessToken, "BASIC")
+
+		if status := login(t, "TV", "Living room", &tv); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		var respBody deviceList
+		if status := doJSON(t, http.MethodGet, "/me/devices", laptop.AccessToken, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 2 || respBody.Items[0].ID != laptop.DeviceID || !respBody.Items[0].Current {
+			t.Fatalf("expected the laptop first and marked current, but got %+v", respBody.Items)
+		}
+		if respBody.Items[1].Type != "TV" || respBody.Items[1].Name != "Living room" || respBody.Items[1].Current {
+			t.Errorf("expected the living room tv second, but got %+v", respBody.Items[1])
+		}
+	})
+
+	t.Run("should refuse devices above the plan limit", func(t *testing.T) {
+		if status := login(t, "MOBILE", "Phone", nil); status != http.StatusTooManyRequests {
+			t.Errorf("expected status code 429, but got %d", status)
+		}
+	})
+
+	t.Run("should rotate refresh tokens", func(t *testing.T) {
+		var refreshed tokens
+		if status := doJSON(t, http.MethodPost, "/auth/refresh", "", map[string]any{"refresh_token": tv.RefreshToken}, &refreshed); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if refreshed.DeviceID != tv.DeviceID || refreshed.RefreshToken == tv.RefreshToken {
+			t.Errorf("expected a new refresh token for the tv, but got %+v", refreshed)
+		}
+		if status := doJSON(t, http.MethodPost, "/auth/refresh", "", map[string]any{"refresh_token": tv.RefreshToken}, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 reusing a refresh token, but got %d", status)
+		}
+		tv = refreshed
+	})
+
+	t.Run("should bind playback sessions to the device", func(t *testing.T) {
+		var playbackInfo struct {
+			Manifests []struct {
+				URL string `json:"url"`
+			} `json:"manifests"`
+		}
+		if status := doJSON(t, http.MethodGet, "/contents/"+contentID+"/playback", tv.AccessToken, nil, &playbackInfo); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(playbackInfo.Manifests) == 0 {
+			t.Fatalf("expected a manifest, but got none")
+		}
+		streamURL = playbackInfo.Manifests[0].URL
+		if status := doJSON(t, http.MethodGet, streamURL, "", nil, nil); status != http.StatusOK {
+			t.Fatalf("expected the signed stream url to work, but got %d", status)
+		}
+
+		var respBody struct {
+			Items []struct {
+				DeviceID string `json:"device_id"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/sessions", laptop.AccessToken, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 1 || respBody.Items[0].DeviceID != tv.DeviceID {
+			t.Errorf("expected one session on the tv, but got %+v", respBody.Items)
+		}
+	})
+
+	var licenseID string
+
+	t.Run("should issue downloads to the device", func(t *testing.T) {
+		var license struct {
+			ID       string `json:"id"`
+			DeviceID string `json:"device_id"`
+		}
+		if status := doJSON(t, http.MethodPost, "/me/downloads", tv.AccessToken, map[string]any{"video_id": videoID}, &license); status != http.StatusCreated {
+			t.Fatalf("expected status code 201, but got %d", status)
+		}
+		if license.DeviceID != tv.DeviceID {
+			t.Errorf("expected a license on the tv, but got %+v", license)
+		}
+		licenseID = license.ID
+	})
+
+	t.Run("should sign a device out remotely", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/me/devices/"+tv.DeviceID, laptop.AccessToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+
+		if status := doJSON(t, http.MethodGet, "/me/devices", tv.AccessToken, nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for the tv access token, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPost, "/auth/refresh", "", map[string]any{"refresh_token": tv.RefreshToken}, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for the tv refresh token, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodGet, streamURL, "", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for a url signed for the tv, but got %d", status)
+		}
+
+		var sessions struct {
+			Items []any `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/sessions", laptop.AccessToken, nil, &sessions); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(sessions.Items) != 0 {
+			t.Errorf("expected the tv session to end, but got %d sessions", len(sessions.Items))
+		}
+
+		var license postgres.DownloadLicenseModel
+		if err := db.First(&license, "id = ?", licenseID).Error; err != nil {
+			t.Fatalf("Failed to load the tv download license: %v", err)
+		}
+		if license.RevokedAt == nil || license.RevokeReason == nil || *license.RevokeReason != "DEVICE_SIGNED_OUT" {
+			t.Errorf("expected the tv download to be revoked on sign out, but got %+v", license)
+		}
+
+		if status := login(t, "MOBILE", "Phone", nil); status != http.StatusOK {
+			t.Errorf("expected the freed slot to take a new device, but got %d", status)
+		}
+	})
+
+	t.Run("should not sign out devices of other accounts", func(t *testing.T) {
+		otherToken := registerAndLogin(t)
+		if status := doJSON(t, http.MethodDelete, "/me/devices/"+laptop.DeviceID, otherToken, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package main_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestDownloadsE2E(t *testing.T) {
+	hdVideoID, otherVideoID, fullHDVideoID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	for id, height := range map[string]int{hdVideoID: 720, otherVideoID: 720, fullHDVideoID: 1080} {
+		videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", id))
+		destVideoPath := filepath.Join("..", "..", videoURLPath)
+		os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+		if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+			t.Fatalf("Failed to copy test video file: %v", err)
+		}
+		t.Cleanup(func() { os.Remove(destVideoPath) })
+
+		if err := db.Create(&postgres.VideoModel{ID: id, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 100, Height: height}).Error; err != nil {
+			t.Fatalf("Failed to seed video in test database: %v", err)
+		}
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{hdVideoID, otherVideoID, fullHDVideoID})
+	})
+
+	accountID, token := registerAccount(t)
+	subscribe(t, token, "BASIC")
+
+	type license struct {
+		ID          string    `json:"id"`
+		VideoID     string    `json:"video_id"`
+		DeviceID    string    `json:"device_id"`
+		Height      int       `json:"height"`
+		ExpiresAt   time.Time `json:"expires_at"`
+		DownloadURL string    `json:"download_url"`
+	}
+	type licenseList struct {
+		Items        []license `json:"items"`
+		MaxDownloads int       `json:"max_downloads"`
+	}
+	issue := func(t *testing.T, videoID string, out any) int {
+		t.Helper()
+		return doJSON(t, http.MethodPost, "/me/downloads", token, map[string]any{"video_id": videoID}, out)
+	}
+
+	var first license
+
+	t.Run("should issue a license with a signed download url", func(t *testing.T) {
+		if status := issue(t, hdVideoID, &first); status != http.StatusCreated {
+			t.Fatalf("expected status code 201, but got %d", status)
+		}
+		if first.VideoID != hdVideoID || first.Height != 720 || first.ExpiresAt.Before(time.Now()) {
+			t.Errorf("unexpected license: %+v", first)
+		}
+		if err := db.First(&postgres.DeviceModel{}, "id = ?", first.DeviceID).Error; err != nil {
+			t.Errorf("expected the license on the signed in device, but got %q", first.DeviceID)
+		}
+
+		resp, err := http.Get(baseAPIURL + first.DownloadURL)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected the signed download url to work without a token, but got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("should refuse downloads above the plan cap", func(t *testing.T) {
+		if status := issue(t, otherVideoID, nil); status != http.StatusTooManyRequests {
+			t.Errorf("expected status code 429, but got %d", status)
+		}
+	})
+
+	t.Run("should renew an active license", func(t *testing.T) {
+		var respBody license
+		if status := doJSON(t, http.MethodPut, "/me/downloads/"+first.ID+"/renew", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.ExpiresAt.Before(first.ExpiresAt) {
+			t.Errorf("expected the expiry to move forward, but got %s after %s", respBody.ExpiresAt, first.ExpiresAt)
+		}
+	})
+
+	t.Run("should free the slot once a download is returned", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/me/downloads/"+first.ID, token, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPut, "/me/downloads/"+first.ID+"/renew", token, nil, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409 renewing a returned license, but got %d", status)
+		}
+		if status := issue(t, otherVideoID, nil); status != http.StatusCreated {
+			t.Errorf("expected status code 201, but got %d", status)
+		}
+	})
+
+	t.Run("should revoke downloads the new plan does not cover", func(t *testing.T) {
+		subscribe(t, token, "STANDARD")
+		var fullHD license
+		if status := issue(t, fullHDVideoID, &fullHD); status != http.StatusCreated {
+			t.Fatalf("expected status code 201, but got %d", status)
+		}
+		if fullHD.Height != 1080 {
+			t.Errorf("expected a 1080p download on STANDARD, but got %dp", fullHD.Height)
+		}
+
+		subscribe(t, token, "BASIC")
+		var respBody licenseList
+		if status := doJSON(t, http.MethodGet, "/me/downloads", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 1 || respBody.Items[0].VideoID != otherVideoID || respBody.MaxDownloads != 1 {
+			t.Errorf("expected only the 720p download to remain, but got %+v", respBody)
+		}
+		if status := doJSON(t, http.MethodPut, "/me/downloads/"+fullHD.ID+"/renew", token, nil, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409 renewing a revoked license, but got %d", status)
+		}
+	})
+
+	t.Run("should revoke every download once the subscription ends", func(t *testing.T) {
+		ended := time.Now().Add(-time.Hour)
+		if err := db.Model(&postgres.SubscriptionModel{}).Where("account_id = ?", accountID).Update("current_period_end", ended).Error; err != nil {
+			t.Fatalf("Failed to end the subscription period: %v", err)
+		}
+
+		if status := doJSON(t, http.MethodGet, "/me/downloads", token, nil, nil); status != http.StatusPaymentRequired {
+			t.Fatalf("expected status code 402, but got %d", status)
+		}
+
+		var revoked int64
+		db.Model(&postgres.DownloadLicenseModel{}).Where("account_id = ? AND revoke_reason = ?", accountID, "SUBSCRIPTION_ENDED").Count(&revoked)
+		if revoked != 1 {
+			t.Errorf("expected the remaining download to be revoked, but %d were", revoked)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestExtrasE2E(t *testing.T) {
+	contentID := uuid.NewString()
+	videoID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(destVideoPath) })
+
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30, Height: 720},
+		&postgres.ContentModel{ID: contentID, Title: "Movie With Extras", Description: "Has a trailer", ContentType: "MOVIE"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: contentID, VideoID: videoID},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		var extras []postgres.ContentExtraModel
+		db.Preload("Video").Find(&extras, "content_id = ?", contentID)
+		for _, e := range extras {
+			os.Remove(filepath.Join("..", "..", e.Video.URL))
+			db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", e.VideoID)
+		}
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	editorToken := registerEditor(t)
+	extrasPath := "/contents/" + contentID + "/extras"
+
+	type extraBody struct {
+		ID        string `json:"id"`
+		Kind      string `json:"kind"`
+		Title     string `json:"title"`
+		StreamURL string `json:"stream_url"`
+	}
+	listExtras := func(t *testing.T) []extraBody {
+		t.Helper()
+		var respBody struct {
+			Items []extraBody `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, extrasPath, "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		return respBody.Items
+	}
+
+	var trailer, preview extraBody
+
+	t.Run("should attach an uploaded trailer", func(t *testing.T) {
+		form := &bytes.Buffer{}
+		writer := multipart.NewWriter(form)
+		writer.WriteField("title", "Official Trailer")
+		addFileToMultipart(t, writer, "video", filepath.Join("..", "..", "testdata", "sample.mp4"))
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Failed to close multipart writer: %v", err)
+		}
+
+		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/contents/"+contentID+"/trailers", form)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+editorToken)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		json.NewDecoder(resp.Body).Decode(&trailer)
+		if resp.StatusCode != http.StatusCreated || trailer.Kind != "TRAILER" || trailer.Title != "Official Trailer" {
+			t.Errorf("expected status code 201 with a trailer, but got %d and %+v", resp.StatusCode, trailer)
+		}
+
+		// Deleting the trailer below leaves its file behind.
+		var model postgres.ContentExtraModel
+		if err := db.Preload("Video").First(&model, "id = ?", trailer.ID).Error; err == nil {
+			trailerPath := filepath.Join("..", "..", model.Video.URL)
+			t.Cleanup(func() { os.Remove(trailerPath) })
+		}
+	})
+
+	t.Run("should cut a preview from the movie", func(t *testing.T) {
+		body := map[string]any{"offset_seconds": 0, "length_seconds": 5}
+		if status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/preview", editorToken, body, &preview); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if preview.Kind != "PREVIEW" {
+			t.Errorf("expected a preview, but got %+v", preview)
+		}
+	})
+
+	t.Run("should replace the preview and detect an offset", func(t *testing.T) {
+		var replaced extraBody
+		if status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/preview", editorToken, nil, &replaced); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		items := listExtras(t)
+		if len(items) != 2 || items[0].ID != replaced.ID || items[1].ID != trailer.ID {
+			t.Errorf("expected the new preview and the trailer, but got %+v", items)
+		}
+		preview = replaced
+	})
+
+	t.Run("should reject offsets past the end", func(t *testing.T) {
+		body := map[string]any{"offset_seconds": 3600}
+		if status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/preview", editorToken, body, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+
+	t.Run("should stream extras without a plan or a session", func(t *testing.T) {
+		token := registerAndLogin(t)
+		for _, e := range []extraBody{trailer, preview} {
+			if status := doJSON(t, http.MethodGet, e.StreamURL, token, nil, nil); status != http.StatusOK {
+				t.Errorf("expected status code 200 for %s, but got %d", e.Kind, status)
+			}
+		}
+		if status := doJSON(t, http.MethodGet, "/videos/"+videoID+"/stream", token, nil, nil); status != http.StatusPaymentRequired {
+			t.Errorf("expected status code 402 for the movie itself, but got %d", status)
+		}
+	})
+
+	t.Run("should deliver the key of a packaged trailer without a plan or a session", func(t *testing.T) {
+		var model postgres.ContentExtraModel
+		if err := db.First(&model, "id = ?", trailer.ID).Error; err != nil {
+			t.Fatalf("Failed to load the trailer: %v", err)
+		}
+		hlsPath := "/videos/" + model.VideoID + "/hls"
+		t.Cleanup(func() { os.RemoveAll(filepath.Join("..", "..", "upload", "hls", model.VideoID)) })
+
+		if status := doJSON(t, http.MethodPut, hlsPath, editorToken, nil, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+hlsPath+"/index.m3u8", nil)
+		req.Header.Set("Authorization", "Bearer "+registerAndLogin(t))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		playlist, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		match := keyURIPattern.FindStringSubmatch(string(playlist))
+		if resp.StatusCode != http.StatusOK || match == nil {
+			t.Fatalf("expected a playlist with a key URI and status 200, but got %d:\n%s", resp.StatusCode, playlist)
+		}
+
+		resp, err = http.Get(baseAPIURL + match[1])
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+		key, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || len(key) != 16 {
+			t.Errorf("expected a 16 byte key with status 200, but got %d bytes and status %d", len(key), resp.StatusCode)
+		}
+	})
+
+	t.Run("should hide extras of unpublished contents", func(t *testing.T) {
+		db.Model(&postgres.ContentModel{}).Where("id = ?", contentID).Update("status", "DRAFT")
+		defer db.Model(&postgres.ContentModel{}).Where("id = ?", contentID).Update("status", "PUBLISHED")
+
+		if status := doJSON(t, http.MethodGet, trailer.StreamURL, registerAndLogin(t), nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should delete an extra", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, extrasPath+"/"+trailer.ID, editorToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if items := listExtras(t); len(items) != 1 || items[0].Kind != "PREVIEW" {
+			t.Errorf("expected only the preview, but got %+v", items)
+		}
+		if status := doJSON(t, http.MethodDelete, extrasPath+"/"+trailer.ID, editorToken, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+}
@@ -1,12 +1,17 @@
 package main_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
 	"mime/multipart"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
 	"gorm.io/gorm"
 )
@@ -85,3 +90,127 @@ func copyFile(src, dst string) error {
 	_, err = io.Copy(destFile, sourceFile)
 	return err
 }
+
+// registerAndLogin creates a throwaway account and returns a bearer token
+// for it. The account and its profiles are removed when the test ends.
+func registerAndLogin(t *testing.T) string {
+	t.Helper()
+
+	_, token := registerAccount(t)
+	return token
+}
+
+// registerEditor is registerAndLogin for an account with the editor role.
+func registerEditor(t *testing.T) string {
+	t.Helper()
+
+	accountID, token := registerAccount(t)
+	if err := db.Model(&postgres.AccountModel{}).Where("id = ?", accountID).Update("role", "EDITOR").Error; err != nil {
+		t.Fatalf("Failed to promote account to editor: %v", err)
+	}
+	return token
+}
+
+func registerAccount(t *testing.T) (accountID, token string) {
+	t.Helper()
+
+	credentials := map[string]string{
+		"email":    fmt.Sprintf("%s@fakeflix.test", uuid.NewString()),
+		"password": "super-secret",
+	}
+
+	var account struct {
+		ID string `json:"id"`
+	}
+	if status := doJSON(t, http.MethodPost, "/accounts", "", credentials, &account); status != http.StatusCreated {
+		t.Fatalf("Expected status code 201 when registering, but got %d", status)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.AccountModel{}, "id = ?", account.ID)
+	})
+
+	var login struct {
+		AccessToken string `json:"access_token"`
+	}
+	if status := doJSON(t, http.MethodPost, "/auth/login", "", credentials, &login); status != http.StatusOK {
+		t.Fatalf("Expected status code 200 when logging in, but got %d", status)
+	}
+	return account.ID, login.AccessToken
+}
+
+// registerSubscriber is registerAndLogin for an account on the given plan.
+func registerSubscriber(t *testing.T, plan string) string {
+	t.Helper()
+
+	token := registerAndLogin(t)
+	subscribe(t, token, plan)
+	return token
+}
+
+// subscribe puts the account behind token on the given plan.
+func subscribe(t *testing.T, token, plan string) {
+	t.Helper()
+
+	if status := doJSON(t, http.MethodPut, "/me/subscription", token, map[string]any{"plan": plan}, nil); status != http.StatusOK {
+		t.Fatalf("Expected status code 200 when subscribing, but got %d", status)
+	}
+}
+
+// createProfile creates a profile under the account behind token and
+// returns its ID.
+func createProfile(t *testing.T, token string, body map[string]any) string {
+	t.Helper()
+
+	var profile struct {
+		ID string `json:"id"`
+	}
+	if status := doJSON(t, http.MethodPost, "/me/profiles", token, body, &profile); status != http.StatusCreated {
+		t.Fatalf("Expected status code 201 when creating a profile, but got %d", status)
+	}
+	return profile.ID
+}
+
+// selectProfile returns a token scoped to the given profile of the account.
+func selectProfile(t *testing.T, token, profileID string) string {
+	t.Helper()
+
+	var selected struct {
+		AccessToken string `json:"access_token"`
+	}
+	if status := doJSON(t, http.MethodPost, "/me/profiles/"+profileID+"/select", token, nil, &selected); status != http.StatusOK {
+		t.Fatalf("Expected status code 200 when selecting a profile, but got %d", status)
+	}
+	return selected.AccessToken
+}
+
+// doJSON sends body as JSON to the API and decodes the response into out,
+// returning the response status code.
+func doJSON(t *testing.T, method, path, token string, body, out any) int {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("Failed to marshal request body: %v", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, _ := http.NewRequest(method, baseAPIURL+path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to execute request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		json.NewDecoder(resp.Body).Decode(out)
+	}
+	return resp.StatusCode
+}
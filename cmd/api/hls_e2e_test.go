@@ -0,0 +1,231 @@
+package main_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestHLSE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(destVideoPath)
+		os.RemoveAll(filepath.Join("..", "..", "upload", "hls", videoID))
+	})
+
+	if err := db.Create(&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30, Height: 720}).Error; err != nil {
+		t.Fatalf("Failed to seed video in test database: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	accountID, token := registerAccount(t)
+	subscribe(t, token, "BASIC")
+	hlsPath := "/videos/" + videoID + "/hls"
+
+	// get requests path from the API and returns the status code, body and
+	// playback session header of the response.
+	get := func(t *testing.T, path, token string) (int, []byte, string) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, body, resp.Header.Get("X-Playback-Session")
+	}
+
+	t.Run("should not serve videos that were never packaged", func(t *testing.T) {
+		if status, _, _ := get(t, hlsPath+"/index.m3u8", token); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should only let editors package videos", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, hlsPath, token, nil, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should package the video with a key encrypted at rest", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, hlsPath, registerEditor(t), nil, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		var model postgres.VideoPackageModel
+		if err := db.First(&model, "video_id = ?", videoID).Error; err != nil {
+			t.Fatalf("Failed to load the video package: %v", err)
+		}
+		if len(model.EncryptedKey) <= 16 {
+			t.Errorf("expected a sealed content key, but got %d bytes", len(model.EncryptedKey))
+		}
+	})
+
+	var playlist, sessionID, keyURI string
+	var key []byte
+
+	t.Run("should sign the key URI for the playback session", func(t *testing.T) {
+		status, body, session := get(t, hlsPath+"/index.m3u8", token)
+		if status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		playlist, sessionID = string(body), session
+
+		match := keyURIPattern.FindStringSubmatch(playlist)
+		if sessionID == "" || match == nil {
+			t.Fatalf("expected a session and a key URI, but got:\n%s", playlist)
+		}
+		keyURI = match[1]
+		if !strings.HasPrefix(keyURI, hlsPath+"/key?") || !strings.Contains(keyURI, "session="+sessionID) || !strings.Contains(keyURI, "sig=") {
+			t.Errorf("expected a key URI signed for the session, but got %s", keyURI)
+		}
+	})
+
+	t.Run("should refuse the key outside the session", func(t *testing.T) {
+		if status, _, _ := get(t, hlsPath+"/key?session="+sessionID, ""); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 anonymously, but got %d", status)
+		}
+		if status, _, _ := get(t, hlsPath+"/key", token); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 without a session, but got %d", status)
+		}
+		if status, _, _ := get(t, hlsPath+"/key?session="+sessionID, registerAndLogin(t)); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 from another account, but got %d", status)
+		}
+		tampered := strings.Replace(keyURI, "session="+sessionID, "session="+uuid.NewString(), 1)
+		if status, _, _ := get(t, tampered, ""); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for a tampered key URI, but got %d", status)
+		}
+	})
+
+	t.Run("should decrypt the segments with the delivered key", func(t *testing.T) {
+		var status int
+		status, key, _ = get(t, keyURI, "")
+		if status != http.StatusOK || len(key) != 16 {
+			t.Fatalf("expected a 16 byte key with status 200, but got %d bytes and status %d", len(key), status)
+		}
+
+		iv, segment := firstSegment(t, playlist)
+		if !strings.HasPrefix(segment, hlsPath+"/") || !strings.Contains(segment, "sig=") {
+			t.Fatalf("expected a signed segment URI, but got %s", segment)
+		}
+		status, encrypted, _ := get(t, segment, "")
+		if status != http.StatusOK || len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+			t.Fatalf("expected an encrypted segment with status 200, but got %d bytes and status %d", len(encrypted), status)
+		}
+
+		block, _ := aes.NewCipher(key)
+		decrypted := make([]byte, len(encrypted))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, encrypted)
+		if decrypted[0] != 0x47 {
+			t.Errorf("expected the decrypted segment to start with the MPEG-TS sync byte, but got %#x", decrypted[0])
+		}
+	})
+
+	t.Run("should keep the previous package when repackaging fails", func(t *testing.T) {
+		if err := os.Rename(destVideoPath, destVideoPath+".bak"); err != nil {
+			t.Fatalf("Failed to move the source video away: %v", err)
+		}
+		status := doJSON(t, http.MethodPut, hlsPath, registerEditor(t), nil, nil)
+		if err := os.Rename(destVideoPath+".bak", destVideoPath); err != nil {
+			t.Fatalf("Failed to restore the source video: %v", err)
+		}
+		if status != http.StatusInternalServerError {
+			t.Fatalf("expected status code 500, but got %d", status)
+		}
+
+		status, current, _ := get(t, keyURI, "")
+		if status != http.StatusOK || !bytes.Equal(current, key) {
+			t.Errorf("expected the previous key with status 200, but got status %d", status)
+		}
+		_, segment := firstSegment(t, playlist)
+		if status, _, _ := get(t, segment, ""); status != http.StatusOK {
+			t.Errorf("expected the previous segments to be served, but got %d", status)
+		}
+	})
+
+	t.Run("should only serve segments to the playback session", func(t *testing.T) {
+		_, segment := firstSegment(t, playlist)
+		name := segment[strings.LastIndex(segment, "/")+1 : strings.Index(segment, "?")]
+
+		if status, _, _ := get(t, hlsPath+"/"+name, ""); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 anonymously, but got %d", status)
+		}
+		if status, _, _ := get(t, hlsPath+"/"+name, token); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 without a session, but got %d", status)
+		}
+		if status, _, _ := get(t, hlsPath+"/"+name+"?session="+uuid.NewString(), token); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 for an unknown session, but got %d", status)
+		}
+		if status, _, _ := get(t, hlsPath+"/"+name+"?session="+sessionID, registerAndLogin(t)); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 from another account, but got %d", status)
+		}
+	})
+
+	t.Run("should refuse the key once the plan lapses", func(t *testing.T) {
+		ended := time.Now().Add(-time.Hour)
+		if err := db.Model(&postgres.SubscriptionModel{}).Where("account_id = ?", accountID).Update("current_period_end", ended).Error; err != nil {
+			t.Fatalf("Failed to end the subscription period: %v", err)
+		}
+		if status, _, _ := get(t, hlsPath+"/key?session="+sessionID, token); status != http.StatusPaymentRequired {
+			t.Errorf("expected status code 402 on a session kept past its plan, but got %d", status)
+		}
+	})
+
+	t.Run("should only serve segment files", func(t *testing.T) {
+		if status, _, _ := get(t, hlsPath+"/index.m3u8.bak", token); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+}
+
+var (
+	keyURIPattern = regexp.MustCompile(`#EXT-X-KEY:[^\n]*URI="([^"]+)"`)
+	keyIVPattern  = regexp.MustCompile(`IV=0x([0-9a-fA-F]{32})`)
+)
+
+// firstSegment returns the IV and the URI of the first segment of an
+// encrypted playlist.
+func firstSegment(t *testing.T, playlist string) ([]byte, string) {
+	t.Helper()
+
+	match := keyIVPattern.FindStringSubmatch(playlist)
+	if match == nil {
+		t.Fatalf("expected the playlist key to carry an IV, but got:\n%s", playlist)
+	}
+	iv, _ := hex.DecodeString(match[1])
+
+	scanner := bufio.NewScanner(strings.NewReader(playlist))
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" && !strings.HasPrefix(line, "#") {
+			return iv, line
+		}
+	}
+	t.Fatalf("expected the playlist to list segments, but got:\n%s", playlist)
+	return nil, ""
+}
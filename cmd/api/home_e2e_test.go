@@ -0,0 +1,97 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestHomeE2E(t *testing.T) {
+	genre := "home-" + uuid.NewString()[:8]
+	contentIDs := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	railID := uuid.NewString()
+
+	now := time.Now().UTC()
+	seeds := []any{
+		&postgres.HomeRailModel{ID: railID, Kind: "GENRE", Title: "Home Test Genre", Genre: &genre, PageSize: 2, Position: 1, Enabled: true, CreatedAt: now, UpdatedAt: now},
+	}
+	for i, contentID := range contentIDs {
+		seeds = append(seeds,
+			&postgres.ContentModel{ID: contentID, Title: "Home Show", ContentType: "TV_SHOW", CreatedAt: now.Add(time.Duration(i) * time.Second)},
+			&postgres.ContentGenreModel{ContentID: contentID, Genre: genre},
+		)
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Delete(&postgres.HomeRailModel{}, "id = ?", railID)
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", contentIDs)
+	})
+
+	token := registerAndLogin(t)
+	token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Home"}))
+	if status := doJSON(t, http.MethodPut, "/me/list/"+contentIDs[0], token, nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected status code 204 when adding to my list, but got %d", status)
+	}
+
+	type rail struct {
+		ID    string `json:"id"`
+		Kind  string `json:"kind"`
+		Items []struct {
+			Content struct {
+				ID string `json:"id"`
+			} `json:"content"`
+		} `json:"items"`
+		NextToken string `json:"next_token"`
+	}
+
+	var genreRail rail
+	t.Run("should compose the configured rails in one response", func(t *testing.T) {
+		var respBody struct {
+			Rails []rail `json:"rails"`
+		}
+		if status := doJSON(t, http.MethodGet, "/home", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		kinds := make(map[string]rail)
+		for _, r := range respBody.Rails {
+			kinds[r.Kind] = r
+			if r.ID == railID {
+				genreRail = r
+			}
+		}
+		if myList, ok := kinds["MY_LIST"]; !ok || len(myList.Items) != 1 || myList.Items[0].Content.ID != contentIDs[0] {
+			t.Errorf("expected my list rail with the added content, but got %+v", myList)
+		}
+		if _, ok := kinds["CONTINUE_WATCHING"]; ok {
+			t.Errorf("expected the empty continue watching rail to be left out")
+		}
+		if len(genreRail.Items) != 2 || genreRail.NextToken == "" {
+			t.Fatalf("expected a first page of 2 genre items with a next token, but got %+v", genreRail)
+		}
+	})
+
+	t.Run("should page through a single rail with its token", func(t *testing.T) {
+		var respBody rail
+		if status := doJSON(t, http.MethodGet, "/home/rails/"+railID+"?token="+genreRail.NextToken, token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 1 || respBody.Items[0].Content.ID != contentIDs[0] || respBody.NextToken != "" {
+			t.Errorf("expected the last genre item without a next token, but got %+v", respBody)
+		}
+	})
+
+	t.Run("should reject a malformed token", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/home/rails/"+railID+"?token=not-a-token", token, nil, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+}
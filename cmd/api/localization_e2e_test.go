@@ -0,0 +1,132 @@
+package main_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestLocalizationE2E(t *testing.T) {
+	genre := "localization-" + uuid.NewString()[:8]
+	contentID := uuid.NewString()
+	videoID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/upload/videos/localized.mp4", SizeInKb: 1, Duration: 60},
+		&postgres.ContentModel{ID: contentID, Title: "The Localized Movie", Description: "Original", ContentType: "MOVIE"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: contentID, VideoID: videoID},
+		&postgres.ContentGenreModel{ContentID: contentID, Genre: genre},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	editorToken := registerEditor(t)
+	translationsPath := "/contents/" + contentID + "/translations"
+
+	// titleFor lists the seeded content with the given Accept-Language
+	// header and returns its title.
+	titleFor := func(t *testing.T, acceptLanguage string) string {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/contents?genre="+genre, nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var respBody struct {
+			Items []struct {
+				Title string `json:"title"`
+			} `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&respBody)
+		if len(respBody.Items) != 1 {
+			t.Fatalf("expected 1 listed content, but got %d", len(respBody.Items))
+		}
+		return respBody.Items[0].Title
+	}
+
+	t.Run("should only let editors manage translations", func(t *testing.T) {
+		body := map[string]any{"title": "O Filme"}
+		if status := doJSON(t, http.MethodPut, translationsPath+"/pt-BR", registerAndLogin(t), body, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should reject invalid locales", func(t *testing.T) {
+		body := map[string]any{"title": "O Filme"}
+		if status := doJSON(t, http.MethodPut, translationsPath+"/portuguese", editorToken, body, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+
+	t.Run("should report unknown contents", func(t *testing.T) {
+		body := map[string]any{"title": "O Filme"}
+		if status := doJSON(t, http.MethodPut, "/contents/"+uuid.NewString()+"/translations/pt-BR", editorToken, body, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should save a normalized translation", func(t *testing.T) {
+		body := map[string]any{"title": "O Filme Localizado", "description": "Traduzido"}
+		var respBody struct {
+			Locale string `json:"locale"`
+		}
+		if status := doJSON(t, http.MethodPut, translationsPath+"/pt-br", editorToken, body, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.Locale != "pt-BR" {
+			t.Errorf("expected locale pt-BR, but got %q", respBody.Locale)
+		}
+
+		var listBody struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, translationsPath, editorToken, nil, &listBody); status != http.StatusOK || len(listBody.Items) != 1 {
+			t.Errorf("expected 1 translation with status 200, but got %d items and status %d", len(listBody.Items), status)
+		}
+	})
+
+	t.Run("should pick the translation from Accept-Language", func(t *testing.T) {
+		cases := map[string]string{
+			"":                          "The Localized Movie",
+			"en-US":                     "The Localized Movie",
+			"pt-BR":                     "O Filme Localizado",
+			"pt-PT":                     "O Filme Localizado",
+			"en-US;q=0.5, pt-BR;q=0.9":  "O Filme Localizado",
+			"fr-FR, pt;q=0.8, en;q=0.7": "O Filme Localizado",
+			"pt-BR;q=0, en":             "The Localized Movie",
+		}
+		for acceptLanguage, want := range cases {
+			if got := titleFor(t, acceptLanguage); got != want {
+				t.Errorf("Accept-Language %q: expected title %q, but got %q", acceptLanguage, want, got)
+			}
+		}
+	})
+
+	t.Run("should fall back to the original once deleted", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, translationsPath+"/pt-BR", editorToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if got := titleFor(t, "pt-BR"); got != "The Localized Movie" {
+			t.Errorf("expected the original title, but got %q", got)
+		}
+		if status := doJSON(t, http.MethodDelete, translationsPath+"/pt-BR", editorToken, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+}
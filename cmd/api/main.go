@@ -1,17 +1,48 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
 	"github.com/hoyci/fakeflix/internal/infra/config"
 	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+	"github.com/hoyci/fakeflix/internal/infra/geo"
 	"github.com/hoyci/fakeflix/internal/infra/logger"
 	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/infra/payment"
+	"github.com/hoyci/fakeflix/internal/infra/scheduler"
+	"github.com/hoyci/fakeflix/internal/infra/throttle"
+	"github.com/hoyci/fakeflix/internal/infra/vault"
 	httphandler "github.com/hoyci/fakeflix/internal/interface/http"
+	"github.com/hoyci/fakeflix/internal/usecase/account"
+	"github.com/hoyci/fakeflix/internal/usecase/availability"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/collection"
+	"github.com/hoyci/fakeflix/internal/usecase/device"
+	"github.com/hoyci/fakeflix/internal/usecase/download"
+	extrausecase "github.com/hoyci/fakeflix/internal/usecase/extra"
+	"github.com/hoyci/fakeflix/internal/usecase/home"
+	"github.com/hoyci/fakeflix/internal/usecase/localization"
+	"github.com/hoyci/fakeflix/internal/usecase/mediacache"
 	"github.com/hoyci/fakeflix/internal/usecase/movie"
+	"github.com/hoyci/fakeflix/internal/usecase/person"
+	"github.com/hoyci/fakeflix/internal/usecase/playback"
+	"github.com/hoyci/fakeflix/internal/usecase/profile"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/publishing"
+	"github.com/hoyci/fakeflix/internal/usecase/rating"
+	"github.com/hoyci/fakeflix/internal/usecase/recommendation"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/internal/usecase/taxonomy"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
 	videousecase "github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
 )
 
 func main() {
@@ -34,17 +65,282 @@ func main() {
 
 	contentRepo := postgres.NewContentRepository(db, appLogger)
 	videoRepo := postgres.NewVideoRepository(db, appLogger)
-	mediaService := media.NewLocalMediaService(appLogger)
+	personRepo := postgres.NewPersonRepository(db, appLogger)
+	profileRepo := postgres.NewProfileRepository(db, appLogger)
+	accountRepo := postgres.NewAccountRepository(db, appLogger)
+	progressRepo := postgres.NewProgressRepository(db, appLogger)
+	watchlistRepo := postgres.NewWatchlistRepository(db, appLogger)
+	ratingRepo := postgres.NewRatingRepository(db, appLogger)
+	taxonomyRepo := postgres.NewTaxonomyRepository(db, appLogger)
+	recommendationRepo := postgres.NewRecommendationRepository(db, appLogger)
+	playbackRepo := postgres.NewPlaybackRepository(db, appLogger)
+	trendingRepo := postgres.NewTrendingRepository(db, appLogger)
+	homeRepo := postgres.NewHomeRepository(db, appLogger)
+	collectionRepo := postgres.NewCollectionRepository(db, appLogger)
+	availabilityRepo := postgres.NewAvailabilityRepository(db, appLogger)
+	translationRepo := postgres.NewTranslationRepository(db, appLogger)
+	subscriptionRepo := postgres.NewSubscriptionRepository(db, appLogger)
+	playbackSessionRepo := postgres.NewPlaybackSessionRepository(db, appLogger)
+	keySealer, err := vault.NewKeySealer(cfg.HLSMasterKey, appLogger)
+	if err != nil {
+		appLogger.Fatal("could not set up the key sealer", "error", err)
+	}
+	extraRepo := postgres.NewExtraRepository(db, appLogger)
+	videoPackageRepo := postgres.NewVideoPackageRepository(db, keySealer, appLogger)
+	videoMarkerRepo := postgres.NewVideoMarkerRepository(db, appLogger)
+	videoAssetRepo := postgres.NewVideoAssetRepository(db, appLogger)
+	downloadLicenseRepo := postgres.NewDownloadLicenseRepository(db, appLogger)
+	deviceRepo := postgres.NewDeviceRepository(db, appLogger)
+	mediaService, mediaCache := media.NewCachedMediaService(
+		media.NewLocalMediaService(appLogger),
+		cfg.MediaCacheMaxBytes,
+		cfg.MediaCacheMaxEntryBytes,
+		appLogger,
+	)
+	streamShaper := throttle.NewShaper(cfg, appLogger)
+	paymentProvider := payment.NewFakeProvider(appLogger)
+	tokenService := auth.NewJWTService(cfg.JWTAccessSecret, time.Duration(cfg.JWTAccessExpMinutes)*time.Minute, appLogger)
+	urlSigner := auth.NewURLSigner(cfg.SignedURLSecret, time.Duration(cfg.SignedURLTTLSeconds)*time.Second, appLogger)
+	countryResolver, err := geo.NewCountryResolver(cfg, appLogger)
+	if err != nil {
+		appLogger.Fatal("could not set up the country resolver", "error", err)
+	}
 
+	sessionTimeout := time.Duration(cfg.PlaybackSessionTimeoutSeconds) * time.Second
+	checkEntitlementUseCase := subscription.NewCheckEntitlementUseCase(subscriptionRepo, appLogger)
+	recordPlaybackEventUseCase := playback.NewRecordPlaybackEventUseCase(playbackRepo, appLogger)
+	openSessionUseCase := playback.NewOpenSessionUseCase(playbackSessionRepo, deviceRepo, recordPlaybackEventUseCase, sessionTimeout, appLogger)
+	completeSessionUseCase := playback.NewCompleteSessionUseCase(playbackSessionRepo, recordPlaybackEventUseCase, sessionTimeout, appLogger)
 	createMovieUseCase := movie.NewCreateMovieUseCase(contentRepo, mediaService, appLogger)
-	getStreamInfoUseCase := videousecase.NewGetStreamInfoUseCase(videoRepo, appLogger)
+	getStreamInfoUseCase := videousecase.NewGetStreamInfoUseCase(videoRepo, videoMarkerRepo, contentRepo, extraRepo, profileRepo, availabilityRepo, checkEntitlementUseCase, openSessionUseCase, appLogger)
+	getStreamFileUseCase := videousecase.NewGetStreamFileUseCase(getStreamInfoUseCase, mediaService, appLogger)
+	packageVideoUseCase := videousecase.NewPackageVideoUseCase(videoRepo, videoPackageRepo, mediaService, appLogger)
+	getPlaylistUseCase := videousecase.NewGetPlaylistUseCase(videoPackageRepo, getStreamInfoUseCase, mediaService, urlSigner, appLogger)
+	getSegmentUseCase := videousecase.NewGetSegmentUseCase(videoPackageRepo, playbackSessionRepo, getStreamInfoUseCase, sessionTimeout, appLogger)
+	getContentKeyUseCase := videousecase.NewGetContentKeyUseCase(videoPackageRepo, playbackSessionRepo, getStreamInfoUseCase, sessionTimeout, appLogger)
+	setMarkersUseCase := videousecase.NewSetMarkersUseCase(videoRepo, videoMarkerRepo, appLogger)
+	getMarkersUseCase := videousecase.NewGetMarkersUseCase(videoMarkerRepo, appLogger)
+	deleteMarkersUseCase := videousecase.NewDeleteMarkersUseCase(videoMarkerRepo, appLogger)
+	detectIntrosUseCase := videousecase.NewDetectIntrosUseCase(contentRepo, videoMarkerRepo, mediaService, appLogger)
+	addAssetUseCase := videousecase.NewAddAssetUseCase(videoRepo, videoAssetRepo, mediaService, appLogger)
+	getAssetUseCase := videousecase.NewGetAssetUseCase(videoAssetRepo, getStreamInfoUseCase, appLogger)
+	deleteAssetUseCase := videousecase.NewDeleteAssetUseCase(videoAssetRepo, appLogger)
+	getPlaybackInfoUseCase := videousecase.NewGetPlaybackInfoUseCase(contentRepo, progressRepo, videoPackageRepo, videoAssetRepo, getStreamInfoUseCase, urlSigner, appLogger)
+	createPersonUseCase := person.NewCreatePersonUseCase(personRepo, mediaService, appLogger)
+	addCreditUseCase := person.NewAddCreditUseCase(personRepo, contentRepo, appLogger)
+	getPersonUseCase := person.NewGetPersonUseCase(personRepo, appLogger)
+	createProfileUseCase := profile.NewCreateProfileUseCase(profileRepo, appLogger)
+	listProfilesUseCase := profile.NewListProfilesUseCase(profileRepo, appLogger)
+	deleteProfileUseCase := profile.NewDeleteProfileUseCase(profileRepo, appLogger)
+	resolveProfileUseCase := profile.NewResolveProfileUseCase(profileRepo, appLogger)
+	registerAccountUseCase := account.NewRegisterAccountUseCase(accountRepo, appLogger)
+	refreshTokenTTL := time.Duration(cfg.RefreshTokenExpHours) * time.Hour
+	loginUseCase := account.NewLoginUseCase(accountRepo, profileRepo, deviceRepo, checkEntitlementUseCase, tokenService, refreshTokenTTL, appLogger)
+	refreshTokenUseCase := account.NewRefreshTokenUseCase(deviceRepo, profileRepo, tokenService, refreshTokenTTL, appLogger)
+	switchProfileUseCase := account.NewSwitchProfileUseCase(profileRepo, tokenService, appLogger)
+	authorizeEditorUseCase := account.NewAuthorizeEditorUseCase(accountRepo, appLogger)
+	listContentsUseCase := catalog.NewListContentsUseCase(contentRepo, profileRepo, ratingRepo, translationRepo, appLogger)
+	recordProgressUseCase := progress.NewRecordProgressUseCase(videoRepo, progressRepo, appLogger)
+	listContinueWatchingUseCase := progress.NewListContinueWatchingUseCase(progressRepo, contentRepo, translationRepo, appLogger)
+	addToListUseCase := watchlist.NewAddToListUseCase(watchlistRepo, contentRepo, appLogger)
+	removeFromListUseCase := watchlist.NewRemoveFromListUseCase(watchlistRepo, appLogger)
+	listMyListUseCase := watchlist.NewListMyListUseCase(watchlistRepo, contentRepo, ratingRepo, translationRepo, appLogger)
+	rateContentUseCase := rating.NewRateContentUseCase(ratingRepo, contentRepo, appLogger)
+	clearRatingUseCase := rating.NewClearRatingUseCase(ratingRepo, appLogger)
+	setTaxonomyUseCase := taxonomy.NewSetTaxonomyUseCase(taxonomyRepo, contentRepo, appLogger)
+	refreshRecommendationsUseCase := recommendation.NewRefreshRecommendationsUseCase(recommendationRepo, appLogger)
+	listRecommendationsUseCase := recommendation.NewListRecommendationsUseCase(recommendationRepo, contentRepo, profileRepo, ratingRepo, translationRepo, appLogger)
+	listSimilarUseCase := recommendation.NewListSimilarUseCase(recommendationRepo, contentRepo, profileRepo, ratingRepo, translationRepo, appLogger)
+	rollupTrendingUseCase := trending.NewRollupTrendingUseCase(trendingRepo, appLogger)
+	listTrendingUseCase := trending.NewListTrendingUseCase(trendingRepo, contentRepo, profileRepo, ratingRepo, translationRepo, appLogger)
+	getHomeUseCase := home.NewGetHomeUseCase(homeRepo, listContinueWatchingUseCase, listTrendingUseCase, listMyListUseCase, listContentsUseCase, appLogger)
+	getRailPageUseCase := home.NewGetRailPageUseCase(homeRepo, listContinueWatchingUseCase, listTrendingUseCase, listMyListUseCase, listContentsUseCase, appLogger)
+	createCollectionUseCase := collection.NewCreateCollectionUseCase(collectionRepo, contentRepo, appLogger)
+	updateCollectionUseCase := collection.NewUpdateCollectionUseCase(collectionRepo, contentRepo, appLogger)
+	setCollectionArtworkUseCase := collection.NewSetCollectionArtworkUseCase(collectionRepo, mediaService, appLogger)
+	deleteCollectionUseCase := collection.NewDeleteCollectionUseCase(collectionRepo, appLogger)
+	listCollectionsUseCase := collection.NewListCollectionsUseCase(collectionRepo, appLogger)
+	getCollectionUseCase := collection.NewGetCollectionUseCase(collectionRepo, contentRepo, profileRepo, ratingRepo, translationRepo, appLogger)
+	changeStatusUseCase := publishing.NewChangeStatusUseCase(contentRepo, appLogger)
+	publishDueUseCase := publishing.NewPublishDueUseCase(contentRepo, appLogger)
+	setAvailabilityUseCase := availability.NewSetAvailabilityUseCase(availabilityRepo, contentRepo, appLogger)
+	clearAvailabilityUseCase := availability.NewClearAvailabilityUseCase(availabilityRepo, appLogger)
+	setTranslationUseCase := localization.NewSetTranslationUseCase(translationRepo, appLogger)
+	setTranslationThumbnailUseCase := localization.NewSetTranslationThumbnailUseCase(translationRepo, mediaService, appLogger)
+	deleteTranslationUseCase := localization.NewDeleteTranslationUseCase(translationRepo, appLogger)
+	listTranslationsUseCase := localization.NewListTranslationsUseCase(translationRepo, appLogger)
+	listPlansUseCase := subscription.NewListPlansUseCase(subscriptionRepo, appLogger)
+	subscribeUseCase := subscription.NewSubscribeUseCase(subscriptionRepo, paymentProvider, appLogger)
+	getSubscriptionUseCase := subscription.NewGetSubscriptionUseCase(subscriptionRepo, appLogger)
+	cancelSubscriptionUseCase := subscription.NewCancelSubscriptionUseCase(subscriptionRepo, appLogger)
+	renewDueUseCase := subscription.NewRenewDueUseCase(subscriptionRepo, paymentProvider, appLogger)
+	addTrailerUseCase := extrausecase.NewAddTrailerUseCase(extraRepo, mediaService, appLogger)
+	generatePreviewUseCase := extrausecase.NewGeneratePreviewUseCase(contentRepo, extraRepo, mediaService, appLogger)
+	listExtrasUseCase := extrausecase.NewListExtrasUseCase(extraRepo, appLogger)
+	deleteExtraUseCase := extrausecase.NewDeleteExtraUseCase(extraRepo, appLogger)
+	getCacheStatsUseCase := mediacache.NewGetCacheStatsUseCase(mediaCache, appLogger)
+	purgeCacheUseCase := mediacache.NewPurgeCacheUseCase(mediaCache, appLogger)
+	listSessionsUseCase := playback.NewListSessionsUseCase(playbackSessionRepo, sessionTimeout, appLogger)
+	heartbeatSessionUseCase := playback.NewHeartbeatSessionUseCase(playbackSessionRepo, sessionTimeout, appLogger)
+	terminateSessionUseCase := playback.NewTerminateSessionUseCase(playbackSessionRepo, appLogger)
+	expireSessionsUseCase := playback.NewExpireSessionsUseCase(playbackSessionRepo, sessionTimeout, appLogger)
+	downloadLicenseTTL := time.Duration(cfg.DownloadLicenseTTLHours) * time.Hour
+	issueLicenseUseCase := download.NewIssueLicenseUseCase(downloadLicenseRepo, videoRepo, getStreamInfoUseCase, checkEntitlementUseCase, urlSigner, downloadLicenseTTL, appLogger)
+	listLicensesUseCase := download.NewListLicensesUseCase(downloadLicenseRepo, checkEntitlementUseCase, urlSigner, appLogger)
+	renewLicenseUseCase := download.NewRenewLicenseUseCase(downloadLicenseRepo, checkEntitlementUseCase, urlSigner, downloadLicenseTTL, appLogger)
+	returnLicenseUseCase := download.NewReturnLicenseUseCase(downloadLicenseRepo, appLogger)
+	getDownloadFileUseCase := download.NewGetDownloadFileUseCase(downloadLicenseRepo, videoRepo, checkEntitlementUseCase, mediaService, appLogger)
+	listDevicesUseCase := device.NewListDevicesUseCase(deviceRepo, appLogger)
+	signOutDeviceUseCase := device.NewSignOutDeviceUseCase(deviceRepo, playbackSessionRepo, downloadLicenseRepo, appLogger)
+	resolveDeviceUseCase := device.NewResolveDeviceUseCase(deviceRepo, appLogger)
 
 	movieHandler := httphandler.NewMovieHandler(createMovieUseCase, appLogger)
-	videoHandler := httphandler.NewVideoHandler(getStreamInfoUseCase, mediaService, appLogger)
+	videoHandler := httphandler.NewVideoHandler(getStreamFileUseCase, completeSessionUseCase, mediaService, streamShaper, appLogger)
+	hlsHandler := httphandler.NewHLSHandler(packageVideoUseCase, getPlaylistUseCase, getSegmentUseCase, getContentKeyUseCase, mediaService, streamShaper, appLogger)
+	assetHandler := httphandler.NewAssetHandler(addAssetUseCase, getAssetUseCase, deleteAssetUseCase, mediaService, appLogger)
+	playbackHandler := httphandler.NewPlaybackHandler(getPlaybackInfoUseCase, appLogger)
+	markerHandler := httphandler.NewMarkerHandler(setMarkersUseCase, getMarkersUseCase, deleteMarkersUseCase, detectIntrosUseCase, appLogger)
+	personHandler := httphandler.NewPersonHandler(createPersonUseCase, addCreditUseCase, getPersonUseCase, appLogger)
+	profileHandler := httphandler.NewProfileHandler(createProfileUseCase, listProfilesUseCase, deleteProfileUseCase, appLogger)
+	accountHandler := httphandler.NewAccountHandler(registerAccountUseCase, loginUseCase, switchProfileUseCase, refreshTokenUseCase, appLogger)
+	catalogHandler := httphandler.NewCatalogHandler(listContentsUseCase, appLogger)
+	progressHandler := httphandler.NewProgressHandler(recordProgressUseCase, listContinueWatchingUseCase, appLogger)
+	watchlistHandler := httphandler.NewWatchlistHandler(addToListUseCase, removeFromListUseCase, listMyListUseCase, appLogger)
+	ratingHandler := httphandler.NewRatingHandler(rateContentUseCase, clearRatingUseCase, appLogger)
+	taxonomyHandler := httphandler.NewTaxonomyHandler(setTaxonomyUseCase, appLogger)
+	recommendationHandler := httphandler.NewRecommendationHandler(listRecommendationsUseCase, listSimilarUseCase, appLogger)
+	trendingHandler := httphandler.NewTrendingHandler(listTrendingUseCase, appLogger)
+	homeHandler := httphandler.NewHomeHandler(getHomeUseCase, getRailPageUseCase, appLogger)
+	collectionHandler := httphandler.NewCollectionHandler(createCollectionUseCase, updateCollectionUseCase, setCollectionArtworkUseCase, deleteCollectionUseCase, listCollectionsUseCase, getCollectionUseCase, appLogger)
+	publishingHandler := httphandler.NewPublishingHandler(changeStatusUseCase, appLogger)
+	availabilityHandler := httphandler.NewAvailabilityHandler(setAvailabilityUseCase, clearAvailabilityUseCase, appLogger)
+	subscriptionHandler := httphandler.NewSubscriptionHandler(listPlansUseCase, subscribeUseCase, getSubscriptionUseCase, cancelSubscriptionUseCase, appLogger)
+	localizationHandler := httphandler.NewLocalizationHandler(setTranslationUseCase, setTranslationThumbnailUseCase, deleteTranslationUseCase, listTranslationsUseCase, appLogger)
+	extraHandler := httphandler.NewExtraHandler(addTrailerUseCase, generatePreviewUseCase, listExtrasUseCase, deleteExtraUseCase, appLogger)
+	mediaCacheHandler := httphandler.NewMediaCacheHandler(getCacheStatsUseCase, purgeCacheUseCase, appLogger)
+	sessionHandler := httphandler.NewSessionHandler(listSessionsUseCase, heartbeatSessionUseCase, terminateSessionUseCase, appLogger)
+	downloadHandler := httphandler.NewDownloadHandler(issueLicenseUseCase, listLicensesUseCase, renewLicenseUseCase, returnLicenseUseCase, getDownloadFileUseCase, mediaService, appLogger)
+	deviceHandler := httphandler.NewDeviceHandler(listDevicesUseCase, signOutDeviceUseCase, appLogger)
+	openAPIHandler := httphandler.NewOpenAPIHandler(cfg.AppName)
+	authMiddleware := httphandler.NewAuthMiddleware(tokenService, urlSigner, resolveProfileUseCase, resolveDeviceUseCase, authorizeEditorUseCase, appLogger)
+
+	jobScheduler := scheduler.New(appLogger)
+	jobScheduler.Every("refresh-recommendations", time.Duration(cfg.RecommendationsRefreshSeconds)*time.Second, refreshRecommendationsUseCase.Execute)
+	jobScheduler.Every("rollup-trending", time.Duration(cfg.TrendingRollupSeconds)*time.Second, rollupTrendingUseCase.Execute)
+	jobScheduler.Every("publish-scheduled", time.Duration(cfg.PublishScheduledSeconds)*time.Second, publishDueUseCase.Execute)
+	jobScheduler.Every("renew-subscriptions", time.Duration(cfg.SubscriptionRenewalSeconds)*time.Second, renewDueUseCase.Execute)
+	jobScheduler.Every("expire-playback-sessions", sessionTimeout, expireSessionsUseCase.Execute)
+	jobScheduler.Start(context.Background())
 
 	router := chi.NewRouter()
-	router.Post("/movies", movieHandler.CreateMovie)
-	router.Get("/videos/{videoID}/stream", videoHandler.StreamVideo)
+	// Set before Use so the middlewares, which already wrap routing, do not
+	// run a second time for unmatched requests.
+	router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		httputils.RespondWithError(w, r, fault.New(
+			"route not found",
+			fault.WithKind(fault.KindNotFound),
+		))
+	})
+	router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		httputils.RespondWithError(w, r, fault.New(
+			"method not allowed",
+			fault.WithHTTPCode(http.StatusMethodNotAllowed),
+			fault.WithCode("method_not_allowed"),
+		))
+	})
+	router.Use(httphandler.RequestID)
+	router.Use(httphandler.ResolveCountry(countryResolver))
+	router.Use(authMiddleware.Authenticate)
+	router.Get("/videos/{videoID}/markers", markerHandler.GetMarkers)
+	router.Get("/people/{personID}", personHandler.GetPerson)
+	router.Get("/contents", catalogHandler.ListContents)
+	router.Get("/contents/{contentID}/similar", recommendationHandler.ListSimilar)
+	router.Get("/contents/{contentID}/extras", extraHandler.ListExtras)
+	router.Get("/trending", trendingHandler.ListTrending)
+	router.Get("/trending/top-10", trendingHandler.TopTenToday)
+	router.Get("/collections/{slug}", collectionHandler.GetCollection)
+	router.Get("/plans", subscriptionHandler.ListPlans)
+	router.Post("/accounts", accountHandler.Register)
+	router.Post("/auth/login", accountHandler.Login)
+	router.Post("/auth/refresh", accountHandler.RefreshToken)
+	router.Get("/openapi.json", openAPIHandler.GetDocument)
+
+	router.Group(func(r chi.Router) {
+		r.Use(httphandler.RequireAccount)
+		r.Get("/me/profiles", profileHandler.ListProfiles)
+		r.Post("/me/profiles", profileHandler.CreateProfile)
+		r.Delete("/me/profiles/{profileID}", profileHandler.DeleteProfile)
+		r.Post("/me/profiles/{profileID}/select", accountHandler.SwitchProfile)
+		r.Get("/me/subscription", subscriptionHandler.GetSubscription)
+		r.Put("/me/subscription", subscriptionHandler.Subscribe)
+		r.Delete("/me/subscription", subscriptionHandler.CancelSubscription)
+		r.Get("/videos/{videoID}/stream", videoHandler.StreamVideo)
+		r.Get("/videos/{videoID}/hls/index.m3u8", hlsHandler.GetPlaylist)
+		r.Get("/videos/{videoID}/hls/key", hlsHandler.GetContentKey)
+		r.Get("/videos/{videoID}/hls/{segment}", hlsHandler.GetSegment)
+		r.Get("/videos/{videoID}/assets/{assetID}", assetHandler.GetAsset)
+		r.Get("/contents/{contentID}/playback", playbackHandler.GetPlaybackInfo)
+		r.Get("/contents/{contentID}/episodes/{episodeID}/playback", playbackHandler.GetPlaybackInfo)
+		r.Get("/me/sessions", sessionHandler.ListSessions)
+		r.Put("/me/sessions/{sessionID}/heartbeat", sessionHandler.Heartbeat)
+		r.Delete("/me/sessions/{sessionID}", sessionHandler.TerminateSession)
+		r.Get("/me/devices", deviceHandler.ListDevices)
+		r.Delete("/me/devices/{deviceID}", deviceHandler.SignOutDevice)
+		r.Get("/me/downloads", downloadHandler.ListLicenses)
+		r.Post("/me/downloads", downloadHandler.IssueLicense)
+		r.Put("/me/downloads/{licenseID}/renew", downloadHandler.RenewLicense)
+		r.Delete("/me/downloads/{licenseID}", downloadHandler.ReturnLicense)
+		r.Get("/me/downloads/{licenseID}/file", downloadHandler.GetFile)
+	})
+
+	router.Group(func(r chi.Router) {
+		r.Use(httphandler.RequireProfile)
+		r.Put("/videos/{videoID}/progress", progressHandler.RecordProgress)
+		r.Get("/me/continue-watching", progressHandler.ListContinueWatching)
+		r.Get("/me/list", watchlistHandler.ListMyList)
+		r.Put("/me/list/{contentID}", watchlistHandler.AddToList)
+		r.Delete("/me/list/{contentID}", watchlistHandler.RemoveFromList)
+		r.Put("/contents/{contentID}/rating", ratingHandler.RateContent)
+		r.Delete("/contents/{contentID}/rating", ratingHandler.ClearRating)
+		r.Get("/me/recommendations", recommendationHandler.ListRecommendations)
+		r.Get("/home", homeHandler.GetHome)
+		r.Get("/home/rails/{railID}", homeHandler.GetRailPage)
+	})
+
+	router.Group(func(r chi.Router) {
+		r.Use(authMiddleware.RequireEditor)
+		r.Post("/movies", movieHandler.CreateMovie)
+		r.Put("/videos/{videoID}/hls", hlsHandler.PackageVideo)
+		r.Put("/videos/{videoID}/markers", markerHandler.SetMarkers)
+		r.Delete("/videos/{videoID}/markers", markerHandler.DeleteMarkers)
+		r.Post("/videos/{videoID}/assets", assetHandler.AddAsset)
+		r.Delete("/videos/{videoID}/assets/{assetID}", assetHandler.DeleteAsset)
+		r.Post("/contents/{contentID}/seasons/{season}/detect-intros", markerHandler.DetectIntros)
+		r.Post("/contents/{contentID}/trailers", extraHandler.AddTrailer)
+		r.Put("/contents/{contentID}/preview", extraHandler.GeneratePreview)
+		r.Delete("/contents/{contentID}/extras/{extraID}", extraHandler.DeleteExtra)
+		r.Get("/admin/media-cache", mediaCacheHandler.GetStats)
+		r.Delete("/admin/media-cache", mediaCacheHandler.Purge)
+		r.Get("/collections", collectionHandler.ListCollections)
+		r.Post("/collections", collectionHandler.CreateCollection)
+		r.Put("/collections/{slug}", collectionHandler.UpdateCollection)
+		r.Put("/collections/{slug}/artwork", collectionHandler.SetArtwork)
+		r.Delete("/collections/{slug}", collectionHandler.DeleteCollection)
+		r.Post("/people", personHandler.CreatePerson)
+		r.Post("/people/{personID}/credits", personHandler.AddCredit)
+		r.Put("/contents/{contentID}/taxonomy", taxonomyHandler.SetTaxonomy)
+		r.Put("/contents/{contentID}/status", publishingHandler.ChangeStatus)
+		r.Put("/contents/{contentID}/availability", availabilityHandler.SetAvailability)
+		r.Delete("/contents/{contentID}/availability", availabilityHandler.ClearAvailability)
+		r.Get("/contents/{contentID}/translations", localizationHandler.ListTranslations)
+		r.Put("/contents/{contentID}/translations/{locale}", localizationHandler.SetTranslation)
+		r.Put("/contents/{contentID}/translations/{locale}/thumbnail", localizationHandler.SetTranslationThumbnail)
+		r.Delete("/contents/{contentID}/translations/{locale}", localizationHandler.DeleteTranslation)
+		r.Get("/episodes/{episodeID}/translations", localizationHandler.ListTranslations)
+		r.Put("/episodes/{episodeID}/translations/{locale}", localizationHandler.SetTranslation)
+		r.Put("/episodes/{episodeID}/translations/{locale}/thumbnail", localizationHandler.SetTranslationThumbnail)
+		r.Delete("/episodes/{episodeID}/translations/{locale}", localizationHandler.DeleteTranslation)
+	})
 
 	listenAddr := fmt.Sprintf(":%d", cfg.Port)
 	appLogger.Info("server is starting", "address", listenAddr)
@@ -46,6 +46,11 @@ func TestMain(m *testing.M) {
 		"DB_PASSWORD=password",
 		"DB_DATABASE=test-db-e2e",
 		"APP_ENV=testing",
+		"RECOMMENDATIONS_REFRESH_SECONDS=1",
+		"TRENDING_ROLLUP_SECONDS=1",
+		"PUBLISH_SCHEDULED_SECONDS=1",
+		"STREAM_SESSION_BYTES_PER_SECOND=1048576",
+		"STREAM_BURST_SECONDS=1",
 	)
 	apiCmd.Stdout = os.Stdout
 	apiCmd.Stderr = os.Stderr
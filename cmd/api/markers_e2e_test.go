@@ -0,0 +1,127 @@
+package main_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestMarkersE2E(t *testing.T) {
+	showContentID := uuid.NewString()
+	showID := uuid.NewString()
+	firstVideoID, secondVideoID := uuid.NewString(), uuid.NewString()
+
+	seeds := []any{
+		&postgres.ContentModel{ID: showContentID, Title: "Marker Show", ContentType: "TV_SHOW"},
+		&postgres.TvShowModel{ID: showID, ContentID: showContentID},
+	}
+	// Both episodes share the same audio, so the whole of it passes as the
+	// intro.
+	for i, videoID := range []string{firstVideoID, secondVideoID} {
+		videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+		destVideoPath := filepath.Join("..", "..", videoURLPath)
+		os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+		if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+			t.Fatalf("Failed to copy test video file: %v", err)
+		}
+		t.Cleanup(func() { os.Remove(destVideoPath) })
+
+		seeds = append(seeds,
+			&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30},
+			&postgres.EpisodeModel{ID: uuid.NewString(), TvShowID: showID, VideoID: videoID, Title: fmt.Sprintf("Episode %d", i+1), Season: 1, Number: i + 1},
+		)
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", showContentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{firstVideoID, secondVideoID})
+	})
+
+	editorToken := registerEditor(t)
+	viewerToken := registerAndLogin(t)
+
+	type markers struct {
+		Intro *struct {
+			Start int `json:"start"`
+			End   int `json:"end"`
+		} `json:"intro"`
+		CreditsStart *int   `json:"credits_start"`
+		Source       string `json:"source"`
+	}
+	body := map[string]any{"intro": map[string]any{"start": 2, "end": 12}, "credits_start": 25}
+
+	t.Run("should only let editors set markers", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/markers", viewerToken, body, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should reject markers outside the video", func(t *testing.T) {
+		invalid := map[string]any{"intro": map[string]any{"start": 20, "end": 40}}
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/markers", editorToken, invalid, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/markers", editorToken, map[string]any{}, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422 without markers, but got %d", status)
+		}
+	})
+
+	t.Run("should set and serve markers", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/markers", editorToken, body, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		var respBody markers
+		if status := doJSON(t, http.MethodGet, "/videos/"+firstVideoID+"/markers", "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.Intro == nil || respBody.Intro.End != 12 || respBody.CreditsStart == nil || *respBody.CreditsStart != 25 || respBody.Source != "MANUAL" {
+			t.Errorf("expected the manual markers, but got %+v", respBody)
+		}
+	})
+
+	t.Run("should detect intros without overriding manual markers", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				VideoID string `json:"video_id"`
+				Source  string `json:"source"`
+			} `json:"items"`
+			Skipped []string `json:"skipped"`
+		}
+		status := doJSON(t, http.MethodPost, "/contents/"+showContentID+"/seasons/1/detect-intros", editorToken, nil, &respBody)
+		if status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 1 || respBody.Items[0].VideoID != secondVideoID || respBody.Items[0].Source != "DETECTED" {
+			t.Errorf("expected an intro detected for the second episode, but got %+v", respBody.Items)
+		}
+		if len(respBody.Skipped) != 1 || respBody.Skipped[0] != firstVideoID {
+			t.Errorf("expected the manually marked episode to be skipped, but got %+v", respBody.Skipped)
+		}
+	})
+
+	t.Run("should refuse seasons too short to compare", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPost, "/contents/"+showContentID+"/seasons/2/detect-intros", editorToken, nil, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409, but got %d", status)
+		}
+	})
+
+	t.Run("should delete markers", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/videos/"+firstVideoID+"/markers", editorToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodGet, "/videos/"+firstVideoID+"/markers", "", nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404 after deleting, but got %d", status)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package main_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestMediaCacheE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(destVideoPath) })
+
+	if err := db.Create(&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30}).Error; err != nil {
+		t.Fatalf("Failed to seed video in test database: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	editorToken := registerEditor(t)
+	viewerToken := registerSubscriber(t, "PREMIUM")
+
+	type cacheStats struct {
+		Hits    int64 `json:"hits"`
+		Misses  int64 `json:"misses"`
+		Entries []struct {
+			Path string `json:"path"`
+			Hits int64  `json:"hits"`
+		} `json:"entries"`
+	}
+	stats := func(t *testing.T) cacheStats {
+		t.Helper()
+		var respBody cacheStats
+		if status := doJSON(t, http.MethodGet, "/admin/media-cache", editorToken, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		return respBody
+	}
+	entryHits := func(s cacheStats) (int64, bool) {
+		for _, entry := range s.Entries {
+			if entry.Path == videoURLPath {
+				return entry.Hits, true
+			}
+		}
+		return 0, false
+	}
+	stream := func(t *testing.T) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+viewerToken)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+		io.Copy(io.Discard, resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", resp.StatusCode)
+		}
+	}
+
+	t.Run("should only let editors inspect the cache", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/admin/media-cache", registerAndLogin(t), nil, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should serve repeated streams from memory", func(t *testing.T) {
+		before := stats(t)
+		stream(t)
+		stream(t)
+		after := stats(t)
+
+		if after.Misses != before.Misses+1 || after.Hits != before.Hits+1 {
+			t.Errorf("expected 1 miss and 1 hit, but got %d misses and %d hits", after.Misses-before.Misses, after.Hits-before.Hits)
+		}
+		if hits, ok := entryHits(after); !ok || hits != 1 {
+			t.Errorf("expected the video to be cached with 1 hit, but got %d (cached: %v)", hits, ok)
+		}
+	})
+
+	t.Run("should purge by path prefix", func(t *testing.T) {
+		var respBody struct {
+			Purged int `json:"purged"`
+		}
+		if status := doJSON(t, http.MethodDelete, "/admin/media-cache?prefix="+videoURLPath, editorToken, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.Purged != 1 {
+			t.Errorf("expected 1 purged entry, but got %d", respBody.Purged)
+		}
+		if _, ok := entryHits(stats(t)); ok {
+			t.Error("expected the video to be gone from the cache")
+		}
+	})
+}
@@ -0,0 +1,320 @@
+package main_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type route struct {
+	method string
+	path   string
+	// guard is the middleware of the group the route is registered in,
+	// empty for public routes.
+	guard string
+}
+
+// registeredRoutes reads the routes main.go registers on the router,
+// together with the middleware guarding their group.
+func registeredRoutes(t *testing.T) []route {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse main.go: %v", err)
+	}
+
+	routeCall := func(node ast.Node) (string, string, bool) {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return "", "", false
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", "", false
+		}
+		recv, ok := sel.X.(*ast.Ident)
+		if !ok || (recv.Name != "router" && recv.Name != "r") {
+			return "", "", false
+		}
+		method := strings.ToUpper(sel.Sel.Name)
+		if !slices.Contains([]string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}, method) {
+			return "", "", false
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", "", false
+		}
+		path, _ := strconv.Unquote(lit.Value)
+		return method, path, true
+	}
+
+	guards := map[token.Pos]string{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Group" || len(call.Args) != 1 {
+			return true
+		}
+		group, ok := call.Args[0].(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+
+		var guard string
+		ast.Inspect(group.Body, func(node ast.Node) bool {
+			use, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if sel, ok := use.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Use" && len(use.Args) == 1 {
+				if middleware, ok := use.Args[0].(*ast.SelectorExpr); ok {
+					guard = middleware.Sel.Name
+				}
+			}
+			return true
+		})
+		ast.Inspect(group.Body, func(node ast.Node) bool {
+			if _, _, ok := routeCall(node); ok {
+				guards[node.Pos()] = guard
+			}
+			return true
+		})
+		return false
+	})
+
+	var routes []route
+	ast.Inspect(file, func(node ast.Node) bool {
+		if method, path, ok := routeCall(node); ok {
+			routes = append(routes, route{method: method, path: path, guard: guards[node.Pos()]})
+		}
+		return true
+	})
+	if len(routes) == 0 {
+		t.Fatal("Expected main.go to register routes, but found none")
+	}
+	return routes
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components struct {
+		Schemas   map[string]any `json:"schemas"`
+		Responses map[string]any `json:"responses"`
+	} `json:"components"`
+}
+
+type openAPIParameter struct {
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+type openAPIOperation struct {
+	Security    []map[string]any   `json:"security"`
+	Parameters  []openAPIParameter `json:"parameters"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema struct {
+				Properties map[string]any `json:"properties"`
+				Required   []string       `json:"required"`
+			} `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]any `json:"responses"`
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// samplePath fills the parameters of a route path with values handlers
+// accept but that match nothing in the database.
+func samplePath(path string) string {
+	return pathParamPattern.ReplaceAllStringFunc(path, func(param string) string {
+		switch strings.Trim(param, "{}") {
+		case "season":
+			return "1"
+		case "locale":
+			return "pt-BR"
+		case "segment":
+			return "segment0.ts"
+		case "slug":
+			return "missing-" + uuid.NewString()
+		default:
+			return uuid.NewString()
+		}
+	})
+}
+
+func TestOpenAPIContractE2E(t *testing.T) {
+	var doc openAPIDocument
+	if status := doJSON(t, http.MethodGet, "/openapi.json", "", nil, &doc); status != http.StatusOK {
+		t.Fatalf("Expected status code 200 for the OpenAPI document, but got %d", status)
+	}
+	if doc.OpenAPI != "3.1.0" {
+		t.Fatalf("Expected an OpenAPI 3.1.0 document, but got %q", doc.OpenAPI)
+	}
+
+	routes := registeredRoutes(t)
+
+	t.Run("every registered route is documented", func(t *testing.T) {
+		for _, r := range routes {
+			if _, ok := doc.Paths[r.path][strings.ToLower(r.method)]; !ok {
+				t.Errorf("Route %s %s is registered in main.go but missing from the OpenAPI document", r.method, r.path)
+			}
+		}
+	})
+
+	t.Run("every documented operation is registered", func(t *testing.T) {
+		for path, item := range doc.Paths {
+			for method := range item {
+				registered := slices.ContainsFunc(routes, func(r route) bool {
+					return r.path == path && strings.EqualFold(r.method, method)
+				})
+				if !registered {
+					t.Errorf("Operation %s %s is documented but not registered in main.go", strings.ToUpper(method), path)
+				}
+			}
+		}
+	})
+
+	t.Run("security matches the middleware guarding each route", func(t *testing.T) {
+		for _, r := range routes {
+			op, ok := doc.Paths[r.path][strings.ToLower(r.method)]
+			if !ok {
+				continue
+			}
+			anonymous := slices.ContainsFunc(op.Security, func(requirement map[string]any) bool {
+				return len(requirement) == 0
+			})
+			if r.guard == "" && !anonymous {
+				t.Errorf("Public route %s %s is documented as requiring authentication", r.method, r.path)
+			}
+			if r.guard != "" && (anonymous || len(op.Security) == 0) {
+				t.Errorf("Route %s %s is guarded by %s but documented as public", r.method, r.path, r.guard)
+			}
+			if r.guard == "RequireEditor" {
+				if _, ok := op.Responses[strconv.Itoa(http.StatusForbidden)]; !ok {
+					t.Errorf("Editor route %s %s does not document 403", r.method, r.path)
+				}
+			}
+		}
+	})
+
+	t.Run("path parameters match the route", func(t *testing.T) {
+		for path, item := range doc.Paths {
+			for method, op := range item {
+				for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+					documented := slices.ContainsFunc(op.Parameters, func(p openAPIParameter) bool {
+						return p.In == "path" && p.Name == match[1]
+					})
+					if !documented {
+						t.Errorf("Path parameter %s of %s %s is not documented", match[1], strings.ToUpper(method), path)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("references resolve", func(t *testing.T) {
+		var walk func(node any)
+		walk = func(node any) {
+			switch n := node.(type) {
+			case map[string]any:
+				if ref, ok := n["$ref"].(string); ok {
+					name, isSchema := strings.CutPrefix(ref, "#/components/schemas/")
+					if isSchema {
+						if _, ok := doc.Components.Schemas[name]; !ok {
+							t.Errorf("Reference %s does not resolve", ref)
+						}
+					} else if name, ok := strings.CutPrefix(ref, "#/components/responses/"); !ok {
+						t.Errorf("Unexpected reference %s", ref)
+					} else if _, ok := doc.Components.Responses[name]; !ok {
+						t.Errorf("Reference %s does not resolve", ref)
+					}
+				}
+				for _, value := range n {
+					walk(value)
+				}
+			case []any:
+				for _, value := range n {
+					walk(value)
+				}
+			}
+		}
+		for _, item := range doc.Paths {
+			for _, op := range item {
+				walk(op.Responses)
+			}
+		}
+		walk(doc.Components.Schemas)
+	})
+
+	t.Run("movie upload is a multipart form", func(t *testing.T) {
+		op := doc.Paths["/movies"]["post"]
+		if op.RequestBody == nil {
+			t.Fatal("Expected POST /movies to document a request body")
+		}
+		form, ok := op.RequestBody.Content["multipart/form-data"]
+		if !ok {
+			t.Fatal("Expected POST /movies to take multipart/form-data")
+		}
+		for _, field := range []string{"title", "description", "rating_system", "rating", "video", "thumbnail"} {
+			if _, ok := form.Schema.Properties[field]; !ok {
+				t.Errorf("Expected the movie form to document %q", field)
+			}
+		}
+		for _, field := range []string{"title", "video"} {
+			if !slices.Contains(form.Schema.Required, field) {
+				t.Errorf("Expected the movie form to require %q", field)
+			}
+		}
+	})
+
+	t.Run("streaming documents range requests", func(t *testing.T) {
+		op := doc.Paths["/videos/{videoID}/stream"]["get"]
+		for _, status := range []int{http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable} {
+			if _, ok := op.Responses[strconv.Itoa(status)]; !ok {
+				t.Errorf("Expected the stream to document status %d", status)
+			}
+		}
+		hasRange := slices.ContainsFunc(op.Parameters, func(p openAPIParameter) bool {
+			return p.In == "header" && p.Name == "Range"
+		})
+		if !hasRange {
+			t.Error("Expected the stream to document the Range header")
+		}
+	})
+
+	t.Run("anonymous responses are documented", func(t *testing.T) {
+		// Requests without credentials or body exercise the guards and
+		// the validation of every handler without changing any data.
+		for _, r := range routes {
+			op, ok := doc.Paths[r.path][strings.ToLower(r.method)]
+			if !ok {
+				continue
+			}
+			var body map[string]any
+			status := doJSON(t, r.method, samplePath(r.path), "", nil, &body)
+			if _, ok := op.Responses[strconv.Itoa(status)]; !ok {
+				t.Errorf("%s %s answered %d, which is not documented", r.method, r.path, status)
+			}
+			if status >= http.StatusBadRequest && status != http.StatusRequestedRangeNotSatisfiable {
+				code, _ := body["code"].(string)
+				if body["status"] != float64(status) || code == "" || body["type"] != "urn:fakeflix:problem:"+code {
+					t.Errorf("%s %s answered %d without the documented problem document: %v", r.method, r.path, status, body)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package main_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestParentalControlE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	contentID := uuid.NewString()
+	movieID := uuid.NewString()
+
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+
+	ratingSystem, ratingValue := "CLASSIND", "16"
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30},
+		&postgres.ContentModel{
+			ID:            contentID,
+			Title:         "Rated 16 Movie",
+			ContentType:   "MOVIE",
+			RatingSystem:  &ratingSystem,
+			RatingValue:   &ratingValue,
+			MaturityLevel: 16,
+		},
+		&postgres.MovieModel{ID: movieID, ContentID: contentID, VideoID: videoID},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		os.Remove(destVideoPath)
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	token := registerAndLogin(t)
+	// The pin unlocked stream and the playback document each take a stream.
+	subscribe(t, token, "STANDARD")
+	profileID := createProfile(t, token, map[string]any{"name": "Teen", "max_maturity_level": 12, "pin": "1234"})
+
+	streamAs := func(t *testing.T, pin string) int {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Profile-ID", profileID)
+		if pin != "" {
+			req.Header.Set("X-Profile-PIN", pin)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("should block streaming content above the profile level", func(t *testing.T) {
+		if status := streamAs(t, ""); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should allow streaming when the profile pin is provided", func(t *testing.T) {
+		if status := streamAs(t, "1234"); status != http.StatusOK {
+			t.Errorf("expected status code 200, but got %d", status)
+		}
+	})
+
+	t.Run("should carry the pin unlock in signed playback urls", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/contents/"+contentID+"/playback", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Profile-ID", profileID)
+		req.Header.Set("X-Profile-PIN", "1234")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var respBody struct {
+			Manifests []struct {
+				URL string `json:"url"`
+			} `json:"manifests"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", resp.StatusCode)
+		}
+		if len(respBody.Manifests) == 0 {
+			t.Fatalf("expected a manifest, but got none")
+		}
+
+		streamURL := respBody.Manifests[0].URL
+		if status := doJSON(t, http.MethodGet, streamURL, "", nil, nil); status != http.StatusOK {
+			t.Errorf("expected the signed stream url to work without the pin, but got %d", status)
+		}
+		tampered := strings.Replace(streamURL, "pin=1", "pin=0", 1)
+		if status := doJSON(t, http.MethodGet, tampered, "", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for a tampered url, but got %d", status)
+		}
+	})
+
+	t.Run("should hide content above the profile level from the catalog", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/contents?page_size=100", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Profile-ID", profileID)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var respBody struct {
+			Items []struct {
+				ID string `json:"id"`
+			} `json:"items"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+			t.Fatalf("Failed to decode response body: %v", err)
+		}
+		for _, item := range respBody.Items {
+			if item.ID == contentID {
+				t.Errorf("expected content %s to be filtered out of the catalog", contentID)
+			}
+		}
+	})
+
+	t.Run("should lock the pin after too many wrong attempts", func(t *testing.T) {
+		for range 5 {
+			if status := streamAs(t, "0000"); status != http.StatusForbidden {
+				t.Fatalf("expected status code 403 for a wrong pin, but got %d", status)
+			}
+		}
+		if status := streamAs(t, "1234"); status != http.StatusTooManyRequests {
+			t.Errorf("expected status code 429 for the right pin once locked, but got %d", status)
+		}
+	})
+}
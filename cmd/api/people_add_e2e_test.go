@@ -0,0 +1,65 @@
+package main_test
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestPeopleWritesE2E(t *testing.T) {
+	personID := uuid.NewString()
+	contentID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.ContentModel{ID: contentID, Title: "Credited Movie", ContentType: "MOVIE"},
+		&postgres.PersonModel{ID: personID, Name: "John Roe"},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.CreditModel{}, "person_id = ?", personID)
+		db.Unscoped().Delete(&postgres.PersonModel{}, "id = ?", personID)
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		log.Printf("Cleaned up resources for people writes test with ID: %s", personID)
+	})
+
+	viewerToken := registerAndLogin(t)
+	editorToken := registerEditor(t)
+	credit := map[string]any{"content_id": contentID, "role": "ACTOR", "character": "Officer Roe"}
+
+	writes := []struct {
+		name string
+		path string
+		body any
+	}{
+		{name: "create a person", path: "/people", body: nil},
+		{name: "credit a person", path: "/people/" + personID + "/credits", body: credit},
+	}
+
+	for _, write := range writes {
+		t.Run("should refuse anonymous callers to "+write.name, func(t *testing.T) {
+			if status := doJSON(t, http.MethodPost, write.path, "", write.body, nil); status != http.StatusUnauthorized {
+				t.Errorf("expected status code 401, but got %d", status)
+			}
+		})
+
+		t.Run("should refuse viewers to "+write.name, func(t *testing.T) {
+			if status := doJSON(t, http.MethodPost, write.path, viewerToken, write.body, nil); status != http.StatusForbidden {
+				t.Errorf("expected status code 403, but got %d", status)
+			}
+		})
+	}
+
+	t.Run("should let editors credit a person", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPost, "/people/"+personID+"/credits", editorToken, credit, nil); status != http.StatusCreated {
+			t.Errorf("expected status code 201, but got %d", status)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package main_test
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestGetPersonE2E(t *testing.T) {
+	personID := uuid.NewString()
+	movieContentID := uuid.NewString()
+	showContentID := uuid.NewString()
+	showID := uuid.NewString()
+	movieVideoID := uuid.NewString()
+	episodeVideoID := uuid.NewString()
+	episodeID := uuid.NewString()
+	movieID := uuid.NewString()
+	draftContentID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.VideoModel{ID: movieVideoID, URL: "/upload/videos/movie.mp4", SizeInKb: 1, Duration: 30},
+		&postgres.VideoModel{ID: episodeVideoID, URL: "/upload/videos/episode.mp4", SizeInKb: 1, Duration: 30},
+		&postgres.ContentModel{ID: movieContentID, Title: "Credits Movie", ContentType: "MOVIE"},
+		&postgres.ContentModel{ID: showContentID, Title: "Credits Show", ContentType: "TV_SHOW"},
+		&postgres.ContentModel{ID: draftContentID, Title: "Unreleased Movie", ContentType: "MOVIE", Status: "DRAFT"},
+		&postgres.MovieModel{ID: movieID, ContentID: movieContentID, VideoID: movieVideoID},
+		&postgres.TvShowModel{ID: showID, ContentID: showContentID},
+		&postgres.EpisodeModel{ID: episodeID, TvShowID: showID, VideoID: episodeVideoID, Title: "Pilot", Season: 1, Number: 1},
+		&postgres.PersonModel{ID: personID, Name: "Jane Doe", Bio: "An actress and director."},
+		&postgres.CreditModel{ID: uuid.NewString(), PersonID: personID, ContentID: movieContentID, Role: "DIRECTOR"},
+		&postgres.CreditModel{ID: uuid.NewString(), PersonID: personID, ContentID: showContentID, EpisodeID: &episodeID, Role: "ACTOR", CharacterName: "Detective Doe"},
+		&postgres.CreditModel{ID: uuid.NewString(), PersonID: personID, ContentID: draftContentID, Role: "ACTOR"},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.PersonModel{}, "id = ?", personID)
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", []string{movieContentID, showContentID, draftContentID})
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{movieVideoID, episodeVideoID})
+		log.Printf("Cleaned up resources for person test with ID: %s", personID)
+	})
+
+	t.Run("should return the person with filmography across published movies and shows", func(t *testing.T) {
+		resp, err := http.Get(baseAPIURL + "/people/" + personID)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code 200 OK, but got %d", resp.StatusCode)
+		}
+
+		var respBody struct {
+			Name        string `json:"name"`
+			Filmography []struct {
+				ContentID string `json:"content_id"`
+				Role      string `json:"role"`
+				Character string `json:"character"`
+				Episodes  []struct {
+					ID string `json:"id"`
+				} `json:"episodes"`
+			} `json:"filmography"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+			t.Fatalf("Failed to decode response body: %v", err)
+		}
+
+		if respBody.Name != "Jane Doe" {
+			t.Errorf("expected name 'Jane Doe', but got '%s'", respBody.Name)
+		}
+		if len(respBody.Filmography) != 2 {
+			t.Fatalf("expected 2 filmography items, but got %d", len(respBody.Filmography))
+		}
+		for _, item := range respBody.Filmography {
+			if item.ContentID == draftContentID {
+				t.Errorf("expected the draft content to be left out of the filmography, but got %+v", item)
+			}
+			if item.ContentID == showContentID {
+				if item.Character != "Detective Doe" {
+					t.Errorf("expected character 'Detective Doe', but got '%s'", item.Character)
+				}
+				if len(item.Episodes) != 1 || item.Episodes[0].ID != episodeID {
+					t.Errorf("expected the show credit to reference episode %s, but got %+v", episodeID, item.Episodes)
+				}
+			}
+		}
+	})
+
+	t.Run("should return 404 for an unknown person", func(t *testing.T) {
+		resp, err := http.Get(baseAPIURL + "/people/" + uuid.NewString())
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", resp.StatusCode)
+		}
+	})
+}
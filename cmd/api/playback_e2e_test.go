@@ -0,0 +1,202 @@
+package main_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestPlaybackInfoE2E(t *testing.T) {
+	showContentID := uuid.NewString()
+	showID := uuid.NewString()
+	firstEpisodeID, secondEpisodeID := uuid.NewString(), uuid.NewString()
+	firstVideoID, secondVideoID := uuid.NewString(), uuid.NewString()
+
+	seeds := []any{
+		&postgres.ContentModel{ID: showContentID, Title: "Playback Show", ContentType: "TV_SHOW"},
+		&postgres.TvShowModel{ID: showID, ContentID: showContentID},
+	}
+	for i, ids := range [][2]string{{firstEpisodeID, firstVideoID}, {secondEpisodeID, secondVideoID}} {
+		videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", ids[1]))
+		destVideoPath := filepath.Join("..", "..", videoURLPath)
+		os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+		if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+			t.Fatalf("Failed to copy test video file: %v", err)
+		}
+		t.Cleanup(func() { os.Remove(destVideoPath) })
+
+		seeds = append(seeds,
+			&postgres.VideoModel{ID: ids[1], URL: "/" + videoURLPath, SizeInKb: 1, Duration: 100, Height: 720},
+			&postgres.EpisodeModel{ID: ids[0], TvShowID: showID, VideoID: ids[1], Title: fmt.Sprintf("Episode %d", i+1), Season: 1, Number: i + 1},
+		)
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", showContentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{firstVideoID, secondVideoID})
+		os.RemoveAll(filepath.Join("..", "..", "upload", "assets", firstVideoID))
+	})
+
+	editorToken := registerEditor(t)
+	_, token := registerAccount(t)
+	subscribe(t, token, "PREMIUM")
+	token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Viewer"}))
+
+	type track struct {
+		Language string `json:"language"`
+		URL      string `json:"url"`
+	}
+	type playbackInfo struct {
+		VideoID   string `json:"video_id"`
+		SessionID string `json:"session_id"`
+		Episode   *struct {
+			ID string `json:"id"`
+		} `json:"episode"`
+		Manifests []struct {
+			Type string `json:"type"`
+			URL  string `json:"url"`
+		} `json:"manifests"`
+		Subtitles []track `json:"subtitles"`
+		Markers   *struct {
+			CreditsStart *int `json:"credits_start"`
+		} `json:"markers"`
+		ResumePosition int `json:"resume_position"`
+		NextEpisode    *struct {
+			ID string `json:"id"`
+		} `json:"next_episode"`
+	}
+
+	fetch := func(t *testing.T, path string) *http.Response {
+		t.Helper()
+		resp, err := http.Get(baseAPIURL + path)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	var sessionID string
+
+	t.Run("should start a show from its first episode", func(t *testing.T) {
+		var respBody playbackInfo
+		if status := doJSON(t, http.MethodGet, "/contents/"+showContentID+"/playback", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.VideoID != firstVideoID || respBody.Episode == nil || respBody.Episode.ID != firstEpisodeID {
+			t.Errorf("expected the first episode, but got %+v", respBody)
+		}
+		if respBody.NextEpisode == nil || respBody.NextEpisode.ID != secondEpisodeID {
+			t.Errorf("expected the second episode to come next, but got %+v", respBody.NextEpisode)
+		}
+		if respBody.SessionID == "" || len(respBody.Manifests) != 1 || respBody.Manifests[0].Type != "PROGRESSIVE" {
+			t.Fatalf("expected a session and a progressive manifest, but got %+v", respBody)
+		}
+		sessionID = respBody.SessionID
+
+		if resp := fetch(t, respBody.Manifests[0].URL); resp.StatusCode != http.StatusOK {
+			t.Errorf("expected the signed stream url to work without a token, but got %d", resp.StatusCode)
+		}
+		tampered := strings.Replace(respBody.Manifests[0].URL, firstVideoID, secondVideoID, 1)
+		if resp := fetch(t, tampered); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for a tampered url, but got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("should list uploaded subtitles", func(t *testing.T) {
+		subtitlePath := filepath.Join(t.TempDir(), "pt-BR.vtt")
+		os.WriteFile(subtitlePath, []byte("WEBVTT\n\n00:00.000 --> 00:02.000\nOlá\n"), 0o644)
+
+		form := &bytes.Buffer{}
+		writer := multipart.NewWriter(form)
+		writer.WriteField("kind", "SUBTITLE")
+		writer.WriteField("language", "pt_br")
+		addFileToMultipart(t, writer, "file", subtitlePath)
+		writer.Close()
+
+		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/videos/"+firstVideoID+"/assets", form)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+editorToken)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected status code 201, but got %d", resp.StatusCode)
+		}
+
+		var respBody playbackInfo
+		path := "/contents/" + showContentID + "/episodes/" + firstEpisodeID + "/playback?session=" + sessionID
+		if status := doJSON(t, http.MethodGet, path, token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Subtitles) != 1 || respBody.Subtitles[0].Language != "pt-BR" {
+			t.Fatalf("expected the pt-BR subtitle, but got %+v", respBody.Subtitles)
+		}
+		if resp := fetch(t, respBody.Subtitles[0].URL); resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/vtt" {
+			t.Errorf("expected the subtitle file, but got %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+
+		unsigned, _, _ := strings.Cut(respBody.Subtitles[0].URL, "?")
+		if resp := fetch(t, unsigned); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for an unsigned subtitle url, but got %d", resp.StatusCode)
+		}
+		if status := doJSON(t, http.MethodGet, unsigned, registerAndLogin(t), nil, nil); status != http.StatusPaymentRequired {
+			t.Errorf("expected status code 402 for an account without a plan, but got %d", status)
+		}
+	})
+
+	t.Run("should include markers and resume where the profile stopped", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/markers", editorToken, map[string]any{"credits_start": 90}, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200 setting markers, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/progress", token, map[string]any{"position_seconds": 42}, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200 recording progress, but got %d", status)
+		}
+
+		var respBody playbackInfo
+		if status := doJSON(t, http.MethodGet, "/contents/"+showContentID+"/playback?session="+sessionID, token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.VideoID != firstVideoID || respBody.ResumePosition != 42 {
+			t.Errorf("expected to resume the first episode at 42s, but got %+v", respBody)
+		}
+		if respBody.Markers == nil || respBody.Markers.CreditsStart == nil || *respBody.Markers.CreditsStart != 90 {
+			t.Errorf("expected the credits marker, but got %+v", respBody.Markers)
+		}
+	})
+
+	t.Run("should move on to the next episode once one is finished", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/progress", token, map[string]any{"position_seconds": 99}, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200 recording progress, but got %d", status)
+		}
+
+		var respBody playbackInfo
+		if status := doJSON(t, http.MethodGet, "/contents/"+showContentID+"/playback?session="+sessionID, token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.VideoID != secondVideoID || respBody.ResumePosition != 0 || respBody.NextEpisode != nil {
+			t.Errorf("expected the start of the last episode, but got %+v", respBody)
+		}
+	})
+
+	t.Run("should not find unknown episodes", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/contents/"+showContentID+"/episodes/"+uuid.NewString()+"/playback", token, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+}
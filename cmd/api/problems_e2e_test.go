@@ -0,0 +1,153 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type problemDocument struct {
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail"`
+	Instance  string            `json:"instance"`
+	Code      string            `json:"code"`
+	RequestID string            `json:"request_id"`
+	Errors    map[string]string `json:"errors"`
+}
+
+func fetchProblem(t *testing.T, req *http.Request) (*http.Response, problemDocument) {
+	t.Helper()
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to execute request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "application/problem+json" {
+		t.Fatalf("Expected an application/problem+json response, but got %q with status %d", contentType, resp.StatusCode)
+	}
+	var problem problemDocument
+	if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+		t.Fatalf("Failed to decode problem document: %v", err)
+	}
+	if problem.Status != resp.StatusCode {
+		t.Errorf("Expected the problem status to match the response status %d, but got %d", resp.StatusCode, problem.Status)
+	}
+	if problem.Type != "urn:fakeflix:problem:"+problem.Code || problem.Title == "" {
+		t.Errorf("Expected a type derived from code %q and a title, but got %+v", problem.Code, problem)
+	}
+	if problem.RequestID == "" || problem.RequestID != resp.Header.Get("X-Request-ID") {
+		t.Errorf("Expected request_id to match the X-Request-ID header %q, but got %q", resp.Header.Get("X-Request-ID"), problem.RequestID)
+	}
+	return resp, problem
+}
+
+func TestProblemResponsesE2E(t *testing.T) {
+	t.Run("validation errors are reported per field", func(t *testing.T) {
+		payload, _ := json.Marshal(map[string]any{"email": "", "password": "short"})
+		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/accounts", bytes.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Request-ID", "client-trace-42")
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusUnprocessableEntity || problem.Code != "validation_failed" {
+			t.Fatalf("Expected a 422 validation_failed problem, but got %d %q", resp.StatusCode, problem.Code)
+		}
+		if problem.RequestID != "client-trace-42" {
+			t.Errorf("Expected the client request id to be kept, but got %q", problem.RequestID)
+		}
+		if problem.Instance != "/accounts" {
+			t.Errorf("Expected instance /accounts, but got %q", problem.Instance)
+		}
+		if problem.Errors["email"] != "email is required" {
+			t.Errorf("Expected an email field error, but got %v", problem.Errors)
+		}
+		if _, ok := problem.Errors["password"]; !ok || len(problem.Errors) != 2 {
+			t.Errorf("Expected only email and password field errors, but got %v", problem.Errors)
+		}
+	})
+
+	t.Run("form fields are keyed by their form name", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		writer.WriteField("rating", "PG-13")
+		writer.Close()
+
+		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/movies", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+registerEditor(t))
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusUnprocessableEntity {
+			t.Fatalf("Expected status code 422, but got %d", resp.StatusCode)
+		}
+		for _, field := range []string{"title", "description", "rating_system", "video"} {
+			if _, ok := problem.Errors[field]; !ok {
+				t.Errorf("Expected a %q field error, but got %v", field, problem.Errors)
+			}
+		}
+	})
+
+	t.Run("malformed query parameters are field errors", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/trending?limit=many", nil)
+
+		_, problem := fetchProblem(t, req)
+		if problem.Errors["limit"] != "must be an integer" {
+			t.Errorf("Expected a limit field error, but got %v", problem.Errors)
+		}
+	})
+
+	t.Run("missing resources carry no field errors", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/people/"+uuid.NewString(), nil)
+		req.Header.Set("X-Request-ID", "not a valid id!")
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusNotFound || problem.Code != "not_found" {
+			t.Fatalf("Expected a 404 not_found problem, but got %d %q", resp.StatusCode, problem.Code)
+		}
+		if problem.Detail == "" || problem.Errors != nil {
+			t.Errorf("Expected a detail and no field errors, but got %+v", problem)
+		}
+		if _, err := uuid.Parse(problem.RequestID); err != nil {
+			t.Errorf("Expected a malformed request id to be replaced by a generated one, but got %q", problem.RequestID)
+		}
+	})
+
+	t.Run("unknown routes are problem documents", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/no-such-route", nil)
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusNotFound || problem.Code != "not_found" {
+			t.Errorf("Expected a 404 not_found problem, but got %d %q", resp.StatusCode, problem.Code)
+		}
+		if problem.Instance != "/no-such-route" {
+			t.Errorf("Expected instance /no-such-route, but got %q", problem.Instance)
+		}
+	})
+
+	t.Run("unsupported methods are problem documents", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPatch, baseAPIURL+"/plans", nil)
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusMethodNotAllowed || problem.Code != "method_not_allowed" {
+			t.Errorf("Expected a 405 method_not_allowed problem, but got %d %q", resp.StatusCode, problem.Code)
+		}
+	})
+
+	t.Run("faults can carry a specific code", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/me/profiles", nil)
+		req.Header.Set("Authorization", "Bearer not-a-token")
+
+		resp, problem := fetchProblem(t, req)
+		if resp.StatusCode != http.StatusUnauthorized || problem.Code != "invalid_access_token" {
+			t.Errorf("Expected a 401 invalid_access_token problem, but got %d %q", resp.StatusCode, problem.Code)
+		}
+	})
+}
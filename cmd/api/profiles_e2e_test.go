@@ -0,0 +1,75 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestProfilesE2E(t *testing.T) {
+	token := registerAndLogin(t)
+	otherToken := registerAndLogin(t)
+
+	adultID := createProfile(t, token, map[string]any{"name": "Adult", "language": "en-US"})
+	kidsID := createProfile(t, token, map[string]any{"name": "Kids", "kids": true})
+
+	t.Run("should list only the profiles of the account", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				ID               string `json:"id"`
+				Kids             bool   `json:"kids"`
+				MaxMaturityLevel int    `json:"max_maturity_level"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/profiles", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 2 {
+			t.Fatalf("expected 2 profiles, but got %d", len(respBody.Items))
+		}
+		for _, item := range respBody.Items {
+			if item.ID == kidsID && (!item.Kids || item.MaxMaturityLevel > 10) {
+				t.Errorf("expected kids profile to be capped at level 10, but got %+v", item)
+			}
+		}
+	})
+
+	t.Run("should issue a token carrying the selected profile", func(t *testing.T) {
+		var respBody struct {
+			AccessToken string `json:"access_token"`
+			ProfileID   string `json:"profile_id"`
+		}
+		if status := doJSON(t, http.MethodPost, "/me/profiles/"+adultID+"/select", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.ProfileID != adultID || respBody.AccessToken == "" {
+			t.Errorf("expected a token for profile %s, but got %+v", adultID, respBody)
+		}
+	})
+
+	t.Run("should reject selecting a profile from another account", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/contents", nil)
+		req.Header.Set("Authorization", "Bearer "+otherToken)
+		req.Header.Set("X-Profile-ID", adultID)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("should require authentication for profile management", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/me/profiles", "", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401, but got %d", status)
+		}
+	})
+
+	t.Run("should delete a profile", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/me/profiles/"+kidsID, token, nil, nil); status != http.StatusNoContent {
+			t.Errorf("expected status code 204, but got %d", status)
+		}
+	})
+}
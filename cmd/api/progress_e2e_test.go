@@ -0,0 +1,101 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestContinueWatchingE2E(t *testing.T) {
+	showContentID := uuid.NewString()
+	showID := uuid.NewString()
+	firstVideoID := uuid.NewString()
+	secondVideoID := uuid.NewString()
+	secondEpisodeID := uuid.NewString()
+	movieContentID := uuid.NewString()
+	movieVideoID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.VideoModel{ID: firstVideoID, URL: "/upload/videos/s01e01.mp4", SizeInKb: 1, Duration: 100},
+		&postgres.VideoModel{ID: secondVideoID, URL: "/upload/videos/s01e02.mp4", SizeInKb: 1, Duration: 120},
+		&postgres.VideoModel{ID: movieVideoID, URL: "/upload/videos/progress-movie.mp4", SizeInKb: 1, Duration: 200},
+		&postgres.ContentModel{ID: showContentID, Title: "Progress Show", ContentType: "TV_SHOW"},
+		&postgres.ContentModel{ID: movieContentID, Title: "Progress Movie", ContentType: "MOVIE"},
+		&postgres.TvShowModel{ID: showID, ContentID: showContentID},
+		&postgres.EpisodeModel{ID: uuid.NewString(), TvShowID: showID, VideoID: firstVideoID, Title: "First", Season: 1, Number: 1},
+		&postgres.EpisodeModel{ID: secondEpisodeID, TvShowID: showID, VideoID: secondVideoID, Title: "Second", Season: 1, Number: 2},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: movieContentID, VideoID: movieVideoID},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", []string{showContentID, movieContentID})
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{firstVideoID, secondVideoID, movieVideoID})
+	})
+
+	token := registerAndLogin(t)
+	token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Viewer"}))
+
+	t.Run("should mark a video as completed near its end", func(t *testing.T) {
+		var respBody struct {
+			Completed bool `json:"completed"`
+		}
+		status := doJSON(t, http.MethodPut, "/videos/"+firstVideoID+"/progress", token, map[string]any{"position_seconds": 98}, &respBody)
+		if status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if !respBody.Completed {
+			t.Errorf("expected progress at 98 of 100 seconds to be completed")
+		}
+	})
+
+	t.Run("should record partial progress of a movie", func(t *testing.T) {
+		status := doJSON(t, http.MethodPut, "/videos/"+movieVideoID+"/progress", token, map[string]any{"position_seconds": 50}, nil)
+		if status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+	})
+
+	t.Run("should suggest the next episode and resume the movie", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				Content struct {
+					ID string `json:"id"`
+				} `json:"content"`
+				VideoID         string `json:"video_id"`
+				PositionSeconds int    `json:"position_seconds"`
+				NextEpisode     bool   `json:"next_episode"`
+				Episode         *struct {
+					ID string `json:"id"`
+				} `json:"episode"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/continue-watching", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 2 {
+			t.Fatalf("expected 2 items, but got %d", len(respBody.Items))
+		}
+
+		movieItem, showItem := respBody.Items[0], respBody.Items[1]
+		if movieItem.Content.ID != movieContentID || movieItem.PositionSeconds != 50 {
+			t.Errorf("expected the movie to resume at 50 seconds, but got %+v", movieItem)
+		}
+		if !showItem.NextEpisode || showItem.VideoID != secondVideoID || showItem.Episode == nil || showItem.Episode.ID != secondEpisodeID {
+			t.Errorf("expected the next episode %s to be suggested, but got %+v", secondEpisodeID, showItem)
+		}
+	})
+
+	t.Run("should require a selected profile", func(t *testing.T) {
+		plainToken := registerAndLogin(t)
+		if status := doJSON(t, http.MethodGet, "/me/continue-watching", plainToken, nil, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+}
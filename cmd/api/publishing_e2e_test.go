@@ -0,0 +1,111 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestPublishingE2E(t *testing.T) {
+	genre := "publishing-" + uuid.NewString()[:8]
+	contentID := uuid.NewString()
+	videoID := uuid.NewString()
+
+	seeds := []any{
+		&postgres.VideoModel{ID: videoID, URL: "/upload/videos/draft.mp4", SizeInKb: 1, Duration: 60},
+		&postgres.ContentModel{ID: contentID, Title: "Draft Movie", ContentType: "MOVIE", Status: "DRAFT"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: contentID, VideoID: videoID},
+		&postgres.ContentGenreModel{ContentID: contentID, Genre: genre},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	editorToken := registerEditor(t)
+	statusPath := "/contents/" + contentID + "/status"
+
+	type listing struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+	}
+	listGenre := func(t *testing.T) listing {
+		t.Helper()
+		var respBody listing
+		if status := doJSON(t, http.MethodGet, "/contents?genre="+genre, "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		return respBody
+	}
+
+	t.Run("should hide drafts from the catalog and the stream", func(t *testing.T) {
+		if got := listGenre(t); len(got.Items) != 0 {
+			t.Errorf("expected no published contents, but got %+v", got.Items)
+		}
+		if status := doJSON(t, http.MethodGet, "/videos/"+videoID+"/stream", registerSubscriber(t, "BASIC"), nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404 when streaming a draft, but got %d", status)
+		}
+	})
+
+	t.Run("should only let editors change the status", func(t *testing.T) {
+		viewerToken := registerAndLogin(t)
+		if status := doJSON(t, http.MethodPut, statusPath, viewerToken, map[string]any{"status": "IN_REVIEW"}, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should reject skipping the review", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, statusPath, editorToken, map[string]any{"status": "PUBLISHED"}, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409, but got %d", status)
+		}
+	})
+
+	t.Run("should publish a scheduled content at its publish time", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, statusPath, editorToken, map[string]any{"status": "IN_REVIEW"}, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200 when submitting for review, but got %d", status)
+		}
+
+		if status := doJSON(t, http.MethodPut, statusPath, editorToken, map[string]any{"status": "SCHEDULED"}, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409 when scheduling without a publish time, but got %d", status)
+		}
+
+		var respBody struct {
+			Status string `json:"status"`
+		}
+		schedule := map[string]any{"status": "SCHEDULED", "publish_at": time.Now().Add(2 * time.Second).UTC()}
+		if status := doJSON(t, http.MethodPut, statusPath, editorToken, schedule, &respBody); status != http.StatusOK || respBody.Status != "SCHEDULED" {
+			t.Fatalf("expected status code 200 with a scheduled content, but got %d and %+v", status, respBody)
+		}
+
+		// Scheduled contents are flipped by a background job, so poll.
+		var got listing
+		for range 20 {
+			if got = listGenre(t); len(got.Items) > 0 {
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+		if len(got.Items) != 1 || got.Items[0].ID != contentID {
+			t.Fatalf("expected the scheduled content to be published, but got %+v", got.Items)
+		}
+	})
+
+	t.Run("should hide the content again once unpublished", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, statusPath, editorToken, map[string]any{"status": "UNPUBLISHED"}, nil); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if got := listGenre(t); len(got.Items) != 0 {
+			t.Errorf("expected the unpublished content to be hidden, but got %+v", got.Items)
+		}
+	})
+}
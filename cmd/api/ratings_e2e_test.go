@@ -0,0 +1,109 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestRatingsE2E(t *testing.T) {
+	contentID := uuid.NewString()
+	seed := &postgres.ContentModel{ID: contentID, Title: "Rated Show", ContentType: "TV_SHOW"}
+	if err := db.Create(seed).Error; err != nil {
+		t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id = ?", contentID)
+	})
+
+	token := registerAndLogin(t)
+	fanToken := selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Fan"}))
+	criticToken := selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Critic"}))
+
+	type score struct {
+		ThumbsUp     int     `json:"thumbs_up"`
+		ThumbsDown   int     `json:"thumbs_down"`
+		StarsCount   int     `json:"stars_count"`
+		AverageStars float64 `json:"average_stars"`
+	}
+	type myRating struct {
+		Thumb string `json:"thumb"`
+		Stars int    `json:"stars"`
+	}
+
+	rate := func(t *testing.T, token string, body map[string]any) score {
+		t.Helper()
+		var respBody struct {
+			Score score `json:"score"`
+		}
+		if status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/rating", token, body, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		return respBody.Score
+	}
+
+	t.Run("should aggregate ratings from different profiles", func(t *testing.T) {
+		rate(t, fanToken, map[string]any{"thumb": "UP", "stars": 5})
+		got := rate(t, criticToken, map[string]any{"thumb": "DOWN", "stars": 2})
+
+		if got.ThumbsUp != 1 || got.ThumbsDown != 1 || got.StarsCount != 2 || got.AverageStars != 3.5 {
+			t.Errorf("unexpected score after two ratings: %+v", got)
+		}
+	})
+
+	t.Run("should replace the previous rating of a profile", func(t *testing.T) {
+		got := rate(t, criticToken, map[string]any{"thumb": "UP"})
+
+		if got.ThumbsUp != 2 || got.ThumbsDown != 0 || got.StarsCount != 1 || got.AverageStars != 5 {
+			t.Errorf("unexpected score after changing a rating: %+v", got)
+		}
+	})
+
+	t.Run("should reject stars out of range", func(t *testing.T) {
+		status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/rating", fanToken, map[string]any{"thumb": "UP", "stars": 6}, nil)
+		if status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+
+	t.Run("should include score and own rating in the catalog", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				ID       string    `json:"id"`
+				Score    *score    `json:"score"`
+				MyRating *myRating `json:"my_rating"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/contents?page_size=100", fanToken, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+
+		for _, item := range respBody.Items {
+			if item.ID != contentID {
+				continue
+			}
+			if item.Score == nil || item.Score.ThumbsUp != 2 {
+				t.Errorf("expected 2 thumbs up in the catalog score, but got %+v", item.Score)
+			}
+			if item.MyRating == nil || item.MyRating.Thumb != "UP" || item.MyRating.Stars != 5 {
+				t.Errorf("expected own rating UP with 5 stars, but got %+v", item.MyRating)
+			}
+			return
+		}
+		t.Errorf("expected content %s to be listed", contentID)
+	})
+
+	t.Run("should take a cleared rating out of the score", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/contents/"+contentID+"/rating", fanToken, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+
+		got := rate(t, criticToken, map[string]any{"thumb": "UP"})
+		if got.ThumbsUp != 1 || got.StarsCount != 0 {
+			t.Errorf("unexpected score after clearing a rating: %+v", got)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestRecommendationsE2E(t *testing.T) {
+	heistID, sequelID, comedyID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	taxonomies := map[string]map[string]any{
+		heistID:  {"genres": []string{"Crime", "Drama"}, "tags": []string{"heist", "Tokyo"}},
+		sequelID: {"genres": []string{"crime"}, "tags": []string{"Heist"}},
+		comedyID: {"genres": []string{"Comedy"}, "tags": []string{"sitcom"}},
+	}
+	for contentID := range taxonomies {
+		seed := &postgres.ContentModel{ID: contentID, Title: "Recommendable " + contentID, ContentType: "TV_SHOW"}
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", []string{heistID, sequelID, comedyID})
+	})
+
+	editorToken := registerEditor(t)
+
+	t.Run("should refuse taxonomy changes from viewers", func(t *testing.T) {
+		body := taxonomies[heistID]
+		if status := doJSON(t, http.MethodPut, "/contents/"+heistID+"/taxonomy", "", body, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401 for anonymous callers, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodPut, "/contents/"+heistID+"/taxonomy", registerAndLogin(t), body, nil); status != http.StatusForbidden {
+			t.Errorf("expected status code 403 for viewers, but got %d", status)
+		}
+	})
+
+	t.Run("should normalize genres and tags", func(t *testing.T) {
+		for contentID, body := range taxonomies {
+			var respBody struct {
+				Genres []string `json:"genres"`
+			}
+			if status := doJSON(t, http.MethodPut, "/contents/"+contentID+"/taxonomy", editorToken, body, &respBody); status != http.StatusOK {
+				t.Fatalf("expected status code 200, but got %d", status)
+			}
+			if contentID == heistID && (len(respBody.Genres) != 2 || respBody.Genres[0] != "crime") {
+				t.Errorf("expected lowercase genres, but got %v", respBody.Genres)
+			}
+		}
+	})
+
+	token := registerAndLogin(t)
+	token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Heist Fan"}))
+	if status := doJSON(t, http.MethodPut, "/contents/"+heistID+"/rating", token, map[string]any{"thumb": "UP", "stars": 5}, nil); status != http.StatusOK {
+		t.Fatalf("expected status code 200 when rating, but got %d", status)
+	}
+
+	type listing struct {
+		Items []struct {
+			Content struct {
+				ID string `json:"id"`
+			} `json:"content"`
+			Score float64 `json:"score"`
+		} `json:"items"`
+		Personalized bool `json:"personalized"`
+	}
+
+	// The cache is rebuilt by a background job, so poll until it catches up.
+	waitFor := func(t *testing.T, path string, ready func(listing) bool) listing {
+		t.Helper()
+		var respBody listing
+		for range 20 {
+			respBody = listing{}
+			if status := doJSON(t, http.MethodGet, path, token, nil, &respBody); status != http.StatusOK {
+				t.Fatalf("expected status code 200, but got %d", status)
+			}
+			if ready(respBody) {
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+		return respBody
+	}
+
+	t.Run("should list contents sharing genres, tags and people", func(t *testing.T) {
+		got := waitFor(t, "/contents/"+heistID+"/similar", func(l listing) bool { return len(l.Items) > 0 })
+
+		if len(got.Items) == 0 || got.Items[0].Content.ID != sequelID {
+			t.Fatalf("expected %s to be the most similar content, but got %+v", sequelID, got.Items)
+		}
+		for _, item := range got.Items {
+			if item.Content.ID == comedyID {
+				t.Errorf("expected unrelated content %s not to be similar", comedyID)
+			}
+		}
+	})
+
+	t.Run("should recommend contents close to what the profile liked", func(t *testing.T) {
+		got := waitFor(t, "/me/recommendations", func(l listing) bool { return l.Personalized })
+
+		if !got.Personalized {
+			t.Fatalf("expected personalized recommendations after rating a content")
+		}
+		var found bool
+		for _, item := range got.Items {
+			switch item.Content.ID {
+			case heistID:
+				t.Errorf("expected the rated content not to be recommended again")
+			case comedyID:
+				t.Errorf("expected unrelated content %s not to be recommended", comedyID)
+			case sequelID:
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %s to be recommended, but got %+v", sequelID, got.Items)
+		}
+	})
+}
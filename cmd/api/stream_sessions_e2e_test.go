@@ -0,0 +1,204 @@
+package main_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestStreamSessionsE2E(t *testing.T) {
+	videoID := uuid.NewString()
+	videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+	destVideoPath := filepath.Join("..", "..", videoURLPath)
+	os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+	if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+		t.Fatalf("Failed to copy test video file: %v", err)
+	}
+	t.Cleanup(func() { os.Remove(destVideoPath) })
+
+	if err := db.Create(&postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30, Height: 720}).Error; err != nil {
+		t.Fatalf("Failed to seed video in test database: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id = ?", videoID)
+	})
+
+	credentials := map[string]string{
+		"email":    fmt.Sprintf("%s@fakeflix.test", uuid.NewString()),
+		"password": "super-secret",
+	}
+	var account struct {
+		ID string `json:"id"`
+	}
+	if status := doJSON(t, http.MethodPost, "/accounts", "", credentials, &account); status != http.StatusCreated {
+		t.Fatalf("Expected status code 201 when registering, but got %d", status)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.AccountModel{}, "id = ?", account.ID)
+	})
+	accountID := account.ID
+
+	// login signs in on a new device and returns its access token.
+	login := func(t *testing.T) string {
+		t.Helper()
+		var respBody struct {
+			AccessToken string `json:"access_token"`
+		}
+		if status := doJSON(t, http.MethodPost, "/auth/login", "", credentials, &respBody); status != http.StatusOK {
+			t.Fatalf("Expected status code 200 when logging in, but got %d", status)
+		}
+		return respBody.AccessToken
+	}
+	token, otherToken := login(t), login(t)
+	subscribe(t, token, "BASIC")
+
+	// streamRange requests rangeHeader of the video, continuing sessionID
+	// when it is not empty, and returns the status code and the session of
+	// the response.
+	streamRange := func(t *testing.T, token, sessionID, rangeHeader string) (int, string) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if sessionID != "" {
+			req.Header.Set("X-Playback-Session", sessionID)
+		}
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return resp.StatusCode, resp.Header.Get("X-Playback-Session")
+	}
+	stream := func(t *testing.T, token, sessionID string) (int, string) {
+		t.Helper()
+		return streamRange(t, token, sessionID, "")
+	}
+
+	var sessionID string
+
+	t.Run("should open a session on the first stream", func(t *testing.T) {
+		var status int
+		if status, sessionID = stream(t, token, ""); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if sessionID == "" {
+			t.Fatal("expected a playback session header")
+		}
+	})
+
+	t.Run("should keep the session of the device on further requests", func(t *testing.T) {
+		if status, got := stream(t, token, ""); status != http.StatusOK || got != sessionID {
+			t.Errorf("expected status code 200 on session %s, but got %d on %q", sessionID, status, got)
+		}
+	})
+
+	t.Run("should record one start and one completion per session", func(t *testing.T) {
+		for _, rangeHeader := range []string{"bytes=0-", "bytes=-1024", "bytes=0-"} {
+			if status, _ := streamRange(t, token, sessionID, rangeHeader); status != http.StatusPartialContent {
+				t.Fatalf("expected status code 206 for %s, but got %d", rangeHeader, status)
+			}
+		}
+
+		counts := map[string]int64{}
+		for _, eventType := range []string{"START", "COMPLETE"} {
+			var count int64
+			db.Model(&postgres.PlaybackEventModel{}).Where("video_id = ? AND event_type = ?", videoID, eventType).Count(&count)
+			counts[eventType] = count
+		}
+		if counts["START"] != 1 || counts["COMPLETE"] != 1 {
+			t.Errorf("expected one start and one completion, but got %v", counts)
+		}
+	})
+
+	t.Run("should refuse streams over the plan limit", func(t *testing.T) {
+		if status, _ := stream(t, otherToken, ""); status != http.StatusTooManyRequests {
+			t.Errorf("expected status code 429 for a second stream on BASIC, but got %d", status)
+		}
+	})
+
+	t.Run("should refuse resuming the session from another device", func(t *testing.T) {
+		if status, _ := stream(t, otherToken, sessionID); status != http.StatusForbidden {
+			t.Errorf("expected status code 403, but got %d", status)
+		}
+	})
+
+	t.Run("should keep streaming on the open session", func(t *testing.T) {
+		if status, got := stream(t, token, sessionID); status != http.StatusOK || got != sessionID {
+			t.Errorf("expected status code 200 on session %s, but got %d on %q", sessionID, status, got)
+		}
+		if status := doJSON(t, http.MethodPut, "/me/sessions/"+sessionID+"/heartbeat", token, nil, nil); status != http.StatusNoContent {
+			t.Errorf("expected status code 204, but got %d", status)
+		}
+	})
+
+	t.Run("should refuse heartbeats moving the session to another video", func(t *testing.T) {
+		body := map[string]any{"video_id": uuid.NewString()}
+		if status := doJSON(t, http.MethodPut, "/me/sessions/"+sessionID+"/heartbeat", token, body, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409, but got %d", status)
+		}
+		body = map[string]any{"video_id": videoID}
+		if status := doJSON(t, http.MethodPut, "/me/sessions/"+sessionID+"/heartbeat", token, body, nil); status != http.StatusNoContent {
+			t.Errorf("expected status code 204 for the current video, but got %d", status)
+		}
+	})
+
+	t.Run("should list the active sessions", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				ID      string `json:"id"`
+				VideoID string `json:"video_id"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/sessions", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 1 || respBody.Items[0].ID != sessionID || respBody.Items[0].VideoID != videoID {
+			t.Errorf("expected only session %s, but got %+v", sessionID, respBody.Items)
+		}
+	})
+
+	t.Run("should hide sessions from other accounts", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/me/sessions/"+sessionID, registerAndLogin(t), nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should free the stream once terminated", func(t *testing.T) {
+		if status := doJSON(t, http.MethodDelete, "/me/sessions/"+sessionID, token, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+		if status, _ := stream(t, token, sessionID); status != http.StatusConflict {
+			t.Errorf("expected status code 409 on a terminated session, but got %d", status)
+		}
+
+		var status int
+		if status, sessionID = stream(t, token, ""); status != http.StatusOK {
+			t.Errorf("expected status code 200 for a new stream, but got %d", status)
+		}
+	})
+
+	t.Run("should free the stream once heartbeats stop", func(t *testing.T) {
+		stale := time.Now().Add(-time.Hour)
+		if err := db.Model(&postgres.PlaybackSessionModel{}).Where("account_id = ?", accountID).Update("last_seen_at", stale).Error; err != nil {
+			t.Fatalf("Failed to age the playback sessions: %v", err)
+		}
+
+		if status, _ := stream(t, token, ""); status != http.StatusOK {
+			t.Errorf("expected status code 200, but got %d", status)
+		}
+		if status, _ := stream(t, token, sessionID); status != http.StatusConflict {
+			t.Errorf("expected status code 409 on a timed out session, but got %d", status)
+		}
+	})
+}
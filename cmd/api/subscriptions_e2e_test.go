@@ -0,0 +1,167 @@
+package main_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestSubscriptionsE2E(t *testing.T) {
+	hdVideoID := uuid.NewString()
+	fullHDVideoID := uuid.NewString()
+
+	var seeds []any
+	for videoID, height := range map[string]int{hdVideoID: 720, fullHDVideoID: 1080} {
+		videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+		destVideoPath := filepath.Join("..", "..", videoURLPath)
+		os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+		if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+			t.Fatalf("Failed to copy test video file: %v", err)
+		}
+		t.Cleanup(func() { os.Remove(destVideoPath) })
+
+		seeds = append(seeds, &postgres.VideoModel{ID: videoID, URL: "/" + videoURLPath, SizeInKb: 1, Duration: 30, Height: height})
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{hdVideoID, fullHDVideoID})
+		os.Remove(filepath.Join("..", "..", "upload", "renditions", fullHDVideoID+"_720p.mp4"))
+	})
+
+	accountID, token := registerAccount(t)
+
+	streamAs := func(t *testing.T, token, videoID string) int {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("should list the plans", func(t *testing.T) {
+		var respBody struct {
+			Items []struct {
+				Code       string `json:"code"`
+				MaxStreams int    `json:"max_streams"`
+				MaxHeight  int    `json:"max_height"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/plans", "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if len(respBody.Items) != 3 || respBody.Items[0].Code != "BASIC" || respBody.Items[0].MaxHeight != 720 {
+			t.Errorf("expected the seeded plans starting with BASIC, but got %+v", respBody.Items)
+		}
+	})
+
+	t.Run("should refuse streams without a subscription", func(t *testing.T) {
+		if status := streamAs(t, token, hdVideoID); status != http.StatusPaymentRequired {
+			t.Errorf("expected status code 402, but got %d", status)
+		}
+		if status := doJSON(t, http.MethodGet, "/me/subscription", token, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+
+	t.Run("should reject unknown plans", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/me/subscription", token, map[string]any{"plan": "PLATINUM"}, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+
+	t.Run("should cap the resolution to the plan", func(t *testing.T) {
+		subscribe(t, token, "BASIC")
+
+		if status := streamAs(t, token, hdVideoID); status != http.StatusOK {
+			t.Errorf("expected status code 200 for a 720p video, but got %d", status)
+		}
+
+		// BASIC allows a single stream, so the 1080p video is played on the
+		// session the 720p one opened.
+		var sessions struct {
+			Items []struct {
+				ID string `json:"id"`
+			} `json:"items"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/sessions", token, nil, &sessions); status != http.StatusOK || len(sessions.Items) != 1 {
+			t.Fatalf("expected one session with status 200, but got %d sessions and status %d", len(sessions.Items), status)
+		}
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+fullHDVideoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Playback-Session", sessions.Items[0].ID)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		read, _ := io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		original, _ := os.Stat(filepath.Join("..", "..", "upload", "videos", fullHDVideoID+".mp4"))
+		if resp.StatusCode != http.StatusOK || read == original.Size() {
+			t.Errorf("expected a scaled down copy of the 1080p video with status 200, but got %d bytes and status %d", read, resp.StatusCode)
+		}
+		if _, err := os.Stat(filepath.Join("..", "..", "upload", "renditions", fullHDVideoID+"_720p.mp4")); err != nil {
+			t.Errorf("expected a 720p rendition of the 1080p video, but got %v", err)
+		}
+		if status := doJSON(t, http.MethodPut, "/me/subscription", token, map[string]any{"plan": "BASIC"}, nil); status != http.StatusConflict {
+			t.Errorf("expected status code 409 when subscribing twice, but got %d", status)
+		}
+	})
+
+	t.Run("should unlock higher resolutions on upgrade", func(t *testing.T) {
+		subscribe(t, token, "PREMIUM")
+
+		if status := streamAs(t, token, fullHDVideoID); status != http.StatusOK {
+			t.Errorf("expected status code 200 for a 1080p video on PREMIUM, but got %d", status)
+		}
+	})
+
+	t.Run("should keep a canceled subscription until the period ends", func(t *testing.T) {
+		var respBody struct {
+			Status   string `json:"status"`
+			Entitled bool   `json:"entitled"`
+		}
+		if status := doJSON(t, http.MethodDelete, "/me/subscription", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.Status != "CANCELED" || !respBody.Entitled {
+			t.Errorf("expected a canceled but entitled subscription, but got %+v", respBody)
+		}
+		if status := streamAs(t, token, hdVideoID); status != http.StatusOK {
+			t.Errorf("expected status code 200, but got %d", status)
+		}
+	})
+
+	t.Run("should refuse streams once the period is over", func(t *testing.T) {
+		ended := time.Now().Add(-time.Hour)
+		if err := db.Model(&postgres.SubscriptionModel{}).Where("account_id = ?", accountID).Update("current_period_end", ended).Error; err != nil {
+			t.Fatalf("Failed to end the subscription period: %v", err)
+		}
+
+		if status := streamAs(t, token, hdVideoID); status != http.StatusPaymentRequired {
+			t.Errorf("expected status code 402, but got %d", status)
+		}
+	})
+
+	t.Run("should refuse anonymous viewers", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/videos/"+hdVideoID+"/stream", "", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected status code 401, but got %d", status)
+		}
+	})
+}
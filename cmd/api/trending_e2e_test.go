@@ -0,0 +1,137 @@
+package main_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestTrendingE2E(t *testing.T) {
+	hitVideoID, nicheVideoID := uuid.NewString(), uuid.NewString()
+	hitContentID, nicheContentID := uuid.NewString(), uuid.NewString()
+
+	var videoPaths []string
+	for _, videoID := range []string{hitVideoID, nicheVideoID} {
+		videoURLPath := filepath.Join("upload", "videos", fmt.Sprintf("%s.mp4", videoID))
+		destVideoPath := filepath.Join("..", "..", videoURLPath)
+		os.MkdirAll(filepath.Dir(destVideoPath), os.ModePerm)
+		if err := copyFile(filepath.Join("..", "..", "testdata", "sample.mp4"), destVideoPath); err != nil {
+			t.Fatalf("Failed to copy test video file: %v", err)
+		}
+		videoPaths = append(videoPaths, destVideoPath)
+	}
+
+	seeds := []any{
+		&postgres.VideoModel{ID: hitVideoID, URL: "/upload/videos/" + hitVideoID + ".mp4", SizeInKb: 1, Duration: 30},
+		&postgres.VideoModel{ID: nicheVideoID, URL: "/upload/videos/" + nicheVideoID + ".mp4", SizeInKb: 1, Duration: 30},
+		&postgres.ContentModel{ID: hitContentID, Title: "Trending Hit", ContentType: "MOVIE"},
+		&postgres.ContentModel{ID: nicheContentID, Title: "Trending Niche", ContentType: "MOVIE"},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: hitContentID, VideoID: hitVideoID},
+		&postgres.MovieModel{ID: uuid.NewString(), ContentID: nicheContentID, VideoID: nicheVideoID},
+	}
+	for _, seed := range seeds {
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		for _, path := range videoPaths {
+			os.Remove(path)
+		}
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", []string{hitContentID, nicheContentID})
+		db.Unscoped().Delete(&postgres.VideoModel{}, "id IN ?", []string{hitVideoID, nicheVideoID})
+	})
+
+	token := registerSubscriber(t, "STANDARD")
+	// stream plays the video on a session of its own, terminating it once
+	// rangeHeader was served.
+	stream := func(t *testing.T, videoID, rangeHeader string) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to execute request: %v", err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+			t.Fatalf("expected a successful stream, but got %d", resp.StatusCode)
+		}
+		sessionID := resp.Header.Get("X-Playback-Session")
+		if status := doJSON(t, http.MethodDelete, "/me/sessions/"+sessionID, token, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204 terminating the session, but got %d", status)
+		}
+	}
+
+	for range 3 {
+		stream(t, hitVideoID, "bytes=0-")
+	}
+	stream(t, nicheVideoID, "bytes=0-1023")
+
+	type trendingList struct {
+		Title string `json:"title"`
+		Items []struct {
+			Rank    int `json:"rank"`
+			Content struct {
+				ID string `json:"id"`
+			} `json:"content"`
+		} `json:"items"`
+	}
+	ranks := func(list trendingList) map[string]int {
+		found := make(map[string]int)
+		for _, item := range list.Items {
+			found[item.Content.ID] = item.Rank
+		}
+		return found
+	}
+
+	t.Run("should rank contents by recent playback", func(t *testing.T) {
+		var found map[string]int
+		// The ranking is rolled up by a background job, so poll until it catches up.
+		for range 20 {
+			var respBody trendingList
+			if status := doJSON(t, http.MethodGet, "/trending?window=24h&limit=100", "", nil, &respBody); status != http.StatusOK {
+				t.Fatalf("expected status code 200, but got %d", status)
+			}
+			if found = ranks(respBody); found[hitContentID] > 0 && found[nicheContentID] > 0 {
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		if found[hitContentID] == 0 || found[nicheContentID] == 0 {
+			t.Fatalf("expected both contents to be trending, but got %v", found)
+		}
+		if found[hitContentID] > found[nicheContentID] {
+			t.Errorf("expected the most played content to rank first, but got %v", found)
+		}
+	})
+
+	t.Run("should expose the top 10 today rail", func(t *testing.T) {
+		var respBody trendingList
+		if status := doJSON(t, http.MethodGet, "/trending/top-10", "", nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		if respBody.Title != "Top 10 today" || len(respBody.Items) > 10 {
+			t.Errorf("unexpected top 10 rail: %q with %d items", respBody.Title, len(respBody.Items))
+		}
+	})
+
+	t.Run("should reject an unknown window", func(t *testing.T) {
+		if status := doJSON(t, http.MethodGet, "/trending?window=1y", "", nil, nil); status != http.StatusUnprocessableEntity {
+			t.Errorf("expected status code 422, but got %d", status)
+		}
+	})
+}
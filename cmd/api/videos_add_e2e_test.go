@@ -14,6 +14,8 @@ import (
 )
 
 func TestAddVideoE2E(t *testing.T) {
+	editorToken := registerEditor(t)
+
 	t.Run("should create movie successfully with valid data", func(t *testing.T) {
 		var createdVideoID string
 		videoFilePath := filepath.Join("..", "..", "testdata", "sample.mp4")
@@ -35,6 +37,7 @@ func TestAddVideoE2E(t *testing.T) {
 
 		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/movies", body)
 		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+editorToken)
 
 		client := &http.Client{Timeout: 10 * time.Second}
 		resp, err := client.Do(req)
@@ -77,6 +80,7 @@ func TestAddVideoE2E(t *testing.T) {
 
 		req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/movies", body)
 		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+editorToken)
 
 		client := &http.Client{}
 		resp, err := client.Do(req)
@@ -89,4 +93,36 @@ func TestAddVideoE2E(t *testing.T) {
 			t.Fatalf("Expected status code 422, but got %d", resp.StatusCode)
 		}
 	})
+
+	t.Run("should only let editors upload movies", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		_ = writer.WriteField("title", "Viewer Upload")
+		_ = writer.WriteField("description", "Test description.")
+		addFileToMultipart(t, writer, "video", filepath.Join("..", "..", "testdata", "sample.mp4"))
+		writer.Close()
+
+		upload := func(t *testing.T, token string) int {
+			t.Helper()
+			req, _ := http.NewRequest(http.MethodPost, baseAPIURL+"/movies", bytes.NewReader(body.Bytes()))
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Failed to execute request: %v", err)
+			}
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+
+		if status := upload(t, ""); status != http.StatusUnauthorized {
+			t.Errorf("Expected status code 401 anonymously, but got %d", status)
+		}
+		if status := upload(t, registerAndLogin(t)); status != http.StatusForbidden {
+			t.Errorf("Expected status code 403 for a viewer, but got %d", status)
+		}
+	})
 }
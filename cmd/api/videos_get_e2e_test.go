@@ -94,8 +94,11 @@ func TestGetVideoE2E(t *testing.T) {
 		log.Printf("Cleaned up resources for video download test with ID: %s", videoID)
 	})
 
+	token := registerSubscriber(t, "PREMIUM")
+
 	t.Run("should download the full video file without range header", func(t *testing.T) {
 		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
 		client := &http.Client{}
 		resp, err := client.Do(req)
 		if err != nil {
@@ -113,6 +116,7 @@ func TestGetVideoE2E(t *testing.T) {
 
 	t.Run("should stream a partial chunk of the video using Range header", func(t *testing.T) {
 		req, _ := http.NewRequest(http.MethodGet, baseAPIURL+"/videos/"+videoID+"/stream", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
 		req.Header.Set("Range", "bytes=50-149")
 
 		client := &http.Client{}
@@ -0,0 +1,104 @@
+package main_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/db/postgres"
+)
+
+func TestWatchlistE2E(t *testing.T) {
+	contentIDs := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	for i, contentID := range contentIDs {
+		seed := &postgres.ContentModel{ID: contentID, Title: "Listed Show " + string(rune('A'+i)), ContentType: "TV_SHOW"}
+		if err := db.Create(seed).Error; err != nil {
+			t.Fatalf("Failed to seed %T in test database: %v", seed, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&postgres.ContentModel{}, "id IN ?", contentIDs)
+	})
+
+	token := registerAndLogin(t)
+	token = selectProfile(t, token, createProfile(t, token, map[string]any{"name": "Lister"}))
+
+	listIDs := func(t *testing.T) []string {
+		t.Helper()
+		var respBody struct {
+			Items []struct {
+				Content struct {
+					ID string `json:"id"`
+				} `json:"content"`
+			} `json:"items"`
+			Total int `json:"total"`
+		}
+		if status := doJSON(t, http.MethodGet, "/me/list", token, nil, &respBody); status != http.StatusOK {
+			t.Fatalf("expected status code 200, but got %d", status)
+		}
+		ids := make([]string, 0, len(respBody.Items))
+		for _, item := range respBody.Items {
+			ids = append(ids, item.Content.ID)
+		}
+		if respBody.Total != len(ids) {
+			t.Fatalf("expected total %d to match the %d listed items", respBody.Total, len(ids))
+		}
+		return ids
+	}
+
+	t.Run("should add contents to the top of the list idempotently", func(t *testing.T) {
+		for _, contentID := range contentIDs {
+			if status := doJSON(t, http.MethodPut, "/me/list/"+contentID, token, nil, nil); status != http.StatusNoContent {
+				t.Fatalf("expected status code 204, but got %d", status)
+			}
+		}
+		if status := doJSON(t, http.MethodPut, "/me/list/"+contentIDs[0], token, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status code 204 when adding twice, but got %d", status)
+		}
+
+		ids := listIDs(t)
+		if len(ids) != 3 || ids[0] != contentIDs[2] || ids[2] != contentIDs[0] {
+			t.Errorf("expected the newest contents first, but got %v", ids)
+		}
+	})
+
+	t.Run("should move a content to the requested position", func(t *testing.T) {
+		status := doJSON(t, http.MethodPut, "/me/list/"+contentIDs[0], token, map[string]any{"position": 1}, nil)
+		if status != http.StatusNoContent {
+			t.Fatalf("expected status code 204, but got %d", status)
+		}
+
+		if ids := listIDs(t); ids[0] != contentIDs[0] {
+			t.Errorf("expected %s to be first, but got %v", contentIDs[0], ids)
+		}
+	})
+
+	t.Run("should remove contents idempotently", func(t *testing.T) {
+		for range 2 {
+			if status := doJSON(t, http.MethodDelete, "/me/list/"+contentIDs[1], token, nil, nil); status != http.StatusNoContent {
+				t.Fatalf("expected status code 204, but got %d", status)
+			}
+		}
+
+		if ids := listIDs(t); len(ids) != 2 {
+			t.Errorf("expected 2 listed contents, but got %v", ids)
+		}
+	})
+
+	t.Run("should hide soft-deleted contents", func(t *testing.T) {
+		db.Delete(&postgres.ContentModel{}, "id = ?", contentIDs[2])
+
+		for _, id := range listIDs(t) {
+			if id == contentIDs[2] {
+				t.Errorf("expected deleted content %s to disappear from the list", id)
+			}
+		}
+	})
+
+	t.Run("should return 404 for an unknown content", func(t *testing.T) {
+		if status := doJSON(t, http.MethodPut, "/me/list/"+uuid.NewString(), token, nil, nil); status != http.StatusNotFound {
+			t.Errorf("expected status code 404, but got %d", status)
+		}
+	})
+}
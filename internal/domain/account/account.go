@@ -0,0 +1,81 @@
+package account
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const minPasswordLength = 8
+
+// Role grants access beyond watching. Every account starts as a viewer;
+// editors can also curate the catalog.
+type Role string
+
+const (
+	ViewerRole Role = "VIEWER"
+	EditorRole Role = "EDITOR"
+)
+
+type Account struct {
+	id           string
+	email        string
+	passwordHash string
+	role         Role
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewAccount(email, password string) (*Account, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, errors.New("account email is invalid")
+	}
+	if len(password) < minPasswordLength {
+		return nil, errors.New("account password is too short")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		id:           uuid.NewString(),
+		email:        email,
+		passwordHash: string(hash),
+		role:         ViewerRole,
+		createdAt:    time.Now().UTC(),
+		updatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func HydrateAccount(id, email, passwordHash string, role Role, createdAt, updatedAt time.Time) *Account {
+	return &Account{
+		id:           id,
+		email:        email,
+		passwordHash: passwordHash,
+		role:         role,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+func (a *Account) VerifyPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(a.passwordHash), []byte(password)) == nil
+}
+
+func (a *Account) IsEditor() bool {
+	return a.role == EditorRole
+}
+
+func (a *Account) ID() string           { return a.id }
+func (a *Account) Email() string        { return a.email }
+func (a *Account) PasswordHash() string { return a.passwordHash }
+func (a *Account) Role() Role           { return a.role }
+func (a *Account) CreatedAt() time.Time { return a.createdAt }
+func (a *Account) UpdatedAt() time.Time { return a.updatedAt }
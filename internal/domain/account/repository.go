@@ -0,0 +1,17 @@
+package account
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound = errors.New("account not found")
+	ErrConflict = errors.New("account email already in use")
+)
+
+type Repository interface {
+	Save(ctx context.Context, account *Account) error
+	FindByID(ctx context.Context, id string) (*Account, error)
+	FindByEmail(ctx context.Context, email string) (*Account, error)
+}
@@ -0,0 +1,15 @@
+package availability
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("availability rule not found")
+
+type Repository interface {
+	// Save replaces the rule of its content.
+	Save(ctx context.Context, rule *Rule) error
+	FindByContentID(ctx context.Context, contentID string) (*Rule, error)
+	Delete(ctx context.Context, contentID string) error
+}
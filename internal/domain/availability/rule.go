@@ -0,0 +1,118 @@
+package availability
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrGeoBlocked means the content is licensed, but not in the viewer's
+	// country.
+	ErrGeoBlocked = errors.New("content is not available in this country")
+	// ErrOutsideWindow means the content is not licensed at this time.
+	ErrOutsideWindow = errors.New("content is not available at this time")
+)
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Rule holds the licensing terms of a content. An empty allow list means
+// every country not denied; a nil bound leaves that side of the window open.
+// Contents without a rule are available everywhere, at any time.
+type Rule struct {
+	contentID        string
+	allowedCountries []string
+	deniedCountries  []string
+	startsAt         *time.Time
+	endsAt           *time.Time
+	updatedAt        time.Time
+}
+
+func NewRule(contentID string, allowedCountries, deniedCountries []string, startsAt, endsAt *time.Time) (*Rule, error) {
+	if contentID == "" {
+		return nil, errors.New("availability content is required")
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return nil, errors.New("availability window must end after it starts")
+	}
+
+	allowed, err := normalizeCountries(allowedCountries)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := normalizeCountries(deniedCountries)
+	if err != nil {
+		return nil, err
+	}
+	for _, country := range denied {
+		if slices.Contains(allowed, country) {
+			return nil, fmt.Errorf("country %s cannot be both allowed and denied", country)
+		}
+	}
+
+	return &Rule{
+		contentID:        contentID,
+		allowedCountries: allowed,
+		deniedCountries:  denied,
+		startsAt:         startsAt,
+		endsAt:           endsAt,
+		updatedAt:        time.Now().UTC(),
+	}, nil
+}
+
+func HydrateRule(contentID string, allowedCountries, deniedCountries []string, startsAt, endsAt *time.Time, updatedAt time.Time) *Rule {
+	return &Rule{
+		contentID:        contentID,
+		allowedCountries: allowedCountries,
+		deniedCountries:  deniedCountries,
+		startsAt:         startsAt,
+		endsAt:           endsAt,
+		updatedAt:        updatedAt,
+	}
+}
+
+// Check tells whether the content may be watched from country at now. An
+// empty country, when it could not be resolved, only passes rules without
+// an allow list.
+func (r *Rule) Check(country string, now time.Time) error {
+	if r.startsAt != nil && now.Before(*r.startsAt) {
+		return ErrOutsideWindow
+	}
+	if r.endsAt != nil && !now.Before(*r.endsAt) {
+		return ErrOutsideWindow
+	}
+
+	country = strings.ToUpper(country)
+	if slices.Contains(r.deniedCountries, country) {
+		return ErrGeoBlocked
+	}
+	if len(r.allowedCountries) > 0 && !slices.Contains(r.allowedCountries, country) {
+		return ErrGeoBlocked
+	}
+	return nil
+}
+
+func (r *Rule) ContentID() string          { return r.contentID }
+func (r *Rule) AllowedCountries() []string { return r.allowedCountries }
+func (r *Rule) DeniedCountries() []string  { return r.deniedCountries }
+func (r *Rule) StartsAt() *time.Time       { return r.startsAt }
+func (r *Rule) EndsAt() *time.Time         { return r.endsAt }
+func (r *Rule) UpdatedAt() time.Time       { return r.updatedAt }
+
+// normalizeCountries upper-cases and deduplicates ISO 3166-1 alpha-2 codes.
+func normalizeCountries(countries []string) ([]string, error) {
+	normalized := make([]string, 0, len(countries))
+	for _, country := range countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if !countryPattern.MatchString(country) {
+			return nil, fmt.Errorf("invalid country code %q", country)
+		}
+		if !slices.Contains(normalized, country) {
+			normalized = append(normalized, country)
+		}
+	}
+	return normalized, nil
+}
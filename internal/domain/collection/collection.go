@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+)
+
+const (
+	MaxSlugLength = 100
+	MaxContents   = 200
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Collection is a hand-picked, ordered list of contents such as "Oscar
+// winners". It is only visible to the public inside its publish window; a
+// nil bound leaves that side of the window open.
+type Collection struct {
+	id           string
+	slug         string
+	title        string
+	description  string
+	artwork      *thumbnail.Thumbnail
+	contentIDs   []string
+	publishFrom  *time.Time
+	publishUntil *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewCollection(slug, title, description string, publishFrom, publishUntil *time.Time, contentIDs []string) (*Collection, error) {
+	if len(slug) > MaxSlugLength || !slugPattern.MatchString(slug) {
+		return nil, errors.New("collection slug must be lowercase words joined by hyphens")
+	}
+
+	c := &Collection{
+		id:        uuid.NewString(),
+		slug:      slug,
+		createdAt: time.Now().UTC(),
+	}
+	if err := c.Update(title, description, publishFrom, publishUntil, contentIDs); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func HydrateCollection(
+	id, slug, title, description string,
+	artwork *thumbnail.Thumbnail,
+	contentIDs []string,
+	publishFrom, publishUntil *time.Time,
+	createdAt, updatedAt time.Time,
+) *Collection {
+	return &Collection{
+		id:           id,
+		slug:         slug,
+		title:        title,
+		description:  description,
+		artwork:      artwork,
+		contentIDs:   contentIDs,
+		publishFrom:  publishFrom,
+		publishUntil: publishUntil,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// Update replaces everything but the slug and the artwork. contentIDs are
+// kept in the given order.
+func (c *Collection) Update(title, description string, publishFrom, publishUntil *time.Time, contentIDs []string) error {
+	if title == "" {
+		return errors.New("collection title is required")
+	}
+	if publishFrom != nil && publishUntil != nil && !publishUntil.After(*publishFrom) {
+		return errors.New("collection publish window must end after it starts")
+	}
+	if len(contentIDs) > MaxContents {
+		return errors.New("collection cannot hold more than 200 contents")
+	}
+	for i, id := range contentIDs {
+		if id == "" {
+			return errors.New("collection content ids cannot be blank")
+		}
+		if slices.Contains(contentIDs[:i], id) {
+			return errors.New("collection cannot hold the same content twice")
+		}
+	}
+
+	c.title = title
+	c.description = description
+	c.publishFrom = publishFrom
+	c.publishUntil = publishUntil
+	c.contentIDs = slices.Clone(contentIDs)
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (c *Collection) SetArtwork(artwork *thumbnail.Thumbnail) error {
+	if artwork == nil {
+		return errors.New("cannot set a nil artwork")
+	}
+	c.artwork = artwork
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// IsPublishedAt reports whether now falls inside the publish window, which
+// includes its start and excludes its end.
+func (c *Collection) IsPublishedAt(now time.Time) bool {
+	if c.publishFrom != nil && now.Before(*c.publishFrom) {
+		return false
+	}
+	if c.publishUntil != nil && !now.Before(*c.publishUntil) {
+		return false
+	}
+	return true
+}
+
+func (c *Collection) ID() string                    { return c.id }
+func (c *Collection) Slug() string                  { return c.slug }
+func (c *Collection) Title() string                 { return c.title }
+func (c *Collection) Description() string           { return c.description }
+func (c *Collection) Artwork() *thumbnail.Thumbnail { return c.artwork }
+func (c *Collection) ContentIDs() []string          { return c.contentIDs }
+func (c *Collection) PublishFrom() *time.Time       { return c.publishFrom }
+func (c *Collection) PublishUntil() *time.Time      { return c.publishUntil }
+func (c *Collection) CreatedAt() time.Time          { return c.createdAt }
+func (c *Collection) UpdatedAt() time.Time          { return c.updatedAt }
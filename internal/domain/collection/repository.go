@@ -0,0 +1,20 @@
+package collection
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound = errors.New("collection not found")
+	ErrConflict = errors.New("collection slug already in use")
+)
+
+type Repository interface {
+	// Save inserts or replaces the collection along with its contents.
+	Save(ctx context.Context, collection *Collection) error
+	FindBySlug(ctx context.Context, slug string) (*Collection, error)
+	// List returns every collection, published or not, newest first.
+	List(ctx context.Context, offset, limit int) ([]*Collection, int, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -36,6 +36,9 @@ type Content struct {
 	description string
 	contentType ContentType
 	media       Media
+	ageRating   AgeRating
+	status      Status
+	publishAt   *time.Time
 	createdAt   time.Time
 	updatedAt   time.Time
 }
@@ -68,18 +71,30 @@ func NewContent(title, description string, contentType ContentType, media Media)
 		description: description,
 		contentType: contentType,
 		media:       media,
+		status:      DraftStatus,
 		createdAt:   time.Now().UTC(),
 		updatedAt:   time.Now().UTC(),
 	}, nil
 }
 
-func HydrateContent(id, title, description string, contentType ContentType, media Media, createdAt, updatedAt time.Time) *Content {
+func HydrateContent(
+	id, title, description string,
+	contentType ContentType,
+	media Media,
+	ageRating AgeRating,
+	status Status,
+	publishAt *time.Time,
+	createdAt, updatedAt time.Time,
+) *Content {
 	return &Content{
 		id:          id,
 		title:       title,
 		description: description,
 		contentType: contentType,
 		media:       media,
+		ageRating:   ageRating,
+		status:      status,
+		publishAt:   publishAt,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 	}
@@ -94,10 +109,23 @@ func (c *Content) ChangeTitle(newTitle string) error {
 	return nil
 }
 
+func (c *Content) ChangeAgeRating(rating AgeRating) error {
+	if rating.IsZero() {
+		return errors.New("age rating cannot be empty")
+	}
+	c.ageRating = rating
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
 func (c *Content) ID() string               { return c.id }
 func (c *Content) Title() string            { return c.title }
 func (c *Content) Description() string      { return c.description }
 func (c *Content) ContentType() ContentType { return c.contentType }
+func (c *Content) AgeRating() AgeRating     { return c.ageRating }
+func (c *Content) MaturityLevel() int       { return c.ageRating.Level() }
+func (c *Content) Status() Status           { return c.status }
+func (c *Content) PublishAt() *time.Time    { return c.publishAt }
 func (c *Content) CreatedAt() time.Time     { return c.createdAt }
 func (c *Content) UpdatedAt() time.Time     { return c.updatedAt }
 
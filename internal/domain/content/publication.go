@@ -0,0 +1,74 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Status is where a content stands in the publishing workflow. Only
+// published contents are visible to viewers.
+type Status string
+
+const (
+	DraftStatus       Status = "DRAFT"
+	InReviewStatus    Status = "IN_REVIEW"
+	ScheduledStatus   Status = "SCHEDULED"
+	PublishedStatus   Status = "PUBLISHED"
+	UnpublishedStatus Status = "UNPUBLISHED"
+)
+
+// transitions lists the statuses each status may move to.
+var transitions = map[Status][]Status{
+	DraftStatus:       {InReviewStatus},
+	InReviewStatus:    {DraftStatus, ScheduledStatus, PublishedStatus},
+	ScheduledStatus:   {DraftStatus, PublishedStatus},
+	PublishedStatus:   {UnpublishedStatus},
+	UnpublishedStatus: {DraftStatus, ScheduledStatus, PublishedStatus},
+}
+
+func (s Status) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	return slices.Contains(transitions[s], next)
+}
+
+// Transition moves the content to next. Scheduling requires a publishAt in
+// the future; publishing records now as the publish time.
+func (c *Content) Transition(next Status, publishAt *time.Time, now time.Time) error {
+	if !next.IsValid() {
+		return errors.New("invalid content status")
+	}
+	if !c.status.CanTransitionTo(next) {
+		return fmt.Errorf("content cannot move from %s to %s", c.status, next)
+	}
+
+	switch next {
+	case ScheduledStatus:
+		if publishAt == nil || !publishAt.After(now) {
+			return errors.New("scheduled contents need a publish time in the future")
+		}
+		at := publishAt.UTC()
+		c.publishAt = &at
+	case PublishedStatus:
+		at := now.UTC()
+		c.publishAt = &at
+	default:
+		c.publishAt = nil
+	}
+
+	c.status = next
+	c.updatedAt = now.UTC()
+	return nil
+}
+
+// IsDue reports whether a scheduled content has reached its publish time.
+func (c *Content) IsDue(now time.Time) bool {
+	return c.status == ScheduledStatus && c.publishAt != nil && !now.Before(*c.publishAt)
+}
+
+func (c *Content) IsPublished() bool { return c.status == PublishedStatus }
@@ -0,0 +1,77 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxMaturityLevel is the numeric level of adult-only content. Unrated
+// contents are treated as this level so they never leak into restricted
+// profiles.
+const MaxMaturityLevel = 18
+
+type RatingSystem string
+
+const (
+	ClassIndSystem RatingSystem = "CLASSIND"
+	MPAASystem     RatingSystem = "MPAA"
+)
+
+// ratingLevels maps each rating of a system to the minimum viewer age it
+// stands for, which is the common numeric level used for comparisons.
+var ratingLevels = map[RatingSystem]map[string]int{
+	ClassIndSystem: {
+		"L":  0,
+		"10": 10,
+		"12": 12,
+		"14": 14,
+		"16": 16,
+		"18": 18,
+	},
+	MPAASystem: {
+		"G":     0,
+		"PG":    10,
+		"PG-13": 13,
+		"R":     17,
+		"NC-17": 18,
+	},
+}
+
+func (rs RatingSystem) IsValid() bool {
+	_, ok := ratingLevels[rs]
+	return ok
+}
+
+type AgeRating struct {
+	system RatingSystem
+	value  string
+	level  int
+}
+
+func NewAgeRating(system RatingSystem, value string) (AgeRating, error) {
+	system = RatingSystem(strings.ToUpper(string(system)))
+	value = strings.ToUpper(value)
+
+	levels, ok := ratingLevels[system]
+	if !ok {
+		return AgeRating{}, errors.New("invalid rating system")
+	}
+	level, ok := levels[value]
+	if !ok {
+		return AgeRating{}, fmt.Errorf("invalid rating %q for system %s", value, system)
+	}
+
+	return AgeRating{system: system, value: value, level: level}, nil
+}
+
+func (r AgeRating) System() RatingSystem { return r.system }
+func (r AgeRating) Value() string        { return r.value }
+func (r AgeRating) IsZero() bool         { return r.system == "" }
+
+func (r AgeRating) Level() int {
+	if r.IsZero() {
+		return MaxMaturityLevel
+	}
+	return r.level
+}
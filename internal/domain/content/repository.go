@@ -3,6 +3,7 @@ package content
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 var (
@@ -10,6 +11,32 @@ var (
 	ErrConflict = errors.New("conflict")
 )
 
+// ListFilter narrows catalog listings. A nil MaxMaturityLevel means no
+// maturity restriction, an empty Genre means any genre and an empty Status
+// means any status. When AvailableAt is set, only contents whose
+// availability rule lets them be watched from Country at that time are
+// kept.
+type ListFilter struct {
+	MaxMaturityLevel *int
+	Genre            string
+	Status           Status
+	Country          string
+	AvailableAt      time.Time
+	Offset           int
+	Limit            int
+}
+
 type Repository interface {
 	Save(ctx context.Context, content *Content) error
+	FindByID(ctx context.Context, id string) (*Content, error)
+	// FindByIDs loads the listing view of the given contents, in no
+	// particular order. Unknown or deleted ids are skipped.
+	FindByIDs(ctx context.Context, ids []string) ([]*Content, error)
+	FindByVideoID(ctx context.Context, videoID string) (*Content, error)
+	List(ctx context.Context, filter ListFilter) ([]*Content, int, error)
+	// UpdatePublication stores the status and publish time of the content.
+	UpdatePublication(ctx context.Context, content *Content) error
+	// FindDue loads up to limit scheduled contents whose publish time is
+	// not after now, without their media.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*Content, error)
 }
@@ -0,0 +1,191 @@
+package device
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const maxNameLength = 100
+
+type Type string
+
+const (
+	TVType      Type = "TV"
+	MobileType  Type = "MOBILE"
+	TabletType  Type = "TABLET"
+	WebType     Type = "WEB"
+	ConsoleType Type = "CONSOLE"
+	OtherType   Type = "OTHER"
+)
+
+var (
+	ErrSignedOut           = errors.New("device has been signed out")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+)
+
+func (t Type) IsValid() bool {
+	switch t {
+	case TVType, MobileType, TabletType, WebType, ConsoleType, OtherType:
+		return true
+	}
+	return false
+}
+
+// Device is an app an account signed in from. It holds the account's
+// refresh token for that app, and its playback sessions are bound to it, so
+// signing a device out ends both.
+type Device struct {
+	id               string
+	accountID        string
+	deviceType       Type
+	name             string
+	userAgent        string
+	refreshTokenHash string
+	refreshExpiresAt time.Time
+	createdAt        time.Time
+	lastSeenAt       time.Time
+	signedOutAt      *time.Time
+}
+
+func NewDevice(accountID string, deviceType Type, name, userAgent string, now time.Time) (*Device, error) {
+	if accountID == "" {
+		return nil, errors.New("device account is required")
+	}
+	if deviceType == "" {
+		deviceType = OtherType
+	}
+	if !deviceType.IsValid() {
+		return nil, errors.New("device type is invalid")
+	}
+	name = strings.TrimSpace(name)
+	if len(name) > maxNameLength {
+		return nil, errors.New("device name is too long")
+	}
+
+	now = now.UTC()
+	return &Device{
+		id:         uuid.NewString(),
+		accountID:  accountID,
+		deviceType: deviceType,
+		name:       name,
+		userAgent:  userAgent,
+		createdAt:  now,
+		lastSeenAt: now,
+	}, nil
+}
+
+func HydrateDevice(
+	id, accountID string,
+	deviceType Type,
+	name, userAgent, refreshTokenHash string,
+	refreshExpiresAt, createdAt, lastSeenAt time.Time,
+	signedOutAt *time.Time,
+) *Device {
+	return &Device{
+		id:               id,
+		accountID:        accountID,
+		deviceType:       deviceType,
+		name:             name,
+		userAgent:        userAgent,
+		refreshTokenHash: refreshTokenHash,
+		refreshExpiresAt: refreshExpiresAt,
+		createdAt:        createdAt,
+		lastSeenAt:       lastSeenAt,
+		signedOutAt:      signedOutAt,
+	}
+}
+
+// IssueRefreshToken replaces the device's refresh token with a new one
+// valid for ttl, so a token can only be used once. Only its hash is kept.
+func (d *Device) IssueRefreshToken(now time.Time, ttl time.Duration) (string, error) {
+	if d.signedOutAt != nil {
+		return "", ErrSignedOut
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := d.id + "." + base64.RawURLEncoding.EncodeToString(secret)
+
+	d.refreshTokenHash = hashRefreshToken(token)
+	d.refreshExpiresAt = now.Add(ttl).UTC()
+	return token, nil
+}
+
+// VerifyRefreshToken checks token is the current refresh token of the
+// device and has not expired.
+func (d *Device) VerifyRefreshToken(token string, now time.Time) error {
+	if d.signedOutAt != nil {
+		return ErrSignedOut
+	}
+	if d.refreshTokenHash == "" || !now.Before(d.refreshExpiresAt) {
+		return ErrInvalidRefreshToken
+	}
+	if subtle.ConstantTimeCompare([]byte(hashRefreshToken(token)), []byte(d.refreshTokenHash)) != 1 {
+		return ErrInvalidRefreshToken
+	}
+	return nil
+}
+
+// Touch records the device was seen at now, from userAgent when known.
+func (d *Device) Touch(userAgent string, now time.Time) {
+	if userAgent != "" {
+		d.userAgent = userAgent
+	}
+	d.lastSeenAt = now.UTC()
+}
+
+// SignOut revokes the device's refresh token; its access tokens are refused
+// from then on.
+func (d *Device) SignOut(now time.Time) error {
+	if d.signedOutAt != nil {
+		return ErrSignedOut
+	}
+	signedOutAt := now.UTC()
+	d.signedOutAt = &signedOutAt
+	d.refreshTokenHash = ""
+	return nil
+}
+
+func (d *Device) IsSignedOut() bool {
+	return d.signedOutAt != nil
+}
+
+func (d *Device) BelongsTo(accountID string) bool {
+	return d.accountID == accountID
+}
+
+// DeviceIDFromRefreshToken returns the device a refresh token was issued
+// to.
+func DeviceIDFromRefreshToken(token string) (string, error) {
+	deviceID, _, ok := strings.Cut(token, ".")
+	if !ok || uuid.Validate(deviceID) != nil {
+		return "", ErrInvalidRefreshToken
+	}
+	return deviceID, nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (d *Device) ID() string                  { return d.id }
+func (d *Device) AccountID() string           { return d.accountID }
+func (d *Device) Type() Type                  { return d.deviceType }
+func (d *Device) Name() string                { return d.name }
+func (d *Device) UserAgent() string           { return d.userAgent }
+func (d *Device) RefreshTokenHash() string    { return d.refreshTokenHash }
+func (d *Device) RefreshExpiresAt() time.Time { return d.refreshExpiresAt }
+func (d *Device) CreatedAt() time.Time        { return d.createdAt }
+func (d *Device) LastSeenAt() time.Time       { return d.lastSeenAt }
+func (d *Device) SignedOutAt() *time.Time     { return d.signedOutAt }
@@ -0,0 +1,26 @@
+package device
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound       = errors.New("device not found")
+	ErrTooManyDevices = errors.New("too many signed in devices")
+)
+
+type Repository interface {
+	// Register stores a new device unless its account already has
+	// maxActive devices signed in, in which case it returns
+	// ErrTooManyDevices. A maxActive of zero sets no limit. The check and
+	// the insert are atomic per account.
+	Register(ctx context.Context, device *Device, maxActive int) error
+	// Save updates the refresh token, last seen time and sign out of an
+	// existing device.
+	Save(ctx context.Context, device *Device) error
+	FindByID(ctx context.Context, id string) (*Device, error)
+	// ListActive returns the signed in devices of the account, oldest
+	// first.
+	ListActive(ctx context.Context, accountID string) ([]*Device, error)
+}
@@ -0,0 +1,130 @@
+package download
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevokeReason string
+
+const (
+	// ReturnedReason is a download the device deleted or gave back.
+	ReturnedReason RevokeReason = "RETURNED"
+	// PlanChangedReason is a download the account's plan no longer covers.
+	PlanChangedReason RevokeReason = "PLAN_CHANGED"
+	// SubscriptionEndedReason is a download of an account that lost its
+	// entitlement.
+	SubscriptionEndedReason RevokeReason = "SUBSCRIPTION_ENDED"
+	// DeviceSignedOutReason is a download of a device signed out.
+	DeviceSignedOutReason RevokeReason = "DEVICE_SIGNED_OUT"
+)
+
+var ErrLicenseRevoked = errors.New("download license has been revoked")
+
+// License lets one device keep one video for offline playback until it
+// expires. Devices renew licenses while online, which is when changes to
+// the account, such as a downgrade, catch up with them.
+type License struct {
+	id           string
+	accountID    string
+	profileID    string
+	deviceID     string
+	videoID      string
+	height       int
+	issuedAt     time.Time
+	expiresAt    time.Time
+	revokedAt    *time.Time
+	revokeReason RevokeReason
+}
+
+func NewLicense(accountID, profileID, deviceID, videoID string, height int, now, expiresAt time.Time) (*License, error) {
+	if accountID == "" {
+		return nil, errors.New("download license account is required")
+	}
+	if deviceID == "" {
+		return nil, errors.New("download license device is required")
+	}
+	if videoID == "" {
+		return nil, errors.New("download license video is required")
+	}
+	if height < 0 {
+		return nil, errors.New("download height cannot be negative")
+	}
+	if !expiresAt.After(now) {
+		return nil, errors.New("download license must expire in the future")
+	}
+
+	return &License{
+		id:        uuid.NewString(),
+		accountID: accountID,
+		profileID: profileID,
+		deviceID:  deviceID,
+		videoID:   videoID,
+		height:    height,
+		issuedAt:  now.UTC(),
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+func HydrateLicense(
+	id, accountID, profileID, deviceID, videoID string,
+	height int,
+	issuedAt, expiresAt time.Time,
+	revokedAt *time.Time,
+	revokeReason RevokeReason,
+) *License {
+	return &License{
+		id:           id,
+		accountID:    accountID,
+		profileID:    profileID,
+		deviceID:     deviceID,
+		videoID:      videoID,
+		height:       height,
+		issuedAt:     issuedAt,
+		expiresAt:    expiresAt,
+		revokedAt:    revokedAt,
+		revokeReason: revokeReason,
+	}
+}
+
+// IsActiveAt tells whether the download can still be played at now and
+// counts against the plan's download cap.
+func (l *License) IsActiveAt(now time.Time) bool {
+	return l.revokedAt == nil && now.Before(l.expiresAt)
+}
+
+// Renew moves the expiry of a license that was not revoked, even one that
+// already lapsed while the device was offline.
+func (l *License) Renew(now, expiresAt time.Time) error {
+	if l.revokedAt != nil {
+		return ErrLicenseRevoked
+	}
+	if !expiresAt.After(now) {
+		return errors.New("download license must expire in the future")
+	}
+	l.expiresAt = expiresAt.UTC()
+	return nil
+}
+
+func (l *License) Revoke(reason RevokeReason, now time.Time) error {
+	if l.revokedAt != nil {
+		return ErrLicenseRevoked
+	}
+	revokedAt := now.UTC()
+	l.revokedAt = &revokedAt
+	l.revokeReason = reason
+	return nil
+}
+
+func (l *License) ID() string                 { return l.id }
+func (l *License) AccountID() string          { return l.accountID }
+func (l *License) ProfileID() string          { return l.profileID }
+func (l *License) DeviceID() string           { return l.deviceID }
+func (l *License) VideoID() string            { return l.videoID }
+func (l *License) Height() int                { return l.height }
+func (l *License) IssuedAt() time.Time        { return l.issuedAt }
+func (l *License) ExpiresAt() time.Time       { return l.expiresAt }
+func (l *License) RevokedAt() *time.Time      { return l.revokedAt }
+func (l *License) RevokeReason() RevokeReason { return l.revokeReason }
@@ -0,0 +1,28 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotFound         = errors.New("download license not found")
+	ErrTooManyDownloads = errors.New("too many active downloads")
+)
+
+type Repository interface {
+	// Issue stores a new license unless its account already holds
+	// maxActive licenses active at now, in which case it returns
+	// ErrTooManyDownloads. The check and the insert are atomic per account.
+	Issue(ctx context.Context, license *License, maxActive int, now time.Time) error
+	// Save updates the expiry and revocation of an existing license.
+	Save(ctx context.Context, license *License) error
+	FindByID(ctx context.Context, id string) (*License, error)
+	// ListActive returns the licenses of the account active at now, oldest
+	// first.
+	ListActive(ctx context.Context, accountID string, now time.Time) ([]*License, error)
+	// RevokeByDevice revokes every license of the device not revoked yet,
+	// returning how many were revoked.
+	RevokeByDevice(ctx context.Context, deviceID string, reason RevokeReason, now time.Time) (int, error)
+}
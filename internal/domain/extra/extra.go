@@ -0,0 +1,80 @@
+package extra
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+)
+
+type Kind string
+
+const (
+	TrailerKind Kind = "TRAILER"
+	// PreviewKind is the short clip the browse UI autoplays. A content has
+	// at most one preview.
+	PreviewKind Kind = "PREVIEW"
+)
+
+func (k Kind) IsValid() bool {
+	return k == TrailerKind || k == PreviewKind
+}
+
+const (
+	DefaultPreviewSeconds = 30
+	MaxPreviewSeconds     = 60
+)
+
+// Extra is a promotional video attached to a content. Extras are streamed
+// like any video, under the publication, availability and maturity rules
+// of their content.
+type Extra struct {
+	id        string
+	contentID string
+	kind      Kind
+	title     string
+	video     *video.Video
+	createdAt time.Time
+}
+
+func NewExtra(contentID string, kind Kind, title string, vid *video.Video) (*Extra, error) {
+	if contentID == "" {
+		return nil, errors.New("content id is required")
+	}
+
+	if !kind.IsValid() {
+		return nil, errors.New("invalid extra kind")
+	}
+
+	if vid == nil {
+		return nil, errors.New("extra video is required")
+	}
+
+	return &Extra{
+		id:        uuid.NewString(),
+		contentID: contentID,
+		kind:      kind,
+		title:     title,
+		video:     vid,
+		createdAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateExtra(id, contentID string, kind Kind, title string, vid *video.Video, createdAt time.Time) *Extra {
+	return &Extra{
+		id:        id,
+		contentID: contentID,
+		kind:      kind,
+		title:     title,
+		video:     vid,
+		createdAt: createdAt,
+	}
+}
+
+func (e *Extra) ID() string           { return e.id }
+func (e *Extra) ContentID() string    { return e.contentID }
+func (e *Extra) Kind() Kind           { return e.kind }
+func (e *Extra) Title() string        { return e.title }
+func (e *Extra) Video() *video.Video  { return e.video }
+func (e *Extra) CreatedAt() time.Time { return e.createdAt }
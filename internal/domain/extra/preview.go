@@ -0,0 +1,36 @@
+package extra
+
+// PreviewOffset picks where a preview of length seconds starts in a video
+// of the given duration: the window with the most scene changes, as busy
+// scenes make the most of a hover. Openings and endings are avoided when
+// the video is long enough, since they tend to hold intros and credits.
+// Without scene changes it falls back to a third into the video.
+func PreviewOffset(sceneChanges []float64, duration, length int) int {
+	if duration <= length {
+		return 0
+	}
+
+	earliest, latest := 0, duration-length
+	if skip := duration / 10; latest-earliest > 2*skip+length {
+		earliest, latest = skip, latest-skip
+	}
+
+	best, bestCount := min(max(duration/3, earliest), latest), 0
+	for i, start := range sceneChanges {
+		at := int(start)
+		if at < earliest || at > latest {
+			continue
+		}
+		count := 0
+		for _, change := range sceneChanges[i:] {
+			if change >= start+float64(length) {
+				break
+			}
+			count++
+		}
+		if count > bestCount {
+			best, bestCount = at, count
+		}
+	}
+	return best
+}
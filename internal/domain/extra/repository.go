@@ -0,0 +1,21 @@
+package extra
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound        = errors.New("extra not found")
+	ErrContentNotFound = errors.New("content not found")
+)
+
+type Repository interface {
+	// Save stores the extra with its video. Saving a preview replaces the
+	// previous preview of the content.
+	Save(ctx context.Context, e *Extra) error
+	FindByID(ctx context.Context, id string) (*Extra, error)
+	FindByVideoID(ctx context.Context, videoID string) (*Extra, error)
+	ListByContentID(ctx context.Context, contentID string) ([]*Extra, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,99 @@
+package home
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RailKind string
+
+const (
+	ContinueWatchingRail RailKind = "CONTINUE_WATCHING"
+	TrendingRail         RailKind = "TRENDING"
+	MyListRail           RailKind = "MY_LIST"
+	GenreRail            RailKind = "GENRE"
+	NewReleasesRail      RailKind = "NEW_RELEASES"
+)
+
+func (k RailKind) IsValid() bool {
+	switch k {
+	case ContinueWatchingRail, TrendingRail, MyListRail, GenreRail, NewReleasesRail:
+		return true
+	}
+	return false
+}
+
+const (
+	DefaultRailPageSize = 20
+	MaxRailPageSize     = 50
+)
+
+// Rail is a row of the home screen. Rails are shown by ascending position
+// and disabled ones are skipped. Genre is only set for genre rails.
+type Rail struct {
+	id        string
+	kind      RailKind
+	title     string
+	genre     string
+	pageSize  int
+	position  int
+	enabled   bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewRail(kind RailKind, title, genre string, pageSize, position int) (*Rail, error) {
+	if !kind.IsValid() {
+		return nil, errors.New("invalid rail kind")
+	}
+	if title == "" {
+		return nil, errors.New("rail title is required")
+	}
+	if (kind == GenreRail) != (genre != "") {
+		return nil, errors.New("a genre is required for genre rails and only for them")
+	}
+	if pageSize == 0 {
+		pageSize = DefaultRailPageSize
+	}
+	if pageSize < 1 || pageSize > MaxRailPageSize {
+		return nil, errors.New("rail page size must be between 1 and 50")
+	}
+
+	return &Rail{
+		id:        uuid.NewString(),
+		kind:      kind,
+		title:     title,
+		genre:     genre,
+		pageSize:  pageSize,
+		position:  position,
+		enabled:   true,
+		createdAt: time.Now().UTC(),
+		updatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateRail(id string, kind RailKind, title, genre string, pageSize, position int, enabled bool, createdAt, updatedAt time.Time) *Rail {
+	return &Rail{
+		id:        id,
+		kind:      kind,
+		title:     title,
+		genre:     genre,
+		pageSize:  pageSize,
+		position:  position,
+		enabled:   enabled,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (r *Rail) ID() string           { return r.id }
+func (r *Rail) Kind() RailKind       { return r.kind }
+func (r *Rail) Title() string        { return r.title }
+func (r *Rail) Genre() string        { return r.genre }
+func (r *Rail) PageSize() int        { return r.pageSize }
+func (r *Rail) Position() int        { return r.position }
+func (r *Rail) IsEnabled() bool      { return r.enabled }
+func (r *Rail) CreatedAt() time.Time { return r.createdAt }
+func (r *Rail) UpdatedAt() time.Time { return r.updatedAt }
@@ -0,0 +1,14 @@
+package home
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Repository interface {
+	// ListEnabled returns the rails to show, in display order.
+	ListEnabled(ctx context.Context) ([]*Rail, error)
+	FindByID(ctx context.Context, id string) (*Rail, error)
+}
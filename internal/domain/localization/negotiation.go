@@ -0,0 +1,33 @@
+package localization
+
+import "strings"
+
+// Pick chooses the translation that best matches the preferred locales,
+// tried in order. For each one it falls back from the exact locale to its
+// bare language and then to the same language in any other region, so
+// "pt-PT" is served "pt" or "pt-BR" before the next preference is tried.
+// It returns nil when nothing matches and the original texts should be
+// used.
+func Pick(preferred []string, translations []*Translation) *Translation {
+	for _, locale := range preferred {
+		language := languageOf(locale)
+		matchers := []func(string) bool{
+			func(l string) bool { return l == locale },
+			func(l string) bool { return l == language },
+			func(l string) bool { return languageOf(l) == language },
+		}
+		for _, matches := range matchers {
+			for _, translation := range translations {
+				if matches(translation.locale) {
+					return translation
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func languageOf(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
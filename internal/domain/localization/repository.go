@@ -0,0 +1,21 @@
+package localization
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound        = errors.New("translation not found")
+	ErrSubjectNotFound = errors.New("translated subject not found")
+)
+
+type Repository interface {
+	// Save inserts or replaces the translation of its subject and locale.
+	// It returns ErrSubjectNotFound when the subject does not exist.
+	Save(ctx context.Context, translation *Translation) error
+	Find(ctx context.Context, subjectKind SubjectKind, subjectID, locale string) (*Translation, error)
+	// ListBySubjects loads every translation of the given subjects.
+	ListBySubjects(ctx context.Context, subjectKind SubjectKind, subjectIDs []string) ([]*Translation, error)
+	Delete(ctx context.Context, subjectKind SubjectKind, subjectID, locale string) error
+}
@@ -0,0 +1,120 @@
+package localization
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+)
+
+type SubjectKind string
+
+const (
+	ContentSubject SubjectKind = "CONTENT"
+	EpisodeSubject SubjectKind = "EPISODE"
+)
+
+func (k SubjectKind) IsValid() bool {
+	switch k {
+	case ContentSubject, EpisodeSubject:
+		return true
+	}
+	return false
+}
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
+// NormalizeLocale brings a language tag such as "PT-br" or "pt_BR" to the
+// "pt-BR" form translations are stored under. Only a language with an
+// optional region is supported.
+func NormalizeLocale(tag string) (string, error) {
+	language, region, _ := strings.Cut(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	locale := strings.ToLower(language)
+	if region != "" {
+		locale += "-" + strings.ToUpper(region)
+	}
+	if !localePattern.MatchString(locale) {
+		return "", fmt.Errorf("invalid locale %q", tag)
+	}
+	return locale, nil
+}
+
+// Translation overrides the title, description and, optionally, the
+// thumbnail of a content or an episode for one locale.
+type Translation struct {
+	subjectKind SubjectKind
+	subjectID   string
+	locale      string
+	title       string
+	description string
+	thumbnail   *thumbnail.Thumbnail
+	updatedAt   time.Time
+}
+
+func NewTranslation(subjectKind SubjectKind, subjectID, locale, title, description string) (*Translation, error) {
+	if !subjectKind.IsValid() {
+		return nil, errors.New("invalid translation subject")
+	}
+	if subjectID == "" {
+		return nil, errors.New("translation subject is required")
+	}
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		return nil, errors.New("translated title is required")
+	}
+
+	return &Translation{
+		subjectKind: subjectKind,
+		subjectID:   subjectID,
+		locale:      locale,
+		title:       title,
+		description: description,
+		updatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+func HydrateTranslation(subjectKind SubjectKind, subjectID, locale, title, description string, thumbnail *thumbnail.Thumbnail, updatedAt time.Time) *Translation {
+	return &Translation{
+		subjectKind: subjectKind,
+		subjectID:   subjectID,
+		locale:      locale,
+		title:       title,
+		description: description,
+		thumbnail:   thumbnail,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Rewrite replaces the texts, keeping the thumbnail.
+func (t *Translation) Rewrite(title, description string) error {
+	if title == "" {
+		return errors.New("translated title is required")
+	}
+	t.title = title
+	t.description = description
+	t.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (t *Translation) SetThumbnail(thumbnail *thumbnail.Thumbnail) error {
+	if thumbnail == nil {
+		return errors.New("cannot set a nil thumbnail")
+	}
+	t.thumbnail = thumbnail
+	t.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (t *Translation) SubjectKind() SubjectKind        { return t.subjectKind }
+func (t *Translation) SubjectID() string               { return t.subjectID }
+func (t *Translation) Locale() string                  { return t.locale }
+func (t *Translation) Title() string                   { return t.title }
+func (t *Translation) Description() string             { return t.description }
+func (t *Translation) Thumbnail() *thumbnail.Thumbnail { return t.thumbnail }
+func (t *Translation) UpdatedAt() time.Time            { return t.updatedAt }
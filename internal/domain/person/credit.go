@@ -0,0 +1,88 @@
+package person
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	ActorRole    Role = "ACTOR"
+	DirectorRole Role = "DIRECTOR"
+	WriterRole   Role = "WRITER"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case ActorRole, DirectorRole, WriterRole:
+		return true
+	}
+	return false
+}
+
+// Credit links a person to a content. When episodeID is set the credit only
+// applies to that episode of the tv show, otherwise it covers the whole content.
+type Credit struct {
+	id        string
+	personID  string
+	contentID string
+	episodeID string
+	role      Role
+	character string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewCredit(personID, contentID, episodeID string, role Role, character string) (*Credit, error) {
+	if personID == "" {
+		return nil, errors.New("credit person is required")
+	}
+	if contentID == "" {
+		return nil, errors.New("credit content is required")
+	}
+	if !role.IsValid() {
+		return nil, errors.New("invalid credit role")
+	}
+	if role == ActorRole && character == "" {
+		return nil, errors.New("actor credits require a character name")
+	}
+	if role != ActorRole && character != "" {
+		return nil, errors.New("only actor credits can have a character name")
+	}
+
+	return &Credit{
+		id:        uuid.NewString(),
+		personID:  personID,
+		contentID: contentID,
+		episodeID: episodeID,
+		role:      role,
+		character: character,
+		createdAt: time.Now().UTC(),
+		updatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateCredit(id, personID, contentID, episodeID string, role Role, character string, createdAt, updatedAt time.Time) *Credit {
+	return &Credit{
+		id:        id,
+		personID:  personID,
+		contentID: contentID,
+		episodeID: episodeID,
+		role:      role,
+		character: character,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (c *Credit) ID() string           { return c.id }
+func (c *Credit) PersonID() string     { return c.personID }
+func (c *Credit) ContentID() string    { return c.contentID }
+func (c *Credit) EpisodeID() string    { return c.episodeID }
+func (c *Credit) Role() Role           { return c.role }
+func (c *Credit) Character() string    { return c.character }
+func (c *Credit) CreatedAt() time.Time { return c.createdAt }
+func (c *Credit) UpdatedAt() time.Time { return c.updatedAt }
@@ -0,0 +1,60 @@
+package person
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+
+	"github.com/google/uuid"
+)
+
+type Person struct {
+	id        string
+	name      string
+	bio       string
+	photo     *thumbnail.Thumbnail
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewPerson(name, bio string) (*Person, error) {
+	if name == "" {
+		return nil, errors.New("person name is required")
+	}
+
+	return &Person{
+		id:        uuid.NewString(),
+		name:      name,
+		bio:       bio,
+		createdAt: time.Now().UTC(),
+		updatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydratePerson(id, name, bio string, photo *thumbnail.Thumbnail, createdAt, updatedAt time.Time) *Person {
+	return &Person{
+		id:        id,
+		name:      name,
+		bio:       bio,
+		photo:     photo,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (p *Person) AddPhoto(photo *thumbnail.Thumbnail) error {
+	if photo == nil {
+		return errors.New("cannot add a nil photo")
+	}
+	p.photo = photo
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (p *Person) ID() string                  { return p.id }
+func (p *Person) Name() string                { return p.name }
+func (p *Person) Bio() string                 { return p.bio }
+func (p *Person) Photo() *thumbnail.Thumbnail { return p.photo }
+func (p *Person) CreatedAt() time.Time        { return p.createdAt }
+func (p *Person) UpdatedAt() time.Time        { return p.updatedAt }
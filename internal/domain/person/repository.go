@@ -0,0 +1,32 @@
+package person
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound = errors.New("person not found")
+	ErrConflict = errors.New("credit already exists")
+)
+
+// FilmographyEntry is a read model joining a credit with the content (and
+// episode, for episode credits) it refers to.
+type FilmographyEntry struct {
+	ContentID    string
+	ContentTitle string
+	ContentType  string
+	EpisodeID    string
+	EpisodeTitle string
+	Season       int
+	Number       int
+	Role         Role
+	Character    string
+}
+
+type Repository interface {
+	Save(ctx context.Context, person *Person) error
+	FindByID(ctx context.Context, id string) (*Person, error)
+	SaveCredit(ctx context.Context, credit *Credit) error
+	FindFilmography(ctx context.Context, personID string) ([]*FilmographyEntry, error)
+}
@@ -0,0 +1,56 @@
+package playback
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	StartEvent    EventType = "START"
+	CompleteEvent EventType = "COMPLETE"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case StartEvent, CompleteEvent:
+		return true
+	}
+	return false
+}
+
+// Event is an immutable record of something that happened during playback.
+// Anonymous viewers have no profile.
+type Event struct {
+	id         string
+	videoID    string
+	profileID  string
+	eventType  EventType
+	occurredAt time.Time
+}
+
+func NewEvent(videoID, profileID string, eventType EventType) (*Event, error) {
+	if videoID == "" {
+		return nil, errors.New("playback event video is required")
+	}
+	if !eventType.IsValid() {
+		return nil, errors.New("invalid playback event type")
+	}
+
+	return &Event{
+		id:         uuid.NewString(),
+		videoID:    videoID,
+		profileID:  profileID,
+		eventType:  eventType,
+		occurredAt: time.Now().UTC(),
+	}, nil
+}
+
+func (e *Event) ID() string            { return e.id }
+func (e *Event) VideoID() string       { return e.videoID }
+func (e *Event) ProfileID() string     { return e.profileID }
+func (e *Event) Type() EventType       { return e.eventType }
+func (e *Event) OccurredAt() time.Time { return e.occurredAt }
@@ -0,0 +1,34 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrSessionNotFound = errors.New("playback session not found")
+	ErrTooManySessions = errors.New("too many concurrent playback sessions")
+)
+
+type Repository interface {
+	// Append stores the event. Events are never updated or deleted.
+	Append(ctx context.Context, event *Event) error
+}
+
+type SessionRepository interface {
+	// Start stores a new session unless its account already has maxActive
+	// sessions seen since activeSince, in which case it returns
+	// ErrTooManySessions. The check and the insert are atomic per account.
+	Start(ctx context.Context, session *Session, maxActive int, activeSince time.Time) error
+	// Save updates the heartbeat and end of an existing session.
+	Save(ctx context.Context, session *Session) error
+	FindByID(ctx context.Context, id string) (*Session, error)
+	ListActive(ctx context.Context, accountID string, activeSince time.Time) ([]*Session, error)
+	// EndStale ends every session not seen since activeSince, returning
+	// how many were ended.
+	EndStale(ctx context.Context, activeSince, now time.Time) (int, error)
+	// EndByDevice ends every session of the device still open, returning
+	// how many were ended.
+	EndByDevice(ctx context.Context, deviceID string, reason EndReason, now time.Time) (int, error)
+}
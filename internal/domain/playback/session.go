@@ -0,0 +1,160 @@
+package playback
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EndReason string
+
+const (
+	TimedOutReason   EndReason = "TIMED_OUT"
+	TerminatedReason EndReason = "TERMINATED"
+	// SignedOutReason ends the sessions of a device signed out remotely.
+	SignedOutReason EndReason = "SIGNED_OUT"
+)
+
+var (
+	ErrSessionEnded     = errors.New("playback session has ended")
+	ErrVideoChanged     = errors.New("playback session is playing another video")
+	ErrAlreadyCompleted = errors.New("playback session already completed its video")
+)
+
+// Session is a stream an account is watching. It starts with the first
+// stream request, is kept alive by heartbeats and ends when the account
+// terminates it, when its device is signed out or when heartbeats stop for
+// longer than the timeout.
+type Session struct {
+	id         string
+	accountID  string
+	profileID  string
+	deviceID   string
+	videoID    string
+	userAgent  string
+	startedAt  time.Time
+	lastSeenAt time.Time
+	endedAt    *time.Time
+	endReason  EndReason
+	// completedAt is when the current video was played to the end.
+	completedAt *time.Time
+}
+
+func NewSession(accountID, profileID, deviceID, videoID, userAgent string, now time.Time) (*Session, error) {
+	if accountID == "" {
+		return nil, errors.New("playback session account is required")
+	}
+	if videoID == "" {
+		return nil, errors.New("playback session video is required")
+	}
+
+	now = now.UTC()
+	return &Session{
+		id:         uuid.NewString(),
+		accountID:  accountID,
+		profileID:  profileID,
+		deviceID:   deviceID,
+		videoID:    videoID,
+		userAgent:  userAgent,
+		startedAt:  now,
+		lastSeenAt: now,
+	}, nil
+}
+
+func HydrateSession(
+	id, accountID, profileID, deviceID, videoID, userAgent string,
+	startedAt, lastSeenAt time.Time,
+	endedAt *time.Time,
+	endReason EndReason,
+	completedAt *time.Time,
+) *Session {
+	return &Session{
+		id:          id,
+		accountID:   accountID,
+		profileID:   profileID,
+		deviceID:    deviceID,
+		videoID:     videoID,
+		userAgent:   userAgent,
+		startedAt:   startedAt,
+		lastSeenAt:  lastSeenAt,
+		endedAt:     endedAt,
+		endReason:   endReason,
+		completedAt: completedAt,
+	}
+}
+
+// IsActiveAt tells whether the session still counts against the account's
+// concurrent streams at now.
+func (s *Session) IsActiveAt(now time.Time, timeout time.Duration) bool {
+	return s.endedAt == nil && now.Sub(s.lastSeenAt) < timeout
+}
+
+// Heartbeat keeps the session alive. videoID, when not empty, must be the
+// video the session is playing: heartbeats carry no proof the viewer may
+// watch another one. A session that timed out cannot be revived.
+func (s *Session) Heartbeat(videoID string, now time.Time, timeout time.Duration) error {
+	if !s.IsActiveAt(now, timeout) {
+		return ErrSessionEnded
+	}
+	if videoID != "" && videoID != s.videoID {
+		return ErrVideoChanged
+	}
+	s.lastSeenAt = now.UTC()
+	return nil
+}
+
+// Resume keeps the session alive on videoID, which changes when the player
+// moves on to the next episode. Callers must have checked the viewer may
+// watch videoID, as the session stands for those checks.
+func (s *Session) Resume(videoID string, now time.Time, timeout time.Duration) error {
+	if !s.IsActiveAt(now, timeout) {
+		return ErrSessionEnded
+	}
+	if videoID != s.videoID {
+		s.videoID = videoID
+		s.completedAt = nil
+	}
+	s.lastSeenAt = now.UTC()
+	return nil
+}
+
+// Complete records that the session played videoID to the end, which
+// happens once per video.
+func (s *Session) Complete(videoID string, now time.Time, timeout time.Duration) error {
+	if !s.IsActiveAt(now, timeout) {
+		return ErrSessionEnded
+	}
+	if videoID != s.videoID {
+		return ErrVideoChanged
+	}
+	if s.completedAt != nil {
+		return ErrAlreadyCompleted
+	}
+	completedAt := now.UTC()
+	s.completedAt = &completedAt
+	s.lastSeenAt = completedAt
+	return nil
+}
+
+func (s *Session) End(reason EndReason, now time.Time) error {
+	if s.endedAt != nil {
+		return ErrSessionEnded
+	}
+	endedAt := now.UTC()
+	s.endedAt = &endedAt
+	s.endReason = reason
+	return nil
+}
+
+func (s *Session) ID() string              { return s.id }
+func (s *Session) AccountID() string       { return s.accountID }
+func (s *Session) ProfileID() string       { return s.profileID }
+func (s *Session) DeviceID() string        { return s.deviceID }
+func (s *Session) VideoID() string         { return s.videoID }
+func (s *Session) UserAgent() string       { return s.userAgent }
+func (s *Session) StartedAt() time.Time    { return s.startedAt }
+func (s *Session) LastSeenAt() time.Time   { return s.lastSeenAt }
+func (s *Session) EndedAt() *time.Time     { return s.endedAt }
+func (s *Session) EndReason() EndReason    { return s.endReason }
+func (s *Session) CompletedAt() *time.Time { return s.completedAt }
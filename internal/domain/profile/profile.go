@@ -0,0 +1,161 @@
+package profile
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/content"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/language"
+)
+
+const (
+	// MaxProfilesPerAccount caps how many viewers can share one account.
+	MaxProfilesPerAccount = 5
+	// KidsMaxMaturityLevel is the highest level a kids profile can be set to.
+	KidsMaxMaturityLevel = 10
+	// MaxPINAttempts wrong PINs in a row lock the PIN for PINLockout.
+	MaxPINAttempts = 5
+	PINLockout     = 15 * time.Minute
+)
+
+type Profile struct {
+	id               string
+	accountID        string
+	name             string
+	avatarURL        string
+	language         string
+	maxMaturityLevel int
+	kids             bool
+	pinHash          string
+	failedPINs       int
+	pinLockedUntil   *time.Time
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func NewProfile(accountID, name, avatarURL, lang string, maxMaturityLevel int, kids bool) (*Profile, error) {
+	if accountID == "" {
+		return nil, errors.New("profile account is required")
+	}
+	if name == "" {
+		return nil, errors.New("profile name is required")
+	}
+	if maxMaturityLevel < 0 || maxMaturityLevel > content.MaxMaturityLevel {
+		return nil, errors.New("profile maturity level is out of range")
+	}
+	if kids && maxMaturityLevel > KidsMaxMaturityLevel {
+		return nil, errors.New("kids profiles cannot exceed the kids maturity level")
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, errors.New("profile language is invalid")
+	}
+
+	return &Profile{
+		id:               uuid.NewString(),
+		accountID:        accountID,
+		name:             name,
+		avatarURL:        avatarURL,
+		language:         tag.String(),
+		maxMaturityLevel: maxMaturityLevel,
+		kids:             kids,
+		createdAt:        time.Now().UTC(),
+		updatedAt:        time.Now().UTC(),
+	}, nil
+}
+
+func HydrateProfile(
+	id, accountID, name, avatarURL, lang string,
+	maxMaturityLevel int,
+	kids bool,
+	pinHash string,
+	failedPINs int,
+	pinLockedUntil *time.Time,
+	createdAt, updatedAt time.Time,
+) *Profile {
+	return &Profile{
+		id:               id,
+		accountID:        accountID,
+		name:             name,
+		avatarURL:        avatarURL,
+		language:         lang,
+		maxMaturityLevel: maxMaturityLevel,
+		kids:             kids,
+		pinHash:          pinHash,
+		failedPINs:       failedPINs,
+		pinLockedUntil:   pinLockedUntil,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
+
+func (p *Profile) SetPIN(pin string) error {
+	if len(pin) != 4 {
+		return errors.New("profile pin must have 4 digits")
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return errors.New("profile pin must have 4 digits")
+		}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	p.pinHash = string(hash)
+	p.failedPINs = 0
+	p.pinLockedUntil = nil
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (p *Profile) HasPIN() bool {
+	return p.pinHash != ""
+}
+
+func (p *Profile) VerifyPIN(pin string) bool {
+	if !p.HasPIN() || pin == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(p.pinHash), []byte(pin)) == nil
+}
+
+func (p *Profile) IsPINLockedAt(now time.Time) bool {
+	return p.pinLockedUntil != nil && now.Before(*p.pinLockedUntil)
+}
+
+// EffectiveMaturityLevel returns the highest maturity level the profile may
+// access, which a PIN checked for the request lifts unless it is a kids
+// profile.
+func (p *Profile) EffectiveMaturityLevel(unlocked bool) int {
+	if unlocked && !p.kids {
+		return content.MaxMaturityLevel
+	}
+	return p.maxMaturityLevel
+}
+
+func (p *Profile) CanWatch(level int, unlocked bool) bool {
+	return level <= p.EffectiveMaturityLevel(unlocked)
+}
+
+func (p *Profile) BelongsTo(accountID string) bool {
+	return p.accountID == accountID
+}
+
+func (p *Profile) ID() string                 { return p.id }
+func (p *Profile) AccountID() string          { return p.accountID }
+func (p *Profile) Name() string               { return p.name }
+func (p *Profile) AvatarURL() string          { return p.avatarURL }
+func (p *Profile) Language() string           { return p.language }
+func (p *Profile) MaxMaturityLevel() int      { return p.maxMaturityLevel }
+func (p *Profile) IsKids() bool               { return p.kids }
+func (p *Profile) PINHash() string            { return p.pinHash }
+func (p *Profile) FailedPINs() int            { return p.failedPINs }
+func (p *Profile) PINLockedUntil() *time.Time { return p.pinLockedUntil }
+func (p *Profile) CreatedAt() time.Time       { return p.createdAt }
+func (p *Profile) UpdatedAt() time.Time       { return p.updatedAt }
@@ -0,0 +1,22 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrNotFound = errors.New("profile not found")
+
+type Repository interface {
+	Save(ctx context.Context, profile *Profile) error
+	FindByID(ctx context.Context, id string) (*Profile, error)
+	ListByAccountID(ctx context.Context, accountID string) ([]*Profile, error)
+	Delete(ctx context.Context, id string) error
+	// RecordFailedPIN counts a wrong PIN on the profile and locks its PIN
+	// until now plus PINLockout once MaxPINAttempts were counted. The count
+	// is atomic, so parallel guesses cannot slip past the lock.
+	RecordFailedPIN(ctx context.Context, id string, now time.Time) error
+	// ResetFailedPINs clears the wrong PINs counted on the profile.
+	ResetFailedPINs(ctx context.Context, id string) error
+}
@@ -0,0 +1,73 @@
+package progress
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/video"
+)
+
+// CompletionRatio is the share of a video that must be watched for it to
+// count as finished, leaving room for end credits.
+const CompletionRatio = 0.95
+
+// Progress is how far a profile got into a video. ContentID and EpisodeID
+// are only filled when read back, to tell which content the video belongs to.
+type Progress struct {
+	profileID       string
+	videoID         string
+	contentID       string
+	episodeID       string
+	positionSeconds int
+	durationSeconds int
+	completed       bool
+	recordedAt      time.Time
+}
+
+func NewProgress(profileID string, vid *video.Video, positionSeconds int, recordedAt time.Time) (*Progress, error) {
+	if profileID == "" {
+		return nil, errors.New("progress profile is required")
+	}
+	if vid == nil {
+		return nil, errors.New("progress video is required")
+	}
+	if positionSeconds < 0 {
+		return nil, errors.New("progress position cannot be negative")
+	}
+
+	duration := vid.Duration()
+	if duration > 0 && positionSeconds > duration {
+		positionSeconds = duration
+	}
+
+	return &Progress{
+		profileID:       profileID,
+		videoID:         vid.ID(),
+		positionSeconds: positionSeconds,
+		durationSeconds: duration,
+		completed:       duration > 0 && float64(positionSeconds) >= float64(duration)*CompletionRatio,
+		recordedAt:      recordedAt.UTC(),
+	}, nil
+}
+
+func HydrateProgress(profileID, videoID, contentID, episodeID string, positionSeconds, durationSeconds int, completed bool, recordedAt time.Time) *Progress {
+	return &Progress{
+		profileID:       profileID,
+		videoID:         videoID,
+		contentID:       contentID,
+		episodeID:       episodeID,
+		positionSeconds: positionSeconds,
+		durationSeconds: durationSeconds,
+		completed:       completed,
+		recordedAt:      recordedAt,
+	}
+}
+
+func (p *Progress) ProfileID() string     { return p.profileID }
+func (p *Progress) VideoID() string       { return p.videoID }
+func (p *Progress) ContentID() string     { return p.contentID }
+func (p *Progress) EpisodeID() string     { return p.episodeID }
+func (p *Progress) PositionSeconds() int  { return p.positionSeconds }
+func (p *Progress) DurationSeconds() int  { return p.durationSeconds }
+func (p *Progress) IsCompleted() bool     { return p.completed }
+func (p *Progress) RecordedAt() time.Time { return p.recordedAt }
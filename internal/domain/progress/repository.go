@@ -0,0 +1,21 @@
+package progress
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("progress not found")
+
+type Repository interface {
+	// Save upserts the progress of a profile on a video, ignoring heartbeats
+	// recorded before the one already stored.
+	Save(ctx context.Context, progress *Progress) error
+	// ListLatestByContent returns the most recent progress of the profile for
+	// each content it watched, newest first.
+	ListLatestByContent(ctx context.Context, profileID string, limit int) ([]*Progress, error)
+	FindByVideoID(ctx context.Context, profileID, videoID string) (*Progress, error)
+	// FindLatestByContentID returns the most recent progress of the profile
+	// on any video of the content.
+	FindLatestByContentID(ctx context.Context, profileID, contentID string) (*Progress, error)
+}
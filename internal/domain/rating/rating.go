@@ -0,0 +1,75 @@
+package rating
+
+import (
+	"errors"
+	"time"
+)
+
+type Thumb string
+
+const (
+	ThumbUp   Thumb = "UP"
+	ThumbDown Thumb = "DOWN"
+)
+
+func (t Thumb) IsValid() bool {
+	switch t {
+	case ThumbUp, ThumbDown:
+		return true
+	}
+	return false
+}
+
+const (
+	MinStars = 1
+	MaxStars = 5
+)
+
+// Rating is the opinion of a profile about a content. Stars are optional
+// and zero when the profile only gave a thumb.
+type Rating struct {
+	profileID string
+	contentID string
+	thumb     Thumb
+	stars     int
+	ratedAt   time.Time
+}
+
+func NewRating(profileID, contentID string, thumb Thumb, stars int) (*Rating, error) {
+	if profileID == "" {
+		return nil, errors.New("rating profile is required")
+	}
+	if contentID == "" {
+		return nil, errors.New("rating content is required")
+	}
+	if !thumb.IsValid() {
+		return nil, errors.New("invalid rating thumb")
+	}
+	if stars != 0 && (stars < MinStars || stars > MaxStars) {
+		return nil, errors.New("rating stars must be between 1 and 5")
+	}
+
+	return &Rating{
+		profileID: profileID,
+		contentID: contentID,
+		thumb:     thumb,
+		stars:     stars,
+		ratedAt:   time.Now().UTC(),
+	}, nil
+}
+
+func HydrateRating(profileID, contentID string, thumb Thumb, stars int, ratedAt time.Time) *Rating {
+	return &Rating{
+		profileID: profileID,
+		contentID: contentID,
+		thumb:     thumb,
+		stars:     stars,
+		ratedAt:   ratedAt,
+	}
+}
+
+func (r *Rating) ProfileID() string  { return r.profileID }
+func (r *Rating) ContentID() string  { return r.contentID }
+func (r *Rating) Thumb() Thumb       { return r.thumb }
+func (r *Rating) Stars() int         { return r.stars }
+func (r *Rating) RatedAt() time.Time { return r.ratedAt }
@@ -0,0 +1,22 @@
+package rating
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("conflict")
+)
+
+type Repository interface {
+	// Save upserts the rating and applies the difference to the content
+	// summary in the same transaction.
+	Save(ctx context.Context, rating *Rating) error
+	// Delete removes the rating of the profile, if any, and takes it out of
+	// the content summary.
+	Delete(ctx context.Context, profileID, contentID string) error
+	FindSummaries(ctx context.Context, contentIDs []string) (map[string]*Summary, error)
+	FindByProfile(ctx context.Context, profileID string, contentIDs []string) (map[string]*Rating, error)
+}
@@ -0,0 +1,29 @@
+package rating
+
+// Summary aggregates every rating given to a content. It is kept up to
+// date as ratings change instead of being computed on read.
+type Summary struct {
+	ContentID  string
+	ThumbsUp   int
+	ThumbsDown int
+	StarsCount int
+	StarsTotal int
+}
+
+// AverageStars is the mean of the star ratings, or zero when nobody gave
+// stars.
+func (s *Summary) AverageStars() float64 {
+	if s.StarsCount == 0 {
+		return 0
+	}
+	return float64(s.StarsTotal) / float64(s.StarsCount)
+}
+
+// ThumbsUpRatio is the share of thumbs that are up, or zero without votes.
+func (s *Summary) ThumbsUpRatio() float64 {
+	votes := s.ThumbsUp + s.ThumbsDown
+	if votes == 0 {
+		return 0
+	}
+	return float64(s.ThumbsUp) / float64(votes)
+}
@@ -0,0 +1,160 @@
+package recommendation
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// cosineWeight balances the TF-IDF cosine, which favours rare shared
+// terms, against the Jaccard index, which favours overall overlap.
+const cosineWeight = 0.7
+
+type vector map[string]float64
+
+// Engine scores contents against each other and against profile tastes
+// using the features known when it was built.
+type Engine struct {
+	ids     []string
+	vectors map[string]vector
+	terms   map[string]map[string]struct{}
+}
+
+func NewEngine(features []Features) *Engine {
+	engine := &Engine{
+		ids:     make([]string, 0, len(features)),
+		vectors: make(map[string]vector, len(features)),
+		terms:   make(map[string]map[string]struct{}, len(features)),
+	}
+
+	documentFrequency := make(map[string]int)
+	for _, f := range features {
+		set := make(map[string]struct{}, len(f.Terms))
+		for _, term := range f.Terms {
+			set[term] = struct{}{}
+		}
+		for term := range set {
+			documentFrequency[term]++
+		}
+		engine.ids = append(engine.ids, f.ContentID)
+		engine.terms[f.ContentID] = set
+	}
+
+	total := float64(len(features))
+	for _, id := range engine.ids {
+		vec := make(vector, len(engine.terms[id]))
+		for term := range engine.terms[id] {
+			// Terms appear at most once per content, so TF is 1 and the
+			// weight is the smoothed IDF alone.
+			vec[term] = math.Log(1 + total/float64(documentFrequency[term]))
+		}
+		engine.vectors[id] = normalize(vec)
+	}
+
+	return engine
+}
+
+// Similar returns the contents closest to the given one, best first.
+func (e *Engine) Similar(contentID string, limit int) []Scored {
+	source, ok := e.vectors[contentID]
+	if !ok || len(source) == 0 {
+		return nil
+	}
+
+	scored := make([]Scored, 0)
+	for _, id := range e.ids {
+		if id == contentID {
+			continue
+		}
+		score := cosineWeight*dot(source, e.vectors[id]) +
+			(1-cosineWeight)*jaccard(e.terms[contentID], e.terms[id])
+		if score > 0 {
+			scored = append(scored, Scored{ContentID: id, Score: score})
+		}
+	}
+
+	return top(scored, limit)
+}
+
+// Recommend ranks the contents the profile has not interacted with
+// against the taste built from its signals, best first.
+func (e *Engine) Recommend(signals []Signal, limit int) []Scored {
+	taste := make(vector)
+	seen := make(map[string]struct{}, len(signals))
+	for _, signal := range signals {
+		seen[signal.ContentID] = struct{}{}
+		weight := signal.Weight()
+		for term, value := range e.vectors[signal.ContentID] {
+			taste[term] += weight * value
+		}
+	}
+	taste = normalize(taste)
+	if len(taste) == 0 {
+		return nil
+	}
+
+	scored := make([]Scored, 0)
+	for _, id := range e.ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		if score := dot(taste, e.vectors[id]); score > 0 {
+			scored = append(scored, Scored{ContentID: id, Score: score})
+		}
+	}
+
+	return top(scored, limit)
+}
+
+func normalize(vec vector) vector {
+	var norm float64
+	for _, value := range vec {
+		norm += value * value
+	}
+	if norm == 0 {
+		return vector{}
+	}
+	norm = math.Sqrt(norm)
+	for term := range vec {
+		vec[term] /= norm
+	}
+	return vec
+}
+
+// dot of two normalized vectors is their cosine similarity.
+func dot(a, b vector) float64 {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	var sum float64
+	for term, value := range a {
+		sum += value * b[term]
+	}
+	return sum
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	var shared int
+	for term := range a {
+		if _, ok := b[term]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func top(scored []Scored, limit int) []Scored {
+	slices.SortFunc(scored, func(a, b Scored) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ContentID, b.ContentID)
+	})
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+	return scored
+}
@@ -0,0 +1,81 @@
+package recommendation
+
+import (
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+)
+
+const (
+	// MaxSimilarPerContent is how many neighbours are kept for each content.
+	MaxSimilarPerContent = 20
+	// MaxPerProfile is how many recommendations are kept for each profile.
+	MaxPerProfile = 50
+)
+
+// Features are the terms describing a content: its genres, tags and the
+// people credited on it, prefixed by kind so they never collide.
+type Features struct {
+	ContentID string
+	Terms     []string
+}
+
+func GenreTerm(genre string) string     { return "genre:" + genre }
+func TagTerm(tag string) string         { return "tag:" + tag }
+func PersonTerm(personID string) string { return "person:" + personID }
+
+// Signal is everything a profile did with a content that tells about its
+// taste. Thumb is empty and Stars zero when the profile did not rate it.
+type Signal struct {
+	ProfileID string
+	ContentID string
+	Watched   bool
+	Completed bool
+	Thumb     rating.Thumb
+	Stars     int
+}
+
+// Weight is how strongly the signal pulls the profile taste towards the
+// content. Disliked contents push it away.
+func (s Signal) Weight() float64 {
+	var weight float64
+	if s.Watched {
+		weight += 1
+	}
+	if s.Completed {
+		weight += 0.5
+	}
+	switch s.Thumb {
+	case rating.ThumbUp:
+		weight += 2
+	case rating.ThumbDown:
+		weight -= 3
+	}
+	if s.Stars != 0 {
+		weight += float64(s.Stars-3) * 0.5
+	}
+	return weight
+}
+
+// Scored is a content ranked against another content or a profile.
+type Scored struct {
+	ContentID string
+	Score     float64
+}
+
+// Similarity links a content to one of its nearest neighbours.
+type Similarity struct {
+	ContentID        string
+	SimilarContentID string
+	Score            float64
+	Rank             int
+}
+
+// Recommendation is a content suggested to a profile.
+type Recommendation struct {
+	ProfileID  string
+	ContentID  string
+	Score      float64
+	Rank       int
+	ComputedAt time.Time
+}
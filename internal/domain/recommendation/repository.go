@@ -0,0 +1,19 @@
+package recommendation
+
+import (
+	"context"
+)
+
+type Repository interface {
+	// LoadFeatures returns the features of every content in the catalog.
+	LoadFeatures(ctx context.Context) ([]Features, error)
+	// LoadSignals returns the watch history and ratings of every profile.
+	LoadSignals(ctx context.Context) ([]Signal, error)
+	// ReplaceSimilarities swaps the whole similarity cache for the given one.
+	ReplaceSimilarities(ctx context.Context, similarities []Similarity) error
+	// ReplaceRecommendations swaps the whole recommendation cache for the
+	// given one.
+	ReplaceRecommendations(ctx context.Context, recommendations []Recommendation) error
+	ListSimilar(ctx context.Context, contentID string, limit int) ([]Scored, error)
+	ListForProfile(ctx context.Context, profileID string, limit int) ([]Scored, error)
+}
@@ -0,0 +1,36 @@
+package subscription
+
+// Plan is a tier a subscriber can pay for. Plans are seeded by migrations
+// and only read by the application. MaxHeight caps the vertical resolution
+// of the videos its subscribers can play, MaxDownloads how many videos they
+// can keep offline at once, zero leaving downloads out of the plan, and
+// MaxDevices how many devices can be signed in to the account.
+type Plan struct {
+	code         string
+	name         string
+	maxStreams   int
+	maxHeight    int
+	maxDownloads int
+	maxDevices   int
+	priceCents   int
+}
+
+func HydratePlan(code, name string, maxStreams, maxHeight, maxDownloads, maxDevices, priceCents int) *Plan {
+	return &Plan{
+		code:         code,
+		name:         name,
+		maxStreams:   maxStreams,
+		maxHeight:    maxHeight,
+		maxDownloads: maxDownloads,
+		maxDevices:   maxDevices,
+		priceCents:   priceCents,
+	}
+}
+
+func (p *Plan) Code() string      { return p.code }
+func (p *Plan) Name() string      { return p.name }
+func (p *Plan) MaxStreams() int   { return p.maxStreams }
+func (p *Plan) MaxHeight() int    { return p.maxHeight }
+func (p *Plan) MaxDownloads() int { return p.maxDownloads }
+func (p *Plan) MaxDevices() int   { return p.maxDevices }
+func (p *Plan) PriceCents() int   { return p.priceCents }
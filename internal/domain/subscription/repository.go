@@ -0,0 +1,23 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotFound     = errors.New("subscription not found")
+	ErrPlanNotFound = errors.New("plan not found")
+)
+
+type Repository interface {
+	ListPlans(ctx context.Context) ([]*Plan, error)
+	FindPlan(ctx context.Context, code string) (*Plan, error)
+	// Save inserts or updates the subscription of its account.
+	Save(ctx context.Context, subscription *Subscription) error
+	FindByAccountID(ctx context.Context, accountID string) (*Subscription, error)
+	// FindDue loads subscriptions that are not expired yet but whose
+	// period ended at or before now.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*Subscription, error)
+}
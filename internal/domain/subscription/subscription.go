@@ -0,0 +1,138 @@
+package subscription
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	ActiveStatus Status = "ACTIVE"
+	// CanceledStatus subscriptions stay entitled until the end of the paid
+	// period and are not renewed.
+	CanceledStatus Status = "CANCELED"
+	ExpiredStatus  Status = "EXPIRED"
+)
+
+// Period is how long a payment keeps a subscription entitled.
+const Period = 30 * 24 * time.Hour
+
+var ErrNotRenewable = errors.New("subscription is not renewable")
+
+// Subscription binds an account to a plan for paid periods. An account has
+// at most one subscription, which moves between plans.
+type Subscription struct {
+	id                 string
+	accountID          string
+	planCode           string
+	status             Status
+	paymentReference   string
+	currentPeriodStart time.Time
+	currentPeriodEnd   time.Time
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+// NewSubscription starts a paid period on plan from now.
+func NewSubscription(accountID string, plan *Plan, paymentReference string, now time.Time) (*Subscription, error) {
+	if accountID == "" {
+		return nil, errors.New("subscription account is required")
+	}
+	if plan == nil {
+		return nil, errors.New("subscription plan is required")
+	}
+
+	now = now.UTC()
+	return &Subscription{
+		id:                 uuid.NewString(),
+		accountID:          accountID,
+		planCode:           plan.Code(),
+		status:             ActiveStatus,
+		paymentReference:   paymentReference,
+		currentPeriodStart: now,
+		currentPeriodEnd:   now.Add(Period),
+		createdAt:          now,
+		updatedAt:          now,
+	}, nil
+}
+
+func HydrateSubscription(
+	id, accountID, planCode string,
+	status Status,
+	paymentReference string,
+	currentPeriodStart, currentPeriodEnd, createdAt, updatedAt time.Time,
+) *Subscription {
+	return &Subscription{
+		id:                 id,
+		accountID:          accountID,
+		planCode:           planCode,
+		status:             status,
+		paymentReference:   paymentReference,
+		currentPeriodStart: currentPeriodStart,
+		currentPeriodEnd:   currentPeriodEnd,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// IsEntitledAt tells whether the subscriber may watch at now.
+func (s *Subscription) IsEntitledAt(now time.Time) bool {
+	return s.status != ExpiredStatus && now.Before(s.currentPeriodEnd)
+}
+
+// Restart begins a new paid period on plan, for subscriptions that are
+// being switched to another plan or resubscribed after expiring.
+func (s *Subscription) Restart(plan *Plan, paymentReference string, now time.Time) error {
+	if plan == nil {
+		return errors.New("subscription plan is required")
+	}
+	now = now.UTC()
+	s.planCode = plan.Code()
+	s.status = ActiveStatus
+	s.paymentReference = paymentReference
+	s.currentPeriodStart = now
+	s.currentPeriodEnd = now.Add(Period)
+	s.updatedAt = now
+	return nil
+}
+
+// Renew extends an active subscription by one period, starting where the
+// current one ends.
+func (s *Subscription) Renew(paymentReference string, now time.Time) error {
+	if s.status != ActiveStatus {
+		return ErrNotRenewable
+	}
+	s.paymentReference = paymentReference
+	s.currentPeriodStart = s.currentPeriodEnd
+	s.currentPeriodEnd = s.currentPeriodEnd.Add(Period)
+	s.updatedAt = now.UTC()
+	return nil
+}
+
+// Cancel stops renewals; the current period is kept.
+func (s *Subscription) Cancel(now time.Time) error {
+	if s.status != ActiveStatus {
+		return errors.New("only active subscriptions can be canceled")
+	}
+	s.status = CanceledStatus
+	s.updatedAt = now.UTC()
+	return nil
+}
+
+func (s *Subscription) Expire(now time.Time) {
+	s.status = ExpiredStatus
+	s.updatedAt = now.UTC()
+}
+
+func (s *Subscription) ID() string                    { return s.id }
+func (s *Subscription) AccountID() string             { return s.accountID }
+func (s *Subscription) PlanCode() string              { return s.planCode }
+func (s *Subscription) Status() Status                { return s.status }
+func (s *Subscription) PaymentReference() string      { return s.paymentReference }
+func (s *Subscription) CurrentPeriodStart() time.Time { return s.currentPeriodStart }
+func (s *Subscription) CurrentPeriodEnd() time.Time   { return s.currentPeriodEnd }
+func (s *Subscription) CreatedAt() time.Time          { return s.createdAt }
+func (s *Subscription) UpdatedAt() time.Time          { return s.updatedAt }
@@ -0,0 +1,14 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Repository interface {
+	// Replace swaps every genre and tag of the content for the given ones.
+	Replace(ctx context.Context, taxonomy *Taxonomy) error
+	FindByContentID(ctx context.Context, contentID string) (*Taxonomy, error)
+}
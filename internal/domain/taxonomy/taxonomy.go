@@ -0,0 +1,82 @@
+package taxonomy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	MaxGenres      = 10
+	MaxTags        = 30
+	MaxLabelLength = 50
+)
+
+// Taxonomy holds the genres and free-form tags that classify a content.
+// Labels are stored normalized as lowercase slugs.
+type Taxonomy struct {
+	contentID string
+	genres    []string
+	tags      []string
+}
+
+func NewTaxonomy(contentID string, genres, tags []string) (*Taxonomy, error) {
+	if contentID == "" {
+		return nil, errors.New("taxonomy content is required")
+	}
+
+	normalizedGenres, err := normalizeLabels("genre", genres, MaxGenres)
+	if err != nil {
+		return nil, err
+	}
+	normalizedTags, err := normalizeLabels("tag", tags, MaxTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Taxonomy{
+		contentID: contentID,
+		genres:    normalizedGenres,
+		tags:      normalizedTags,
+	}, nil
+}
+
+func HydrateTaxonomy(contentID string, genres, tags []string) *Taxonomy {
+	return &Taxonomy{
+		contentID: contentID,
+		genres:    genres,
+		tags:      tags,
+	}
+}
+
+func (t *Taxonomy) ContentID() string { return t.contentID }
+func (t *Taxonomy) Genres() []string  { return t.genres }
+func (t *Taxonomy) Tags() []string    { return t.tags }
+
+// Slug is the normalized form labels are stored and searched in.
+func Slug(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), "-")
+}
+
+// normalizeLabels slugs and deduplicates labels, keeping the order they
+// were given in.
+func normalizeLabels(kind string, labels []string, maxLabels int) ([]string, error) {
+	normalized := make([]string, 0, len(labels))
+	for _, label := range labels {
+		slug := Slug(label)
+		if slug == "" {
+			return nil, fmt.Errorf("%s cannot be blank", kind)
+		}
+		if len(slug) > MaxLabelLength {
+			return nil, fmt.Errorf("%s %q is longer than %d characters", kind, slug, MaxLabelLength)
+		}
+		if !slices.Contains(normalized, slug) {
+			normalized = append(normalized, slug)
+		}
+	}
+	if len(normalized) > maxLabels {
+		return nil, fmt.Errorf("a content cannot have more than %d %ss", maxLabels, kind)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,14 @@
+package trending
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Rollup recomputes the ranking of the window from the playback events
+	// up to now and replaces the stored one. It returns how many contents
+	// were ranked.
+	Rollup(ctx context.Context, window Window, now time.Time) (int, error)
+	List(ctx context.Context, window Window, limit int) ([]Score, error)
+}
@@ -0,0 +1,61 @@
+package trending
+
+import (
+	"time"
+)
+
+// Window is the period popularity is measured over.
+type Window string
+
+const (
+	Day  Window = "24h"
+	Week Window = "7d"
+)
+
+func (w Window) IsValid() bool {
+	switch w {
+	case Day, Week:
+		return true
+	}
+	return false
+}
+
+// Duration is how far back events count for the window.
+func (w Window) Duration() time.Duration {
+	if w == Week {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// HalfLife is the age at which an event counts half as much as a fresh
+// one, so recent plays dominate the ranking.
+func (w Window) HalfLife() time.Duration {
+	if w == Week {
+		return 36 * time.Hour
+	}
+	return 6 * time.Hour
+}
+
+var Windows = []Window{Day, Week}
+
+const (
+	// StartWeight and CompleteWeight are how much each playback event adds
+	// to the popularity score before decay. Finishing a title is a
+	// stronger signal than opening it.
+	StartWeight    = 1.0
+	CompleteWeight = 2.0
+
+	// MaxRanked is how many contents are kept per window.
+	MaxRanked = 100
+	// TopTenSize is the size of the "Top 10 today" rail.
+	TopTenSize = 10
+)
+
+// Score is the popularity of a content over a window.
+type Score struct {
+	ContentID  string
+	Score      float64
+	Rank       int
+	ComputedAt time.Time
+}
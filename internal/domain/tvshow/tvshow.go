@@ -63,6 +63,39 @@ func (t *TvShow) AddThumbnail(thumb *thumbnail.Thumbnail) error {
 	return nil
 }
 
+// NextEpisode returns the episode that follows the given one, moving on to
+// the next season when needed, or nil when it is the last episode.
+func (t *TvShow) NextEpisode(episodeID string) *episode.Episode {
+	var current *episode.Episode
+	for _, ep := range t.episodes {
+		if ep.ID() == episodeID {
+			current = ep
+			break
+		}
+	}
+	if current == nil {
+		return nil
+	}
+
+	var next *episode.Episode
+	for _, ep := range t.episodes {
+		if !isAfter(ep, current) {
+			continue
+		}
+		if next == nil || isAfter(next, ep) {
+			next = ep
+		}
+	}
+	return next
+}
+
+func isAfter(a, b *episode.Episode) bool {
+	if a.Season() != b.Season() {
+		return a.Season() > b.Season()
+	}
+	return a.Number() > b.Number()
+}
+
 func (t *TvShow) ID() string                      { return t.id }
 func (t *TvShow) Thumbnail() *thumbnail.Thumbnail { return t.thumbnail }
 func (t *TvShow) Episodes() []*episode.Episode    { return t.episodes }
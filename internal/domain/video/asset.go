@@ -0,0 +1,95 @@
+package video
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssetKind string
+
+const (
+	SubtitleAsset  AssetKind = "SUBTITLE"
+	AudioAsset     AssetKind = "AUDIO"
+	TrickPlayAsset AssetKind = "TRICKPLAY"
+	DASHAsset      AssetKind = "DASH"
+)
+
+// assetExtensions are the file types players accept for each kind.
+var assetExtensions = map[AssetKind][]string{
+	SubtitleAsset:  {".vtt", ".srt"},
+	AudioAsset:     {".m4a", ".mp4", ".aac", ".mp3"},
+	TrickPlayAsset: {".vtt", ".bif", ".jpg", ".png"},
+	DASHAsset:      {".mpd"},
+}
+
+// Asset is a file delivered alongside a video: a subtitle or alternate
+// audio track, the trick-play thumbnails shown while seeking, or a DASH
+// manifest packaged outside the API.
+type Asset struct {
+	id        string
+	videoID   string
+	kind      AssetKind
+	language  string
+	label     string
+	url       string
+	createdAt time.Time
+}
+
+// NewAsset builds an asset; subtitle and audio tracks need the language
+// they are in.
+func NewAsset(videoID string, kind AssetKind, language, label, url string) (*Asset, error) {
+	if videoID == "" {
+		return nil, errors.New("video id is required")
+	}
+
+	extensions, ok := assetExtensions[kind]
+	if !ok {
+		return nil, errors.New("invalid asset kind")
+	}
+
+	if url == "" {
+		return nil, errors.New("asset url is required")
+	}
+	if !slices.Contains(extensions, strings.ToLower(filepath.Ext(url))) {
+		return nil, errors.New("unsupported file type for " + strings.ToLower(string(kind)) + " assets")
+	}
+
+	if (kind == SubtitleAsset || kind == AudioAsset) && language == "" {
+		return nil, errors.New("language is required for subtitle and audio tracks")
+	}
+
+	return &Asset{
+		id:        uuid.NewString(),
+		videoID:   videoID,
+		kind:      kind,
+		language:  language,
+		label:     label,
+		url:       url,
+		createdAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateAsset(id, videoID string, kind AssetKind, language, label, url string, createdAt time.Time) *Asset {
+	return &Asset{
+		id:        id,
+		videoID:   videoID,
+		kind:      kind,
+		language:  language,
+		label:     label,
+		url:       url,
+		createdAt: createdAt,
+	}
+}
+
+func (a *Asset) ID() string           { return a.id }
+func (a *Asset) VideoID() string      { return a.videoID }
+func (a *Asset) Kind() AssetKind      { return a.kind }
+func (a *Asset) Language() string     { return a.language }
+func (a *Asset) Label() string        { return a.label }
+func (a *Asset) URL() string          { return a.url }
+func (a *Asset) CreatedAt() time.Time { return a.createdAt }
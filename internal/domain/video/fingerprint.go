@@ -0,0 +1,64 @@
+package video
+
+import "math/bits"
+
+// Fingerprint is an audio fingerprint: one 32 bit sub-fingerprint per
+// frame of FrameSeconds, as in Haitsma and Kalker's scheme, so that the
+// same audio yields nearly the same bits even after re-encoding.
+type Fingerprint struct {
+	Frames       []uint32
+	FrameSeconds float64
+}
+
+const (
+	// maxFrameBitErrors is how many of the 32 bits two frames may differ by
+	// and still count as the same audio.
+	maxFrameBitErrors = 8
+	// maxFrameGap is how many mismatched frames a shared run may bridge.
+	maxFrameGap = 4
+)
+
+// SharedSpan finds the longest stretch of audio two fingerprints have in
+// common, such as the intro two episodes share, and returns where it lies
+// in each. Stretches shorter than minSeconds are ignored.
+func SharedSpan(a, b Fingerprint, minSeconds int) (spanA, spanB Span, ok bool) {
+	if a.FrameSeconds <= 0 || a.FrameSeconds != b.FrameSeconds {
+		return Span{}, Span{}, false
+	}
+
+	bestStart, bestLength, bestShift := 0, 0, 0
+	// shift is the offset of b against a: frame i of a lines up with frame
+	// i-shift of b.
+	for shift := -(len(b.Frames) - 1); shift < len(a.Frames); shift++ {
+		from, to := max(0, shift), min(len(a.Frames), len(b.Frames)+shift)
+
+		runStart, runEnd, gap := -1, -1, 0
+		for i := from; i < to; i++ {
+			if bits.OnesCount32(a.Frames[i]^b.Frames[i-shift]) <= maxFrameBitErrors {
+				if runStart < 0 {
+					runStart = i
+				}
+				runEnd, gap = i+1, 0
+				if runEnd-runStart > bestLength {
+					bestStart, bestLength, bestShift = runStart, runEnd-runStart, shift
+				}
+				continue
+			}
+			if gap++; gap > maxFrameGap {
+				runStart, gap = -1, 0
+			}
+		}
+	}
+
+	if float64(bestLength)*a.FrameSeconds < float64(minSeconds) {
+		return Span{}, Span{}, false
+	}
+
+	toSpan := func(start int) Span {
+		return Span{
+			Start: int(float64(start) * a.FrameSeconds),
+			End:   int(float64(start+bestLength) * a.FrameSeconds),
+		}
+	}
+	return toSpan(bestStart), toSpan(bestStart - bestShift), true
+}
@@ -0,0 +1,85 @@
+package video
+
+import (
+	"crypto/rand"
+	"errors"
+	"time"
+)
+
+// ContentKeySize is the size in bytes of AES-128 content keys and IVs.
+const ContentKeySize = 16
+
+// HLSPackage is the AES-128 encrypted HLS rendition of a video. Its content
+// key only ever leaves the server through the key endpoint.
+type HLSPackage struct {
+	videoID     string
+	playlistURL string
+	key         []byte
+	iv          []byte
+	createdAt   time.Time
+}
+
+// GenerateContentKey returns a random content key and IV for packaging.
+func GenerateContentKey() (key, iv []byte, err error) {
+	key = make([]byte, ContentKeySize)
+	iv = make([]byte, ContentKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, err
+	}
+	return key, iv, nil
+}
+
+func NewHLSPackage(videoID, playlistURL string, key, iv []byte) (*HLSPackage, error) {
+	if videoID == "" {
+		return nil, errors.New("video id is required")
+	}
+
+	if playlistURL == "" {
+		return nil, errors.New("playlist url is required")
+	}
+
+	if len(key) != ContentKeySize || len(iv) != ContentKeySize {
+		return nil, errors.New("content key and iv must be 16 bytes")
+	}
+
+	return &HLSPackage{
+		videoID:     videoID,
+		playlistURL: playlistURL,
+		key:         key,
+		iv:          iv,
+		createdAt:   time.Now().UTC(),
+	}, nil
+}
+
+func HydrateHLSPackage(videoID, playlistURL string, key, iv []byte, createdAt time.Time) *HLSPackage {
+	return &HLSPackage{
+		videoID:     videoID,
+		playlistURL: playlistURL,
+		key:         key,
+		iv:          iv,
+		createdAt:   createdAt,
+	}
+}
+
+func (p *HLSPackage) VideoID() string {
+	return p.videoID
+}
+
+func (p *HLSPackage) PlaylistURL() string {
+	return p.playlistURL
+}
+
+func (p *HLSPackage) Key() []byte {
+	return p.key
+}
+
+func (p *HLSPackage) IV() []byte {
+	return p.iv
+}
+
+func (p *HLSPackage) CreatedAt() time.Time {
+	return p.createdAt
+}
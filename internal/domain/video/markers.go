@@ -0,0 +1,92 @@
+package video
+
+import (
+	"errors"
+	"time"
+)
+
+type MarkerSource string
+
+const (
+	ManualSource   MarkerSource = "MANUAL"
+	DetectedSource MarkerSource = "DETECTED"
+)
+
+// Span is a part of a video, in whole seconds from its start.
+type Span struct {
+	Start int
+	End   int
+}
+
+func (s Span) validate(duration int) error {
+	if s.Start < 0 || s.End <= s.Start {
+		return errors.New("marker end must come after its start")
+	}
+	if duration > 0 && s.End > duration {
+		return errors.New("marker ends after the video")
+	}
+	return nil
+}
+
+// Markers point players at the parts of a video worth skipping: the intro
+// and recap, and the credits, where the next episode can be offered.
+type Markers struct {
+	videoID      string
+	intro        *Span
+	recap        *Span
+	creditsStart *int
+	source       MarkerSource
+	updatedAt    time.Time
+}
+
+// NewMarkers validates markers against the video duration in seconds;
+// a zero duration skips the upper bound checks.
+func NewMarkers(videoID string, duration int, intro, recap *Span, creditsStart *int, source MarkerSource) (*Markers, error) {
+	if videoID == "" {
+		return nil, errors.New("video id is required")
+	}
+
+	for _, span := range []*Span{intro, recap} {
+		if span == nil {
+			continue
+		}
+		if err := span.validate(duration); err != nil {
+			return nil, err
+		}
+	}
+
+	if creditsStart != nil && (*creditsStart < 0 || (duration > 0 && *creditsStart >= duration)) {
+		return nil, errors.New("credits must start within the video")
+	}
+
+	if source != ManualSource && source != DetectedSource {
+		return nil, errors.New("invalid marker source")
+	}
+
+	return &Markers{
+		videoID:      videoID,
+		intro:        intro,
+		recap:        recap,
+		creditsStart: creditsStart,
+		source:       source,
+		updatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func HydrateMarkers(videoID string, intro, recap *Span, creditsStart *int, source MarkerSource, updatedAt time.Time) *Markers {
+	return &Markers{
+		videoID:      videoID,
+		intro:        intro,
+		recap:        recap,
+		creditsStart: creditsStart,
+		source:       source,
+		updatedAt:    updatedAt,
+	}
+}
+
+func (m *Markers) VideoID() string      { return m.videoID }
+func (m *Markers) Intro() *Span         { return m.intro }
+func (m *Markers) Recap() *Span         { return m.recap }
+func (m *Markers) CreditsStart() *int   { return m.creditsStart }
+func (m *Markers) Source() MarkerSource { return m.source }
+func (m *Markers) UpdatedAt() time.Time { return m.updatedAt }
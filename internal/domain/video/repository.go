@@ -5,8 +5,36 @@ import (
 	"errors"
 )
 
-var ErrNotFound = errors.New("video not found")
+var (
+	ErrNotFound        = errors.New("video not found")
+	ErrPackageNotFound = errors.New("video has not been packaged for hls")
+	ErrMarkersNotFound = errors.New("video has no markers")
+	ErrAssetNotFound   = errors.New("video asset not found")
+)
 
 type Repository interface {
 	FindByID(ctx context.Context, id string) (*Video, error)
 }
+
+// PackageRepository stores HLS packages. Implementations keep content keys
+// encrypted at rest.
+type PackageRepository interface {
+	// Save creates or replaces the package of its video.
+	Save(ctx context.Context, hlsPackage *HLSPackage) error
+	FindByVideoID(ctx context.Context, videoID string) (*HLSPackage, error)
+}
+
+type MarkerRepository interface {
+	// Save creates or replaces the markers of their video.
+	Save(ctx context.Context, markers *Markers) error
+	FindByVideoID(ctx context.Context, videoID string) (*Markers, error)
+	ListByVideoIDs(ctx context.Context, videoIDs []string) ([]*Markers, error)
+	Delete(ctx context.Context, videoID string) error
+}
+
+type AssetRepository interface {
+	Save(ctx context.Context, asset *Asset) error
+	FindByID(ctx context.Context, id string) (*Asset, error)
+	ListByVideoID(ctx context.Context, videoID string) ([]*Asset, error)
+	Delete(ctx context.Context, id string) error
+}
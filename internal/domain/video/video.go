@@ -12,11 +12,14 @@ type Video struct {
 	url       string
 	sizeInKB  int
 	duration  int
+	height    int
 	createdAt time.Time
 	updatedAt time.Time
 }
 
-func NewVideo(url string, sizeInKB, duration int) (*Video, error) {
+// NewVideo creates a video. Height is the vertical resolution in pixels, or
+// zero when it is unknown.
+func NewVideo(url string, sizeInKB, duration, height int) (*Video, error) {
 	if url == "" {
 		return nil, errors.New("video url is required")
 	}
@@ -29,22 +32,28 @@ func NewVideo(url string, sizeInKB, duration int) (*Video, error) {
 		return nil, errors.New("video duration must be positive")
 	}
 
+	if height < 0 {
+		return nil, errors.New("video height cannot be negative")
+	}
+
 	return &Video{
 		id:        uuid.NewString(),
 		url:       url,
 		sizeInKB:  sizeInKB,
 		duration:  duration,
+		height:    height,
 		createdAt: time.Now().UTC(),
 		updatedAt: time.Now().UTC(),
 	}, nil
 }
 
-func HydrateVideo(id, url string, sizeInKB, duration int, createdAt, updatedAt time.Time) *Video {
+func HydrateVideo(id, url string, sizeInKB, duration, height int, createdAt, updatedAt time.Time) *Video {
 	return &Video{
 		id:        id,
 		url:       url,
 		sizeInKB:  sizeInKB,
 		duration:  duration,
+		height:    height,
 		createdAt: createdAt,
 		updatedAt: updatedAt,
 	}
@@ -66,6 +75,10 @@ func (v *Video) Duration() int {
 	return v.duration
 }
 
+func (v *Video) Height() int {
+	return v.height
+}
+
 func (v *Video) CreatedAt() time.Time {
 	return v.createdAt
 }
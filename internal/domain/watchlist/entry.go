@@ -0,0 +1,44 @@
+package watchlist
+
+import (
+	"errors"
+	"time"
+)
+
+// Entry is a content saved on a profile's list. Entries are ordered by
+// position, lowest first.
+type Entry struct {
+	profileID string
+	contentID string
+	position  int
+	addedAt   time.Time
+}
+
+func NewEntry(profileID, contentID string) (*Entry, error) {
+	if profileID == "" {
+		return nil, errors.New("watchlist profile is required")
+	}
+	if contentID == "" {
+		return nil, errors.New("watchlist content is required")
+	}
+
+	return &Entry{
+		profileID: profileID,
+		contentID: contentID,
+		addedAt:   time.Now().UTC(),
+	}, nil
+}
+
+func HydrateEntry(profileID, contentID string, position int, addedAt time.Time) *Entry {
+	return &Entry{
+		profileID: profileID,
+		contentID: contentID,
+		position:  position,
+		addedAt:   addedAt,
+	}
+}
+
+func (e *Entry) ProfileID() string  { return e.profileID }
+func (e *Entry) ContentID() string  { return e.contentID }
+func (e *Entry) Position() int      { return e.position }
+func (e *Entry) AddedAt() time.Time { return e.addedAt }
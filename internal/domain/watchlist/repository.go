@@ -0,0 +1,23 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Repository interface {
+	// Add puts the entry at the top of the profile's list. Adding a content
+	// that is already listed keeps its current position.
+	Add(ctx context.Context, entry *Entry) error
+	// Move places a listed content at the given 1-based position, shifting
+	// the others. Positions past the end move the content to the bottom.
+	Move(ctx context.Context, profileID, contentID string, position int) error
+	// Remove takes the content off the list. Removing an unlisted content is
+	// a no-op.
+	Remove(ctx context.Context, profileID, contentID string) error
+	// List pages through the profile's list in order, skipping contents that
+	// were deleted from the catalog.
+	List(ctx context.Context, profileID string, offset, limit int) ([]*Entry, int, error)
+}
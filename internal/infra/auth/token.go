@@ -0,0 +1,107 @@
+// Package auth issues and validates the signed access tokens (HS256 JWTs)
+// that identify an account, the device it signed in from and, optionally,
+// the profile it is acting as, and the signed media URLs that stand in for
+// them in players.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+type Claims struct {
+	AccountID string `json:"sub"`
+	ProfileID string `json:"pid,omitempty"`
+	DeviceID  string `json:"did,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	// PINUnlocked is only carried by signed media URLs, issued once the
+	// profile PIN was checked for the content they point to.
+	PINUnlocked bool `json:"-"`
+}
+
+type TokenService interface {
+	Issue(accountID, profileID, deviceID string) (string, time.Time, error)
+	Parse(token string) (*Claims, error)
+}
+
+type jwtService struct {
+	secret []byte
+	ttl    time.Duration
+	logger *log.Logger
+}
+
+func NewJWTService(secret string, ttl time.Duration, logger *log.Logger) TokenService {
+	return &jwtService{
+		secret: []byte(secret),
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+var encodedHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func (s *jwtService) Issue(accountID, profileID, deviceID string) (string, time.Time, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.ttl)
+
+	payload, err := json.Marshal(Claims{
+		AccountID: accountID,
+		ProfileID: profileID,
+		DeviceID:  deviceID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	unsigned := encodedHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + s.sign(unsigned), expiresAt, nil
+}
+
+func (s *jwtService) Parse(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != encodedHeader {
+		return nil, ErrInvalidToken
+	}
+
+	expected := s.sign(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(expected), []byte(parts[2])) {
+		s.logger.Debug("Token signature mismatch")
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.AccountID == "" {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrExpiredToken
+	}
+
+	return &claims, nil
+}
+
+func (s *jwtService) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
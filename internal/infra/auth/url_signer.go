@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	signatureParam = "sig"
+	expiresParam   = "exp"
+	accountParam   = "acc"
+	profileParam   = "pid"
+	deviceParam    = "did"
+	pinParam       = "pin"
+)
+
+// URLSigner signs media URLs so players can fetch them as the viewer they
+// were issued to without sending an Authorization header, as <video> and
+// <track> elements cannot.
+type URLSigner interface {
+	// Sign appends the viewer in claims, an expiry and a signature covering
+	// the path and every query param to rawURL. The expiry of claims is
+	// ignored in favour of the signer's own.
+	Sign(rawURL string, claims Claims) (string, time.Time, error)
+	// IsSigned tells whether a URL carries a signature to verify.
+	IsSigned(u *url.URL) bool
+	// Verify checks the signature and expiry of a URL and returns the viewer
+	// it was signed for; AccountID is empty for anonymous viewers.
+	Verify(u *url.URL) (*Claims, error)
+}
+
+type hmacURLSigner struct {
+	secret []byte
+	ttl    time.Duration
+	logger *log.Logger
+}
+
+func NewURLSigner(secret string, ttl time.Duration, logger *log.Logger) URLSigner {
+	return &hmacURLSigner{
+		secret: []byte(secret),
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+func (s *hmacURLSigner) Sign(rawURL string, claims Claims) (string, time.Time, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().UTC().Add(s.ttl)
+
+	query := u.Query()
+	query.Del(signatureParam)
+	query.Set(expiresParam, strconv.FormatInt(expiresAt.Unix(), 10))
+	for param, value := range map[string]string{
+		accountParam: claims.AccountID,
+		profileParam: claims.ProfileID,
+		deviceParam:  claims.DeviceID,
+	} {
+		query.Del(param)
+		if value != "" {
+			query.Set(param, value)
+		}
+	}
+	query.Del(pinParam)
+	if claims.PINUnlocked {
+		query.Set(pinParam, "1")
+	}
+
+	query.Set(signatureParam, s.sign(u.Path, query))
+	u.RawQuery = query.Encode()
+	return u.String(), expiresAt, nil
+}
+
+func (s *hmacURLSigner) IsSigned(u *url.URL) bool {
+	return u.Query().Has(signatureParam)
+}
+
+func (s *hmacURLSigner) Verify(u *url.URL) (*Claims, error) {
+	query := u.Query()
+	signature := query.Get(signatureParam)
+	query.Del(signatureParam)
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(u.Path, query))) {
+		s.logger.Debug("URL signature mismatch", "path", u.Path)
+		return nil, ErrInvalidToken
+	}
+
+	expiresAt, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() >= expiresAt {
+		return nil, ErrExpiredToken
+	}
+
+	return &Claims{
+		AccountID:   query.Get(accountParam),
+		ProfileID:   query.Get(profileParam),
+		DeviceID:    query.Get(deviceParam),
+		ExpiresAt:   expiresAt,
+		PINUnlocked: query.Get(pinParam) == "1",
+	}, nil
+}
+
+// sign covers the query in its encoded form, which sorts params by key,
+// so the signature does not depend on the order players send them in.
+func (s *hmacURLSigner) sign(path string, query url.Values) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(path + "?" + query.Encode()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -29,10 +29,47 @@ type Config struct {
 	// StorageSecretKey  string `mapstructure:"STORAGE_SECRET_KEY"`
 	// StorageBucketName string `mapstructure:"STORAGE_BUCKET_NAME"`
 	//
-	// JWTAccessSecret     string `mapstructure:"JWT_ACCESS_SECRET"`
-	// JWTRefreshSecret    string `mapstructure:"JWT_REFRESH_SECRET"`
-	// JWTAccessExpMinutes int16  `mapstructure:"JWT_ACCESS_EXP_MINUTES"`
-	// JWTRefreshExpHours  int16  `mapstructure:"JWT_REFRESH_EXP_HOURS"`
+	JWTAccessSecret     string `mapstructure:"JWT_ACCESS_SECRET"`
+	JWTAccessExpMinutes int16  `mapstructure:"JWT_ACCESS_EXP_MINUTES"`
+
+	// Refresh tokens are opaque and stored hashed on the device they were
+	// issued to, so they need no secret.
+	RefreshTokenExpHours int16 `mapstructure:"REFRESH_TOKEN_EXP_HOURS"`
+
+	// Media URLs handed to players by the playback endpoint are signed so
+	// they work without an Authorization header.
+	SignedURLSecret     string `mapstructure:"SIGNED_URL_SECRET"`
+	SignedURLTTLSeconds int    `mapstructure:"SIGNED_URL_TTL_SECONDS"`
+
+	// DownloadLicenseTTLHours is how long a download plays offline before
+	// the device must renew it.
+	DownloadLicenseTTLHours int `mapstructure:"DOWNLOAD_LICENSE_TTL_HOURS"`
+
+	RecommendationsRefreshSeconds int `mapstructure:"RECOMMENDATIONS_REFRESH_SECONDS"`
+	TrendingRollupSeconds         int `mapstructure:"TRENDING_ROLLUP_SECONDS"`
+	PublishScheduledSeconds       int `mapstructure:"PUBLISH_SCHEDULED_SECONDS"`
+	SubscriptionRenewalSeconds    int `mapstructure:"SUBSCRIPTION_RENEWAL_SECONDS"`
+	PlaybackSessionTimeoutSeconds int `mapstructure:"PLAYBACK_SESSION_TIMEOUT_SECONDS"`
+
+	// Streaming throughput limits in bytes per second; zero disables a
+	// limit. Clients may burst StreamBurstSeconds worth of the video.
+	StreamGlobalBytesPerSecond  int `mapstructure:"STREAM_GLOBAL_BYTES_PER_SECOND"`
+	StreamClientBytesPerSecond  int `mapstructure:"STREAM_CLIENT_BYTES_PER_SECOND"`
+	StreamSessionBytesPerSecond int `mapstructure:"STREAM_SESSION_BYTES_PER_SECOND"`
+	StreamBurstSeconds          int `mapstructure:"STREAM_BURST_SECONDS"`
+
+	// In-memory cache of streamed files; files above the entry limit are
+	// always read from disk. A zero size disables the cache.
+	MediaCacheMaxBytes      int64 `mapstructure:"MEDIA_CACHE_MAX_BYTES"`
+	MediaCacheMaxEntryBytes int64 `mapstructure:"MEDIA_CACHE_MAX_ENTRY_BYTES"`
+
+	// HLSMasterKey is the hex encoded AES-256 key content keys are
+	// encrypted with at rest.
+	HLSMasterKey string `mapstructure:"HLS_MASTER_KEY"`
+
+	GeoResolver       string `mapstructure:"GEO_RESOLVER"`
+	GeoCountryHeader  string `mapstructure:"GEO_COUNTRY_HEADER"`
+	GeoIPDatabasePath string `mapstructure:"GEOIP_DATABASE_PATH"`
 }
 
 func GetConfig() *Config {
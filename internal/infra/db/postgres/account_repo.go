@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/account"
+	"gorm.io/gorm"
+)
+
+type accountRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewAccountRepository(db *gorm.DB, logger *log.Logger) account.Repository {
+	return &accountRepository{db: db, logger: logger}
+}
+
+func (r *accountRepository) Save(ctx context.Context, accountEntity *account.Account) error {
+	accountModel := AccountModel{
+		ID:           accountEntity.ID(),
+		Email:        accountEntity.Email(),
+		PasswordHash: accountEntity.PasswordHash(),
+		Role:         string(accountEntity.Role()),
+		CreatedAt:    accountEntity.CreatedAt(),
+		UpdatedAt:    accountEntity.UpdatedAt(),
+	}
+	if err := r.db.WithContext(ctx).Save(&accountModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return account.ErrConflict
+		}
+		r.logger.Error("Failed to save account", "accountID", accountEntity.ID(), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountRepository) FindByID(ctx context.Context, id string) (*account.Account, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *accountRepository) FindByEmail(ctx context.Context, email string) (*account.Account, error) {
+	return r.findOne(ctx, "email = ?", email)
+}
+
+func (r *accountRepository) findOne(ctx context.Context, query string, args ...any) (*account.Account, error) {
+	var model AccountModel
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, account.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return account.HydrateAccount(
+		model.ID,
+		model.Email,
+		model.PasswordHash,
+		account.Role(model.Role),
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
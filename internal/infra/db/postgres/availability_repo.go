@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/availability"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	allowCountryRule = "ALLOW"
+	denyCountryRule  = "DENY"
+)
+
+type availabilityRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewAvailabilityRepository(db *gorm.DB, logger *log.Logger) availability.Repository {
+	return &availabilityRepository{db: db, logger: logger}
+}
+
+func (r *availabilityRepository) Save(ctx context.Context, rule *availability.Rule) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ruleModel := ContentAvailabilityModel{
+			ContentID: rule.ContentID(),
+			StartsAt:  rule.StartsAt(),
+			EndsAt:    rule.EndsAt(),
+			UpdatedAt: rule.UpdatedAt(),
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "content_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"starts_at", "ends_at", "updated_at"}),
+		}).Omit("Countries").Create(&ruleModel).Error
+		if err != nil {
+			r.logger.Error("Failed to upsert availability rule", "contentID", rule.ContentID(), "error", err)
+			return err
+		}
+
+		if err := tx.Delete(&ContentAvailabilityCountryModel{}, "content_id = ?", rule.ContentID()).Error; err != nil {
+			return err
+		}
+
+		countries := make([]ContentAvailabilityCountryModel, 0, len(rule.AllowedCountries())+len(rule.DeniedCountries()))
+		for _, country := range rule.AllowedCountries() {
+			countries = append(countries, ContentAvailabilityCountryModel{ContentID: rule.ContentID(), CountryCode: country, Rule: allowCountryRule})
+		}
+		for _, country := range rule.DeniedCountries() {
+			countries = append(countries, ContentAvailabilityCountryModel{ContentID: rule.ContentID(), CountryCode: country, Rule: denyCountryRule})
+		}
+		if len(countries) == 0 {
+			return nil
+		}
+		return tx.Create(&countries).Error
+	})
+}
+
+func (r *availabilityRepository) FindByContentID(ctx context.Context, contentID string) (*availability.Rule, error) {
+	var model ContentAvailabilityModel
+	err := r.db.WithContext(ctx).
+		Preload("Countries", func(db *gorm.DB) *gorm.DB {
+			return db.Order("country_code")
+		}).
+		First(&model, "content_id = ?", contentID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, availability.ErrNotFound
+		}
+		return nil, err
+	}
+
+	allowed, denied := []string{}, []string{}
+	for _, country := range model.Countries {
+		if country.Rule == allowCountryRule {
+			allowed = append(allowed, country.CountryCode)
+		} else {
+			denied = append(denied, country.CountryCode)
+		}
+	}
+
+	return availability.HydrateRule(model.ContentID, allowed, denied, model.StartsAt, model.EndsAt, model.UpdatedAt), nil
+}
+
+func (r *availabilityRepository) Delete(ctx context.Context, contentID string) error {
+	result := r.db.WithContext(ctx).Delete(&ContentAvailabilityModel{}, "content_id = ?", contentID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return availability.ErrNotFound
+	}
+	return nil
+}
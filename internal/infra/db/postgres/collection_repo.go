@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewCollectionRepository(db *gorm.DB, logger *log.Logger) collection.Repository {
+	return &collectionRepository{db: db, logger: logger}
+}
+
+func (r *collectionRepository) Save(ctx context.Context, collectionEntity *collection.Collection) error {
+	log := r.logger.With("collectionID", collectionEntity.ID())
+	log.Debug("Starting save transaction for collection")
+
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	var artworkID *string
+	if artworkEntity := collectionEntity.Artwork(); artworkEntity != nil {
+		artworkModel := ThumbnailModel{
+			ID:        artworkEntity.ID(),
+			URL:       artworkEntity.URL(),
+			CreatedAt: artworkEntity.CreatedAt(),
+			UpdatedAt: artworkEntity.UpdatedAt(),
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&artworkModel).Error; err != nil {
+			return err
+		}
+		artworkID = &artworkModel.ID
+	}
+
+	collectionModel := CollectionModel{
+		ID:           collectionEntity.ID(),
+		Slug:         collectionEntity.Slug(),
+		Title:        collectionEntity.Title(),
+		Description:  collectionEntity.Description(),
+		ArtworkID:    artworkID,
+		PublishFrom:  collectionEntity.PublishFrom(),
+		PublishUntil: collectionEntity.PublishUntil(),
+		CreatedAt:    collectionEntity.CreatedAt(),
+		UpdatedAt:    collectionEntity.UpdatedAt(),
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "description", "artwork_id", "publish_from", "publish_until", "updated_at"}),
+	}).Create(&collectionModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return collection.ErrConflict
+		}
+		log.Error("Failed to upsert collection model in transaction", "error", err)
+		return err
+	}
+
+	if err := tx.Delete(&CollectionItemModel{}, "collection_id = ?", collectionEntity.ID()).Error; err != nil {
+		return err
+	}
+	if contentIDs := collectionEntity.ContentIDs(); len(contentIDs) > 0 {
+		items := make([]CollectionItemModel, 0, len(contentIDs))
+		for i, contentID := range contentIDs {
+			items = append(items, CollectionItemModel{
+				CollectionID: collectionEntity.ID(),
+				ContentID:    contentID,
+				Position:     i + 1,
+			})
+		}
+		if err := tx.Create(&items).Error; err != nil {
+			log.Error("Failed to create collection items in transaction", "error", err)
+			return err
+		}
+	}
+
+	log.Debug("Finishing save transaction")
+	return tx.Commit().Error
+}
+
+func (r *collectionRepository) FindBySlug(ctx context.Context, slug string) (*collection.Collection, error) {
+	var model CollectionModel
+
+	err := r.db.WithContext(ctx).
+		Preload("Artwork").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		First(&model, "slug = ?", slug).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, collection.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainCollection(&model), nil
+}
+
+func (r *collectionRepository) List(ctx context.Context, offset, limit int) ([]*collection.Collection, int, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&CollectionModel{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*CollectionModel
+	err := r.db.WithContext(ctx).
+		Preload("Artwork").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	collections := make([]*collection.Collection, 0, len(models))
+	for _, model := range models {
+		collections = append(collections, toDomainCollection(model))
+	}
+
+	return collections, int(total), nil
+}
+
+func (r *collectionRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&CollectionModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return collection.ErrNotFound
+	}
+	return nil
+}
+
+func toDomainCollection(model *CollectionModel) *collection.Collection {
+	var artworkEntity *thumbnail.Thumbnail
+	if model.Artwork != nil {
+		artworkEntity = toDomainThumbnail(model.Artwork)
+	}
+
+	contentIDs := make([]string, 0, len(model.Items))
+	for _, item := range model.Items {
+		contentIDs = append(contentIDs, item.ContentID)
+	}
+
+	return collection.HydrateCollection(
+		model.ID,
+		model.Slug,
+		model.Title,
+		model.Description,
+		artworkEntity,
+		contentIDs,
+		model.PublishFrom,
+		model.PublishUntil,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
@@ -3,15 +3,43 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/charmbracelet/log"
 	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/episode"
 	"github.com/hoyci/fakeflix/internal/domain/movie"
 	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"github.com/hoyci/fakeflix/internal/domain/tvshow"
 	"github.com/hoyci/fakeflix/internal/domain/video"
 	"gorm.io/gorm"
 )
 
+// availableContent keeps contents whose availability rule, if any, is
+// open at the given time and neither denies the given country nor leaves it
+// out of a non-empty allow list. Its arguments are the time twice, then the
+// country twice.
+const availableContent = `NOT EXISTS (
+	SELECT 1 FROM content_availability ca
+	WHERE ca.content_id = contents.id AND (
+		ca.starts_at > ? OR ca.ends_at <= ?
+		OR EXISTS (
+			SELECT 1 FROM content_availability_countries cc
+			WHERE cc.content_id = ca.content_id AND cc.rule = 'DENY' AND cc.country_code = ?
+		)
+		OR (
+			EXISTS (
+				SELECT 1 FROM content_availability_countries cc
+				WHERE cc.content_id = ca.content_id AND cc.rule = 'ALLOW'
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM content_availability_countries cc
+				WHERE cc.content_id = ca.content_id AND cc.rule = 'ALLOW' AND cc.country_code = ?
+			)
+		)
+	)
+)`
+
 type contentRepository struct {
 	db     *gorm.DB
 	logger *log.Logger
@@ -40,6 +68,7 @@ func (r *contentRepository) Save(ctx context.Context, contentEntity *content.Con
 		URL:       videoEntity.URL(),
 		SizeInKb:  videoEntity.SizeInKB(),
 		Duration:  videoEntity.Duration(),
+		Height:    videoEntity.Height(),
 		CreatedAt: videoEntity.CreatedAt(),
 		UpdatedAt: videoEntity.UpdatedAt(),
 	}
@@ -62,12 +91,20 @@ func (r *contentRepository) Save(ctx context.Context, contentEntity *content.Con
 	}
 
 	contentModel := ContentModel{
-		ID:          contentEntity.ID(),
-		Title:       contentEntity.Title(),
-		Description: contentEntity.Description(),
-		ContentType: contentEntity.ContentType(),
-		CreatedAt:   contentEntity.CreatedAt(),
-		UpdatedAt:   contentEntity.UpdatedAt(),
+		ID:            contentEntity.ID(),
+		Title:         contentEntity.Title(),
+		Description:   contentEntity.Description(),
+		ContentType:   contentEntity.ContentType(),
+		MaturityLevel: contentEntity.MaturityLevel(),
+		Status:        string(contentEntity.Status()),
+		PublishAt:     contentEntity.PublishAt(),
+		CreatedAt:     contentEntity.CreatedAt(),
+		UpdatedAt:     contentEntity.UpdatedAt(),
+	}
+	if rating := contentEntity.AgeRating(); !rating.IsZero() {
+		system, value := string(rating.System()), rating.Value()
+		contentModel.RatingSystem = &system
+		contentModel.RatingValue = &value
 	}
 	if err := tx.Create(&contentModel).Error; err != nil {
 		log.Error("Failed to create content model in transaction", "error", err)
@@ -96,8 +133,12 @@ func (r *contentRepository) FindByID(ctx context.Context, id string) (*content.C
 	err := r.db.WithContext(ctx).
 		Preload("Movie.Video").
 		Preload("Movie.Thumbnail").
-		// Preload("TvShow.Episodes.Video"). // Exemplo para quando implementar TvShow
-		// Preload("TvShow.Episodes.Thumbnail").
+		Preload("TvShow.Thumbnail").
+		Preload("TvShow.Episodes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("season, number")
+		}).
+		Preload("TvShow.Episodes.Video").
+		Preload("TvShow.Episodes.Thumbnail").
 		First(&model, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -109,6 +150,139 @@ func (r *contentRepository) FindByID(ctx context.Context, id string) (*content.C
 	return toDomainContent(&model)
 }
 
+func (r *contentRepository) FindByIDs(ctx context.Context, ids []string) ([]*content.Content, error) {
+	if len(ids) == 0 {
+		return []*content.Content{}, nil
+	}
+
+	var models []*ContentModel
+	err := r.db.WithContext(ctx).
+		Preload("Movie.Video").
+		Preload("Movie.Thumbnail").
+		Preload("TvShow.Thumbnail").
+		Find(&models, "id IN ?", ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make([]*content.Content, 0, len(models))
+	for _, model := range models {
+		contentEntity, err := toDomainContent(model)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, contentEntity)
+	}
+
+	return contents, nil
+}
+
+func (r *contentRepository) FindByVideoID(ctx context.Context, videoID string) (*content.Content, error) {
+	var contentID string
+
+	err := r.db.WithContext(ctx).
+		Raw("SELECT content_id FROM video_contents WHERE video_id = ? LIMIT 1", videoID).
+		Scan(&contentID).Error
+	if err != nil {
+		return nil, err
+	}
+	if contentID == "" {
+		return nil, content.ErrNotFound
+	}
+
+	return r.FindByID(ctx, contentID)
+}
+
+func (r *contentRepository) List(ctx context.Context, filter content.ListFilter) ([]*content.Content, int, error) {
+	filtered := func() *gorm.DB {
+		query := r.db.WithContext(ctx).Model(&ContentModel{})
+		if filter.MaxMaturityLevel != nil {
+			query = query.Where("maturity_level <= ?", *filter.MaxMaturityLevel)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if !filter.AvailableAt.IsZero() {
+			query = query.Where(availableContent, filter.AvailableAt, filter.AvailableAt, filter.Country, filter.Country)
+		}
+		if filter.Genre != "" {
+			query = query.Where("EXISTS (SELECT 1 FROM content_genres cg WHERE cg.content_id = contents.id AND cg.genre = ?)", filter.Genre)
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*ContentModel
+	err := filtered().
+		Preload("Movie.Video").
+		Preload("Movie.Thumbnail").
+		Preload("TvShow.Thumbnail").
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	contents := make([]*content.Content, 0, len(models))
+	for _, model := range models {
+		contentEntity, err := toDomainContent(model)
+		if err != nil {
+			return nil, 0, err
+		}
+		contents = append(contents, contentEntity)
+	}
+
+	return contents, int(total), nil
+}
+
+func (r *contentRepository) UpdatePublication(ctx context.Context, contentEntity *content.Content) error {
+	result := r.db.WithContext(ctx).
+		Model(&ContentModel{}).
+		Where("id = ?", contentEntity.ID()).
+		Updates(map[string]any{
+			"status":     string(contentEntity.Status()),
+			"publish_at": contentEntity.PublishAt(),
+			"updated_at": contentEntity.UpdatedAt(),
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to update content publication", "contentID", contentEntity.ID(), "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return content.ErrNotFound
+	}
+	return nil
+}
+
+func (r *contentRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*content.Content, error) {
+	var models []*ContentModel
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", content.ScheduledStatus, now).
+		Order("publish_at").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make([]*content.Content, 0, len(models))
+	for _, model := range models {
+		contentEntity, err := toDomainContent(model)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, contentEntity)
+	}
+
+	return contents, nil
+}
+
 func toDomainContent(model *ContentModel) (*content.Content, error) {
 	var media content.Media
 	var err error
@@ -122,7 +296,17 @@ func toDomainContent(model *ContentModel) (*content.Content, error) {
 			}
 		}
 	case content.TvShowType:
-		// Lógica para TvShow viria aqui
+		if model.TvShow != nil {
+			media = toDomainTvShow(model.TvShow)
+		}
+	}
+
+	var ageRating content.AgeRating
+	if model.RatingSystem != nil && model.RatingValue != nil {
+		ageRating, err = content.NewAgeRating(content.RatingSystem(*model.RatingSystem), *model.RatingValue)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return content.HydrateContent(
@@ -131,6 +315,9 @@ func toDomainContent(model *ContentModel) (*content.Content, error) {
 		model.Description,
 		model.ContentType,
 		media,
+		ageRating,
+		content.Status(model.Status),
+		model.PublishAt,
 		model.CreatedAt,
 		model.UpdatedAt,
 	), nil
@@ -153,12 +340,52 @@ func toDomainMovie(model *MovieModel) (*movie.Movie, error) {
 	), nil
 }
 
+func toDomainTvShow(model *TvShowModel) *tvshow.TvShow {
+	var thumbnailEntity *thumbnail.Thumbnail
+	if model.Thumbnail != nil {
+		thumbnailEntity = toDomainThumbnail(model.Thumbnail)
+	}
+
+	episodes := make([]*episode.Episode, 0, len(model.Episodes))
+	for _, episodeModel := range model.Episodes {
+		episodes = append(episodes, toDomainEpisode(episodeModel))
+	}
+
+	return tvshow.HydrateTvShow(
+		model.ID,
+		thumbnailEntity,
+		episodes,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func toDomainEpisode(model *EpisodeModel) *episode.Episode {
+	var thumbnailEntity *thumbnail.Thumbnail
+	if model.Thumbnail != nil {
+		thumbnailEntity = toDomainThumbnail(model.Thumbnail)
+	}
+
+	return episode.HydrateEpisode(
+		model.ID,
+		model.Title,
+		model.Description,
+		model.Season,
+		model.Number,
+		toDomainVideo(&model.Video),
+		thumbnailEntity,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
 func toDomainVideo(model *VideoModel) *video.Video {
 	return video.HydrateVideo(
 		model.ID,
 		model.URL,
 		model.SizeInKb,
 		model.Duration,
+		model.Height,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"gorm.io/gorm"
+)
+
+type deviceRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewDeviceRepository(db *gorm.DB, logger *log.Logger) device.Repository {
+	return &deviceRepository{db: db, logger: logger}
+}
+
+func (r *deviceRepository) Register(ctx context.Context, d *device.Device, maxActive int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize registrations per account so two sign ins cannot both
+		// take the last free device.
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "devices:"+d.AccountID()).Error; err != nil {
+			return err
+		}
+
+		if maxActive > 0 {
+			var active int64
+			err := tx.Model(&DeviceModel{}).
+				Where("account_id = ? AND signed_out_at IS NULL", d.AccountID()).
+				Count(&active).Error
+			if err != nil {
+				return err
+			}
+			if int(active) >= maxActive {
+				return device.ErrTooManyDevices
+			}
+		}
+
+		model := toDeviceModel(d)
+		if err := tx.Create(&model).Error; err != nil {
+			r.logger.Error("Failed to register device", "accountID", d.AccountID(), "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *deviceRepository) Save(ctx context.Context, d *device.Device) error {
+	model := toDeviceModel(d)
+	return r.db.WithContext(ctx).
+		Model(&DeviceModel{}).
+		Where("id = ?", d.ID()).
+		Select("user_agent", "refresh_token_hash", "refresh_expires_at", "last_seen_at", "signed_out_at").
+		Updates(&model).Error
+}
+
+func (r *deviceRepository) FindByID(ctx context.Context, id string) (*device.Device, error) {
+	var model DeviceModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, device.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainDevice(&model), nil
+}
+
+func (r *deviceRepository) ListActive(ctx context.Context, accountID string) ([]*device.Device, error) {
+	var models []DeviceModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND signed_out_at IS NULL", accountID).
+		Order("created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*device.Device, 0, len(models))
+	for _, model := range models {
+		devices = append(devices, toDomainDevice(&model))
+	}
+	return devices, nil
+}
+
+func toDeviceModel(d *device.Device) DeviceModel {
+	return DeviceModel{
+		ID:               d.ID(),
+		AccountID:        d.AccountID(),
+		Type:             string(d.Type()),
+		Name:             d.Name(),
+		UserAgent:        d.UserAgent(),
+		RefreshTokenHash: d.RefreshTokenHash(),
+		RefreshExpiresAt: d.RefreshExpiresAt(),
+		CreatedAt:        d.CreatedAt(),
+		LastSeenAt:       d.LastSeenAt(),
+		SignedOutAt:      d.SignedOutAt(),
+	}
+}
+
+func toDomainDevice(model *DeviceModel) *device.Device {
+	return device.HydrateDevice(
+		model.ID,
+		model.AccountID,
+		device.Type(model.Type),
+		model.Name,
+		model.UserAgent,
+		model.RefreshTokenHash,
+		model.RefreshExpiresAt,
+		model.CreatedAt,
+		model.LastSeenAt,
+		model.SignedOutAt,
+	)
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"gorm.io/gorm"
+)
+
+type downloadLicenseRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewDownloadLicenseRepository(db *gorm.DB, logger *log.Logger) download.Repository {
+	return &downloadLicenseRepository{db: db, logger: logger}
+}
+
+func (r *downloadLicenseRepository) Issue(ctx context.Context, license *download.License, maxActive int, now time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize issues per account so two devices cannot both take the
+		// last free download.
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "downloads:"+license.AccountID()).Error; err != nil {
+			return err
+		}
+
+		var active int64
+		err := tx.Model(&DownloadLicenseModel{}).
+			Where("account_id = ? AND revoked_at IS NULL AND expires_at > ?", license.AccountID(), now).
+			Count(&active).Error
+		if err != nil {
+			return err
+		}
+		if int(active) >= maxActive {
+			return download.ErrTooManyDownloads
+		}
+
+		model := toDownloadLicenseModel(license)
+		if err := tx.Create(&model).Error; err != nil {
+			r.logger.Error("Failed to create download license", "accountID", license.AccountID(), "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *downloadLicenseRepository) Save(ctx context.Context, license *download.License) error {
+	model := toDownloadLicenseModel(license)
+	return r.db.WithContext(ctx).
+		Model(&DownloadLicenseModel{}).
+		Where("id = ?", license.ID()).
+		Select("expires_at", "revoked_at", "revoke_reason").
+		Updates(&model).Error
+}
+
+func (r *downloadLicenseRepository) FindByID(ctx context.Context, id string) (*download.License, error) {
+	var model DownloadLicenseModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, download.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainDownloadLicense(&model), nil
+}
+
+func (r *downloadLicenseRepository) ListActive(ctx context.Context, accountID string, now time.Time) ([]*download.License, error) {
+	var models []DownloadLicenseModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND revoked_at IS NULL AND expires_at > ?", accountID, now).
+		Order("issued_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	licenses := make([]*download.License, 0, len(models))
+	for _, model := range models {
+		licenses = append(licenses, toDomainDownloadLicense(&model))
+	}
+	return licenses, nil
+}
+
+func (r *downloadLicenseRepository) RevokeByDevice(ctx context.Context, deviceID string, reason download.RevokeReason, now time.Time) (int, error) {
+	result := r.db.WithContext(ctx).
+		Model(&DownloadLicenseModel{}).
+		Where("device_id = ? AND revoked_at IS NULL", deviceID).
+		Updates(map[string]any{"revoked_at": now, "revoke_reason": string(reason)})
+	return int(result.RowsAffected), result.Error
+}
+
+func toDownloadLicenseModel(license *download.License) DownloadLicenseModel {
+	model := DownloadLicenseModel{
+		ID:        license.ID(),
+		AccountID: license.AccountID(),
+		DeviceID:  license.DeviceID(),
+		VideoID:   license.VideoID(),
+		Height:    license.Height(),
+		IssuedAt:  license.IssuedAt(),
+		ExpiresAt: license.ExpiresAt(),
+		RevokedAt: license.RevokedAt(),
+	}
+	if license.ProfileID() != "" {
+		profileID := license.ProfileID()
+		model.ProfileID = &profileID
+	}
+	if license.RevokeReason() != "" {
+		revokeReason := string(license.RevokeReason())
+		model.RevokeReason = &revokeReason
+	}
+	return model
+}
+
+func toDomainDownloadLicense(model *DownloadLicenseModel) *download.License {
+	var profileID string
+	if model.ProfileID != nil {
+		profileID = *model.ProfileID
+	}
+	var revokeReason download.RevokeReason
+	if model.RevokeReason != nil {
+		revokeReason = download.RevokeReason(*model.RevokeReason)
+	}
+	return download.HydrateLicense(
+		model.ID,
+		model.AccountID,
+		profileID,
+		model.DeviceID,
+		model.VideoID,
+		model.Height,
+		model.IssuedAt,
+		model.ExpiresAt,
+		model.RevokedAt,
+		revokeReason,
+	)
+}
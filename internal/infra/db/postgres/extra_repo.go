@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"gorm.io/gorm"
+)
+
+type extraRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewExtraRepository(db *gorm.DB, logger *log.Logger) extra.Repository {
+	return &extraRepository{db: db, logger: logger}
+}
+
+func (r *extraRepository) Save(ctx context.Context, e *extra.Extra) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if e.Kind() == extra.PreviewKind {
+			// Dropping the video of the old preview cascades to its extra.
+			err := tx.Unscoped().
+				Where("id IN (SELECT video_id FROM content_extras WHERE content_id = ? AND kind = ?)", e.ContentID(), extra.PreviewKind).
+				Delete(&VideoModel{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		videoEntity := e.Video()
+		videoModel := VideoModel{
+			ID:        videoEntity.ID(),
+			URL:       videoEntity.URL(),
+			SizeInKb:  videoEntity.SizeInKB(),
+			Duration:  videoEntity.Duration(),
+			Height:    videoEntity.Height(),
+			CreatedAt: videoEntity.CreatedAt(),
+			UpdatedAt: videoEntity.UpdatedAt(),
+		}
+		if err := tx.Create(&videoModel).Error; err != nil {
+			return err
+		}
+
+		model := ContentExtraModel{
+			ID:        e.ID(),
+			ContentID: e.ContentID(),
+			VideoID:   videoEntity.ID(),
+			Kind:      string(e.Kind()),
+			Title:     e.Title(),
+			CreatedAt: e.CreatedAt(),
+		}
+		if err := tx.Omit("Video").Create(&model).Error; err != nil {
+			r.logger.Error("Failed to create extra", "contentID", e.ContentID(), "error", err)
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return extra.ErrContentNotFound
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *extraRepository) FindByID(ctx context.Context, id string) (*extra.Extra, error) {
+	return r.find(ctx, "id = ?", id)
+}
+
+func (r *extraRepository) FindByVideoID(ctx context.Context, videoID string) (*extra.Extra, error) {
+	return r.find(ctx, "video_id = ?", videoID)
+}
+
+func (r *extraRepository) find(ctx context.Context, query string, args ...any) (*extra.Extra, error) {
+	var model ContentExtraModel
+	if err := r.db.WithContext(ctx).Preload("Video").Where(query, args...).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, extra.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainExtra(&model), nil
+}
+
+func (r *extraRepository) ListByContentID(ctx context.Context, contentID string) ([]*extra.Extra, error) {
+	var models []ContentExtraModel
+	err := r.db.WithContext(ctx).
+		Preload("Video").
+		Where("content_id = ?", contentID).
+		Order("kind DESC, created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	extras := make([]*extra.Extra, 0, len(models))
+	for _, model := range models {
+		extras = append(extras, toDomainExtra(&model))
+	}
+	return extras, nil
+}
+
+func (r *extraRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("id IN (SELECT video_id FROM content_extras WHERE id = ?)", id).
+		Delete(&VideoModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return extra.ErrNotFound
+	}
+	return nil
+}
+
+func toDomainExtra(model *ContentExtraModel) *extra.Extra {
+	return extra.HydrateExtra(
+		model.ID,
+		model.ContentID,
+		extra.Kind(model.Kind),
+		model.Title,
+		toDomainVideo(&model.Video),
+		model.CreatedAt,
+	)
+}
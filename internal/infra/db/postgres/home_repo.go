@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/home"
+	"gorm.io/gorm"
+)
+
+type homeRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewHomeRepository(db *gorm.DB, logger *log.Logger) home.Repository {
+	return &homeRepository{db: db, logger: logger}
+}
+
+func (r *homeRepository) ListEnabled(ctx context.Context) ([]*home.Rail, error) {
+	var models []HomeRailModel
+	err := r.db.WithContext(ctx).
+		Where("enabled").
+		Order("position, created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	rails := make([]*home.Rail, 0, len(models))
+	for i := range models {
+		rails = append(rails, toDomainRail(&models[i]))
+	}
+	return rails, nil
+}
+
+func (r *homeRepository) FindByID(ctx context.Context, id string) (*home.Rail, error) {
+	var model HomeRailModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, home.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainRail(&model), nil
+}
+
+func toDomainRail(model *HomeRailModel) *home.Rail {
+	var genre string
+	if model.Genre != nil {
+		genre = *model.Genre
+	}
+
+	return home.HydrateRail(
+		model.ID,
+		home.RailKind(model.Kind),
+		model.Title,
+		genre,
+		model.PageSize,
+		model.Position,
+		model.Enabled,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
@@ -0,0 +1,31 @@
+CREATE TABLE people (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    photo_id UUID UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    bio TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_thumbnails FOREIGN KEY(photo_id) REFERENCES thumbnails(id) ON DELETE SET NULL
+);
+
+CREATE TABLE credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    person_id UUID NOT NULL,
+    content_id UUID NOT NULL,
+    episode_id UUID,
+    role VARCHAR(50) NOT NULL,
+    character_name VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_people FOREIGN KEY(person_id) REFERENCES people(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE,
+    CONSTRAINT fk_episodes FOREIGN KEY(episode_id) REFERENCES episodes(id) ON DELETE CASCADE,
+    CONSTRAINT uq_credits UNIQUE NULLS NOT DISTINCT (person_id, content_id, episode_id, role)
+);
+
+CREATE INDEX idx_people_deleted_at ON people(deleted_at);
+CREATE INDEX idx_credits_deleted_at ON credits(deleted_at);
+CREATE INDEX idx_credits_person_id ON credits(person_id);
+CREATE INDEX idx_credits_content_id ON credits(content_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS profiles;
+
+ALTER TABLE contents
+    DROP COLUMN IF EXISTS maturity_level,
+    DROP COLUMN IF EXISTS rating_value,
+    DROP COLUMN IF EXISTS rating_system;
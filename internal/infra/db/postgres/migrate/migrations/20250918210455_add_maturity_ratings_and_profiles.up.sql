@@ -0,0 +1,17 @@
+ALTER TABLE contents
+    ADD COLUMN rating_system VARCHAR(50),
+    ADD COLUMN rating_value VARCHAR(20),
+    ADD COLUMN maturity_level INTEGER NOT NULL DEFAULT 18;
+
+CREATE TABLE profiles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    max_maturity_level INTEGER NOT NULL,
+    pin_hash TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_contents_maturity_level ON contents(maturity_level);
+CREATE INDEX idx_profiles_deleted_at ON profiles(deleted_at);
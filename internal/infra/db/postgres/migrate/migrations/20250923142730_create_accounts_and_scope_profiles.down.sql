@@ -0,0 +1,8 @@
+ALTER TABLE profiles
+    DROP CONSTRAINT IF EXISTS fk_accounts,
+    DROP COLUMN IF EXISTS is_kids,
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS avatar_url,
+    DROP COLUMN IF EXISTS account_id;
+
+DROP TABLE IF EXISTS accounts;
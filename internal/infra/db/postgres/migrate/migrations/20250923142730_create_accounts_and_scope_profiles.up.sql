@@ -0,0 +1,30 @@
+CREATE TABLE accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email VARCHAR(255) UNIQUE NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+-- Profiles created before accounts existed move to a legacy account that
+-- nobody can sign in to, since no password matches an empty hash.
+INSERT INTO accounts (email, password_hash)
+SELECT 'legacy-profiles@fakeflix.invalid', ''
+WHERE EXISTS (SELECT 1 FROM profiles);
+
+ALTER TABLE profiles
+    ADD COLUMN account_id UUID,
+    ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT 'pt-BR',
+    ADD COLUMN is_kids BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT fk_accounts FOREIGN KEY(account_id) REFERENCES accounts(id) ON DELETE CASCADE;
+
+UPDATE profiles
+SET account_id = (SELECT id FROM accounts WHERE email = 'legacy-profiles@fakeflix.invalid')
+WHERE account_id IS NULL;
+
+ALTER TABLE profiles ALTER COLUMN account_id SET NOT NULL;
+
+CREATE INDEX idx_accounts_deleted_at ON accounts(deleted_at);
+CREATE INDEX idx_profiles_account_id ON profiles(account_id);
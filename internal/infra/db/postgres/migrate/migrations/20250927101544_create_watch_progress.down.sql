@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS watch_progress;
+DROP VIEW IF EXISTS video_contents;
@@ -0,0 +1,28 @@
+-- Maps every playable video to the content (and episode) it belongs to.
+CREATE VIEW video_contents AS
+    SELECT movies.video_id, movies.content_id, NULL::UUID AS episode_id
+    FROM movies
+    JOIN contents ON contents.id = movies.content_id AND contents.deleted_at IS NULL
+    WHERE movies.deleted_at IS NULL
+    UNION ALL
+    SELECT episodes.video_id, tv_shows.content_id, episodes.id AS episode_id
+    FROM episodes
+    JOIN tv_shows ON tv_shows.id = episodes.tv_show_id AND tv_shows.deleted_at IS NULL
+    JOIN contents ON contents.id = tv_shows.content_id AND contents.deleted_at IS NULL
+    WHERE episodes.deleted_at IS NULL;
+
+CREATE TABLE watch_progress (
+    profile_id UUID NOT NULL,
+    video_id UUID NOT NULL,
+    position_seconds INTEGER NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (profile_id, video_id),
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_watch_progress_profile_recorded_at ON watch_progress(profile_id, recorded_at DESC);
@@ -0,0 +1,12 @@
+CREATE TABLE watchlist_items (
+    profile_id UUID NOT NULL,
+    content_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (profile_id, content_id),
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_watchlist_items_profile_position ON watchlist_items(profile_id, position);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS content_rating_summaries;
+DROP TABLE IF EXISTS content_ratings;
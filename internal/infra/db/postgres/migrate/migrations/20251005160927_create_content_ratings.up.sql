@@ -0,0 +1,22 @@
+CREATE TABLE content_ratings (
+    profile_id UUID NOT NULL,
+    content_id UUID NOT NULL,
+    thumb VARCHAR(4) NOT NULL CHECK (thumb IN ('UP', 'DOWN')),
+    stars SMALLINT CHECK (stars BETWEEN 1 AND 5),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (profile_id, content_id),
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+-- Maintained incrementally by the application whenever a rating changes.
+CREATE TABLE content_rating_summaries (
+    content_id UUID PRIMARY KEY,
+    thumbs_up INTEGER NOT NULL DEFAULT 0,
+    thumbs_down INTEGER NOT NULL DEFAULT 0,
+    stars_count INTEGER NOT NULL DEFAULT 0,
+    stars_total INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
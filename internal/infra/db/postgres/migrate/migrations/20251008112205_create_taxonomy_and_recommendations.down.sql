@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS profile_recommendations;
+DROP TABLE IF EXISTS content_similarities;
+DROP TABLE IF EXISTS content_tags;
+DROP TABLE IF EXISTS content_genres;
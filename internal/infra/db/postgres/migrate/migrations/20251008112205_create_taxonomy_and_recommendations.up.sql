@@ -0,0 +1,43 @@
+CREATE TABLE content_genres (
+    content_id UUID NOT NULL,
+    genre VARCHAR(50) NOT NULL,
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (content_id, genre),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE TABLE content_tags (
+    content_id UUID NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (content_id, tag),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_content_genres_genre ON content_genres(genre);
+CREATE INDEX idx_content_tags_tag ON content_tags(tag);
+
+-- Caches filled by the recommendation job; rebuilt from scratch on every run.
+CREATE TABLE content_similarities (
+    content_id UUID NOT NULL,
+    similar_content_id UUID NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (content_id, similar_content_id),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE,
+    CONSTRAINT fk_similar_contents FOREIGN KEY(similar_content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE TABLE profile_recommendations (
+    profile_id UUID NOT NULL,
+    content_id UUID NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    position SMALLINT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (profile_id, content_id),
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_content_similarities_position ON content_similarities(content_id, position);
+CREATE INDEX idx_profile_recommendations_position ON profile_recommendations(profile_id, position);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS trending_scores;
+DROP TABLE IF EXISTS playback_events;
@@ -0,0 +1,25 @@
+-- Append-only: rows are never updated or deleted by the application.
+CREATE TABLE playback_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    video_id UUID NOT NULL,
+    profile_id UUID,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('START', 'COMPLETE')),
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE,
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_playback_events_occurred_at ON playback_events(occurred_at);
+
+-- Filled by the trending rollup job; each window is replaced on every run.
+CREATE TABLE trending_scores (
+    time_window VARCHAR(10) NOT NULL,
+    content_id UUID NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    position SMALLINT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (time_window, content_id),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_trending_scores_position ON trending_scores(time_window, position);
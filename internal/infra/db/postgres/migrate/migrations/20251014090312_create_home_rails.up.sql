@@ -0,0 +1,22 @@
+CREATE TABLE home_rails (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind VARCHAR(30) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    genre VARCHAR(50),
+    page_size SMALLINT NOT NULL DEFAULT 20 CHECK (page_size BETWEEN 1 AND 50),
+    position INTEGER NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_home_rails_genre CHECK ((kind = 'GENRE') = (genre IS NOT NULL))
+);
+
+CREATE INDEX idx_home_rails_position ON home_rails(position) WHERE enabled;
+
+INSERT INTO home_rails (kind, title, genre, page_size, position) VALUES
+    ('CONTINUE_WATCHING', 'Continue Watching', NULL, 20, 10),
+    ('TRENDING', 'Top 10 today', NULL, 10, 20),
+    ('MY_LIST', 'My List', NULL, 20, 30),
+    ('NEW_RELEASES', 'New Releases', NULL, 20, 40),
+    ('GENRE', 'Dramas', 'drama', 20, 50),
+    ('GENRE', 'Comedies', 'comedy', 20, 60);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS collection_items;
+DROP TABLE IF EXISTS collections;
+ALTER TABLE accounts DROP COLUMN IF EXISTS role;
@@ -0,0 +1,27 @@
+ALTER TABLE accounts ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'VIEWER'
+    CONSTRAINT chk_accounts_role CHECK (role IN ('VIEWER', 'EDITOR'));
+
+CREATE TABLE collections (
+    id UUID PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    artwork_id UUID,
+    publish_from TIMESTAMPTZ,
+    publish_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_thumbnails FOREIGN KEY(artwork_id) REFERENCES thumbnails(id) ON DELETE SET NULL,
+    CONSTRAINT chk_collections_window CHECK (publish_from IS NULL OR publish_until IS NULL OR publish_until > publish_from)
+);
+
+CREATE TABLE collection_items (
+    collection_id UUID NOT NULL,
+    content_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (collection_id, content_id),
+    CONSTRAINT fk_collections FOREIGN KEY(collection_id) REFERENCES collections(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_collection_items_position ON collection_items(collection_id, position);
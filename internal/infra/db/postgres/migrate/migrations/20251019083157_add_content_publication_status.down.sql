@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_contents_scheduled;
+ALTER TABLE contents
+    DROP CONSTRAINT IF EXISTS chk_contents_scheduled,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,9 @@
+-- Contents that already exist were live before the workflow was introduced,
+-- so they start out published.
+ALTER TABLE contents
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'PUBLISHED'
+        CONSTRAINT chk_contents_status CHECK (status IN ('DRAFT', 'IN_REVIEW', 'SCHEDULED', 'PUBLISHED', 'UNPUBLISHED')),
+    ADD COLUMN publish_at TIMESTAMPTZ,
+    ADD CONSTRAINT chk_contents_scheduled CHECK (status <> 'SCHEDULED' OR publish_at IS NOT NULL);
+
+CREATE INDEX idx_contents_scheduled ON contents(publish_at) WHERE status = 'SCHEDULED';
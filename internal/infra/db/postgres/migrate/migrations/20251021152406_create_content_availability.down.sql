@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS content_availability_countries;
+DROP TABLE IF EXISTS content_availability;
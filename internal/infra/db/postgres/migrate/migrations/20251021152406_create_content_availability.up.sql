@@ -0,0 +1,16 @@
+CREATE TABLE content_availability (
+    content_id UUID PRIMARY KEY,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE,
+    CONSTRAINT chk_content_availability_window CHECK (starts_at IS NULL OR ends_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE TABLE content_availability_countries (
+    content_id UUID NOT NULL,
+    country_code CHAR(2) NOT NULL,
+    rule VARCHAR(5) NOT NULL CHECK (rule IN ('ALLOW', 'DENY')),
+    PRIMARY KEY (content_id, country_code),
+    CONSTRAINT fk_content_availability FOREIGN KEY(content_id) REFERENCES content_availability(content_id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS episode_translations;
+DROP TABLE IF EXISTS content_translations;
@@ -0,0 +1,23 @@
+CREATE TABLE content_translations (
+    content_id UUID NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    thumbnail_id UUID,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (content_id, locale),
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE,
+    CONSTRAINT fk_thumbnails FOREIGN KEY(thumbnail_id) REFERENCES thumbnails(id) ON DELETE SET NULL
+);
+
+CREATE TABLE episode_translations (
+    episode_id UUID NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    thumbnail_id UUID,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (episode_id, locale),
+    CONSTRAINT fk_episodes FOREIGN KEY(episode_id) REFERENCES episodes(id) ON DELETE CASCADE,
+    CONSTRAINT fk_thumbnails FOREIGN KEY(thumbnail_id) REFERENCES thumbnails(id) ON DELETE SET NULL
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS plans;
+ALTER TABLE videos DROP COLUMN IF EXISTS height;
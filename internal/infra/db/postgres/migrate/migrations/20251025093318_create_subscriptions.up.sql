@@ -0,0 +1,30 @@
+ALTER TABLE videos ADD COLUMN height INT NOT NULL DEFAULT 0;
+
+CREATE TABLE plans (
+    code VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    max_streams INT NOT NULL CHECK (max_streams > 0),
+    max_height INT NOT NULL CHECK (max_height > 0),
+    price_cents INT NOT NULL CHECK (price_cents >= 0)
+);
+
+INSERT INTO plans (code, name, max_streams, max_height, price_cents) VALUES
+    ('BASIC', 'Basic', 1, 720, 1990),
+    ('STANDARD', 'Standard', 2, 1080, 3990),
+    ('PREMIUM', 'Premium', 4, 2160, 5590);
+
+CREATE TABLE subscriptions (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL UNIQUE,
+    plan_code VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('ACTIVE', 'CANCELED', 'EXPIRED')),
+    payment_reference VARCHAR(255) NOT NULL DEFAULT '',
+    current_period_start TIMESTAMPTZ NOT NULL,
+    current_period_end TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_accounts FOREIGN KEY(account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_plans FOREIGN KEY(plan_code) REFERENCES plans(code)
+);
+
+CREATE INDEX idx_subscriptions_due ON subscriptions (current_period_end) WHERE status <> 'EXPIRED';
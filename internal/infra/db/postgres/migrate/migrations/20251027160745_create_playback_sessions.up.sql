@@ -0,0 +1,16 @@
+CREATE TABLE playback_sessions (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL,
+    profile_id UUID,
+    video_id UUID NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    end_reason VARCHAR(20),
+    CONSTRAINT fk_accounts FOREIGN KEY(account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE SET NULL,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_playback_sessions_active ON playback_sessions (account_id, last_seen_at) WHERE ended_at IS NULL;
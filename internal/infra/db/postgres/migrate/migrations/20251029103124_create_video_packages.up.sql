@@ -0,0 +1,8 @@
+CREATE TABLE video_packages (
+    video_id UUID PRIMARY KEY,
+    playlist_url TEXT NOT NULL,
+    encrypted_key BYTEA NOT NULL,
+    iv BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE content_extras (
+    id UUID PRIMARY KEY,
+    content_id UUID NOT NULL,
+    video_id UUID NOT NULL UNIQUE,
+    kind VARCHAR(20) NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_contents FOREIGN KEY(content_id) REFERENCES contents(id) ON DELETE CASCADE,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_content_extras_content_id ON content_extras (content_id);
+CREATE UNIQUE INDEX idx_content_extras_preview ON content_extras (content_id) WHERE kind = 'PREVIEW';
@@ -0,0 +1,13 @@
+CREATE TABLE video_markers (
+    video_id UUID PRIMARY KEY,
+    intro_start INTEGER,
+    intro_end INTEGER,
+    recap_start INTEGER,
+    recap_end INTEGER,
+    credits_start INTEGER,
+    source VARCHAR(20) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE,
+    CONSTRAINT chk_intro CHECK ((intro_start IS NULL) = (intro_end IS NULL)),
+    CONSTRAINT chk_recap CHECK ((recap_start IS NULL) = (recap_end IS NULL))
+);
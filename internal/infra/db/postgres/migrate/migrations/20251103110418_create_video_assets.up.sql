@@ -0,0 +1,13 @@
+CREATE TABLE video_assets (
+    id UUID PRIMARY KEY,
+    video_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    language VARCHAR(10) NOT NULL DEFAULT '',
+    label TEXT NOT NULL DEFAULT '',
+    url TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_video_assets_video_id ON video_assets (video_id);
+CREATE UNIQUE INDEX idx_video_assets_single ON video_assets (video_id, kind) WHERE kind IN ('TRICKPLAY', 'DASH');
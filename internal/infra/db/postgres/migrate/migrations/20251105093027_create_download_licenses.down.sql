@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS download_licenses;
+
+ALTER TABLE plans DROP COLUMN IF EXISTS max_downloads;
@@ -0,0 +1,23 @@
+ALTER TABLE plans ADD COLUMN max_downloads INT NOT NULL DEFAULT 0 CHECK (max_downloads >= 0);
+
+UPDATE plans SET max_downloads = 1 WHERE code = 'BASIC';
+UPDATE plans SET max_downloads = 10 WHERE code = 'STANDARD';
+UPDATE plans SET max_downloads = 30 WHERE code = 'PREMIUM';
+
+CREATE TABLE download_licenses (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL,
+    profile_id UUID,
+    device_id VARCHAR(255) NOT NULL,
+    video_id UUID NOT NULL,
+    height INT NOT NULL DEFAULT 0,
+    issued_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    revoke_reason VARCHAR(20),
+    CONSTRAINT fk_accounts FOREIGN KEY(account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_profiles FOREIGN KEY(profile_id) REFERENCES profiles(id) ON DELETE SET NULL,
+    CONSTRAINT fk_videos FOREIGN KEY(video_id) REFERENCES videos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_download_licenses_active ON download_licenses (account_id, expires_at) WHERE revoked_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE playback_sessions DROP COLUMN IF EXISTS device_id;
+
+DROP TABLE IF EXISTS devices;
+
+ALTER TABLE plans DROP COLUMN IF EXISTS max_devices;
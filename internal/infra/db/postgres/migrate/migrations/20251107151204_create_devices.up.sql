@@ -0,0 +1,26 @@
+ALTER TABLE plans ADD COLUMN max_devices INT NOT NULL DEFAULT 1 CHECK (max_devices >= 1);
+
+UPDATE plans SET max_devices = 2 WHERE code = 'BASIC';
+UPDATE plans SET max_devices = 5 WHERE code = 'STANDARD';
+UPDATE plans SET max_devices = 10 WHERE code = 'PREMIUM';
+
+CREATE TABLE devices (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    refresh_token_hash VARCHAR(64) NOT NULL DEFAULT '',
+    refresh_expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    signed_out_at TIMESTAMPTZ,
+    CONSTRAINT fk_accounts FOREIGN KEY(account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_devices_active ON devices (account_id, created_at) WHERE signed_out_at IS NULL;
+
+ALTER TABLE playback_sessions ADD COLUMN device_id UUID;
+ALTER TABLE playback_sessions ADD CONSTRAINT fk_devices FOREIGN KEY(device_id) REFERENCES devices(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_playback_sessions_device ON playback_sessions (device_id) WHERE ended_at IS NULL;
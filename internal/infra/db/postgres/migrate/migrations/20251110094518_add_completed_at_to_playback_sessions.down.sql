@@ -0,0 +1 @@
+ALTER TABLE playback_sessions DROP COLUMN IF EXISTS completed_at;
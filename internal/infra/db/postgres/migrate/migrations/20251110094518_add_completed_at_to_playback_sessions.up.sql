@@ -0,0 +1 @@
+ALTER TABLE playback_sessions ADD COLUMN completed_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE profiles
+    DROP COLUMN IF EXISTS pin_locked_until,
+    DROP COLUMN IF EXISTS failed_pins;
@@ -0,0 +1,3 @@
+ALTER TABLE profiles
+    ADD COLUMN failed_pins INT NOT NULL DEFAULT 0,
+    ADD COLUMN pin_locked_until TIMESTAMPTZ;
@@ -9,13 +9,18 @@ import (
 )
 
 type ContentModel struct {
-	ID          string `gorm:"type:uuid;primary_key"`
-	Title       string
-	Description string
-	ContentType content.ContentType `gorm:"type:varchar(50)"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID            string `gorm:"type:uuid;primary_key"`
+	Title         string
+	Description   string
+	ContentType   content.ContentType `gorm:"type:varchar(50)"`
+	RatingSystem  *string             `gorm:"type:varchar(50)"`
+	RatingValue   *string             `gorm:"type:varchar(20)"`
+	MaturityLevel int
+	Status        string `gorm:"type:varchar(20);default:PUBLISHED"`
+	PublishAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
 
 	Movie  *MovieModel  `gorm:"foreignKey:ContentID"`
 	TvShow *TvShowModel `gorm:"foreignKey:ContentID"`
@@ -68,6 +73,7 @@ type VideoModel struct {
 	URL       string
 	SizeInKb  int
 	Duration  int
+	Height    int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
@@ -81,6 +87,310 @@ type ThumbnailModel struct {
 	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
+type PersonModel struct {
+	ID        string  `gorm:"type:uuid;primary_key"`
+	PhotoID   *string `gorm:"type:uuid;unique"`
+	Name      string
+	Bio       string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Photo *ThumbnailModel `gorm:"foreignKey:PhotoID"`
+}
+
+type CreditModel struct {
+	ID            string  `gorm:"type:uuid;primary_key"`
+	PersonID      string  `gorm:"type:uuid;not null"`
+	ContentID     string  `gorm:"type:uuid;not null"`
+	EpisodeID     *string `gorm:"type:uuid"`
+	Role          string  `gorm:"type:varchar(50)"`
+	CharacterName string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+type AccountModel struct {
+	ID           string `gorm:"type:uuid;primary_key"`
+	Email        string `gorm:"unique;not null"`
+	PasswordHash string
+	Role         string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+
+	Profiles []*ProfileModel `gorm:"foreignKey:AccountID"`
+}
+
+type ProfileModel struct {
+	ID               string `gorm:"type:uuid;primary_key"`
+	AccountID        string `gorm:"type:uuid;not null"`
+	Name             string
+	AvatarURL        string
+	Language         string `gorm:"type:varchar(35)"`
+	MaxMaturityLevel int
+	IsKids           bool
+	PinHash          *string
+	FailedPins       int
+	PinLockedUntil   *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+}
+
+type WatchProgressModel struct {
+	ProfileID       string `gorm:"type:uuid;primaryKey"`
+	VideoID         string `gorm:"type:uuid;primaryKey"`
+	PositionSeconds int
+	DurationSeconds int
+	Completed       bool
+	RecordedAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type WatchlistItemModel struct {
+	ProfileID string `gorm:"type:uuid;primaryKey"`
+	ContentID string `gorm:"type:uuid;primaryKey"`
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ContentRatingModel struct {
+	ProfileID string `gorm:"type:uuid;primaryKey"`
+	ContentID string `gorm:"type:uuid;primaryKey"`
+	Thumb     string
+	Stars     *int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type RatingSummaryModel struct {
+	ContentID  string `gorm:"type:uuid;primaryKey"`
+	ThumbsUp   int
+	ThumbsDown int
+	StarsCount int
+	StarsTotal int
+	UpdatedAt  time.Time
+}
+
+type ContentGenreModel struct {
+	ContentID string `gorm:"type:uuid;primaryKey"`
+	Genre     string `gorm:"primaryKey"`
+	Position  int
+}
+
+type ContentTagModel struct {
+	ContentID string `gorm:"type:uuid;primaryKey"`
+	Tag       string `gorm:"primaryKey"`
+	Position  int
+}
+
+type ContentSimilarityModel struct {
+	ContentID        string `gorm:"type:uuid;primaryKey"`
+	SimilarContentID string `gorm:"type:uuid;primaryKey"`
+	Score            float64
+	Position         int
+}
+
+type ProfileRecommendationModel struct {
+	ProfileID  string `gorm:"type:uuid;primaryKey"`
+	ContentID  string `gorm:"type:uuid;primaryKey"`
+	Score      float64
+	Position   int
+	ComputedAt time.Time
+}
+
+type PlaybackEventModel struct {
+	ID         string  `gorm:"type:uuid;primaryKey"`
+	VideoID    string  `gorm:"type:uuid"`
+	ProfileID  *string `gorm:"type:uuid"`
+	EventType  string
+	OccurredAt time.Time
+}
+
+type TrendingScoreModel struct {
+	TimeWindow string `gorm:"primaryKey"`
+	ContentID  string `gorm:"type:uuid;primaryKey"`
+	Score      float64
+	Position   int
+	ComputedAt time.Time
+}
+
+type HomeRailModel struct {
+	ID        string `gorm:"type:uuid;primaryKey"`
+	Kind      string
+	Title     string
+	Genre     *string
+	PageSize  int
+	Position  int
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CollectionModel struct {
+	ID           string `gorm:"type:uuid;primaryKey"`
+	Slug         string `gorm:"unique;not null"`
+	Title        string
+	Description  string
+	ArtworkID    *string `gorm:"type:uuid"`
+	PublishFrom  *time.Time
+	PublishUntil *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	Artwork *ThumbnailModel       `gorm:"foreignKey:ArtworkID"`
+	Items   []CollectionItemModel `gorm:"foreignKey:CollectionID"`
+}
+
+type CollectionItemModel struct {
+	CollectionID string `gorm:"type:uuid;primaryKey"`
+	ContentID    string `gorm:"type:uuid;primaryKey"`
+	Position     int
+}
+
+type ContentAvailabilityModel struct {
+	ContentID string `gorm:"type:uuid;primaryKey"`
+	StartsAt  *time.Time
+	EndsAt    *time.Time
+	UpdatedAt time.Time
+
+	Countries []ContentAvailabilityCountryModel `gorm:"foreignKey:ContentID"`
+}
+
+type ContentAvailabilityCountryModel struct {
+	ContentID   string `gorm:"type:uuid;primaryKey"`
+	CountryCode string `gorm:"primaryKey"`
+	Rule        string
+}
+
+type ContentTranslationModel struct {
+	ContentID   string `gorm:"type:uuid;primaryKey"`
+	Locale      string `gorm:"primaryKey"`
+	Title       string
+	Description string
+	ThumbnailID *string `gorm:"type:uuid"`
+	UpdatedAt   time.Time
+
+	Thumbnail *ThumbnailModel `gorm:"foreignKey:ThumbnailID"`
+}
+
+type EpisodeTranslationModel struct {
+	EpisodeID   string `gorm:"type:uuid;primaryKey"`
+	Locale      string `gorm:"primaryKey"`
+	Title       string
+	Description string
+	ThumbnailID *string `gorm:"type:uuid"`
+	UpdatedAt   time.Time
+
+	Thumbnail *ThumbnailModel `gorm:"foreignKey:ThumbnailID"`
+}
+
+type PlanModel struct {
+	Code         string `gorm:"primaryKey"`
+	Name         string
+	MaxStreams   int
+	MaxHeight    int
+	MaxDownloads int
+	MaxDevices   int
+	PriceCents   int
+}
+
+type SubscriptionModel struct {
+	ID                 string `gorm:"type:uuid;primaryKey"`
+	AccountID          string `gorm:"type:uuid;unique;not null"`
+	PlanCode           string
+	Status             string
+	PaymentReference   string
+	CurrentPeriodStart time.Time
+	CurrentPeriodEnd   time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type PlaybackSessionModel struct {
+	ID          string  `gorm:"type:uuid;primaryKey"`
+	AccountID   string  `gorm:"type:uuid;not null"`
+	ProfileID   *string `gorm:"type:uuid"`
+	DeviceID    *string `gorm:"type:uuid"`
+	VideoID     string  `gorm:"type:uuid;not null"`
+	UserAgent   string
+	StartedAt   time.Time
+	LastSeenAt  time.Time
+	EndedAt     *time.Time
+	EndReason   *string
+	CompletedAt *time.Time
+}
+
+type VideoPackageModel struct {
+	VideoID      string `gorm:"type:uuid;primaryKey"`
+	PlaylistURL  string
+	EncryptedKey []byte
+	IV           []byte
+	CreatedAt    time.Time
+}
+
+type ContentExtraModel struct {
+	ID        string `gorm:"type:uuid;primaryKey"`
+	ContentID string `gorm:"type:uuid;not null"`
+	VideoID   string `gorm:"type:uuid;unique;not null"`
+	Kind      string `gorm:"type:varchar(20)"`
+	Title     string
+	CreatedAt time.Time
+
+	Video VideoModel `gorm:"foreignKey:VideoID"`
+}
+
+type VideoMarkerModel struct {
+	VideoID      string `gorm:"type:uuid;primaryKey"`
+	IntroStart   *int
+	IntroEnd     *int
+	RecapStart   *int
+	RecapEnd     *int
+	CreditsStart *int
+	Source       string `gorm:"type:varchar(20)"`
+	UpdatedAt    time.Time
+}
+
+type VideoAssetModel struct {
+	ID        string `gorm:"type:uuid;primaryKey"`
+	VideoID   string `gorm:"type:uuid;not null"`
+	Kind      string `gorm:"type:varchar(20)"`
+	Language  string `gorm:"type:varchar(10)"`
+	Label     string
+	URL       string
+	CreatedAt time.Time
+}
+
+type DownloadLicenseModel struct {
+	ID           string  `gorm:"type:uuid;primaryKey"`
+	AccountID    string  `gorm:"type:uuid;not null"`
+	ProfileID    *string `gorm:"type:uuid"`
+	DeviceID     string
+	VideoID      string `gorm:"type:uuid;not null"`
+	Height       int
+	IssuedAt     time.Time
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	RevokeReason *string `gorm:"type:varchar(20)"`
+}
+
+type DeviceModel struct {
+	ID               string `gorm:"type:uuid;primaryKey"`
+	AccountID        string `gorm:"type:uuid;not null"`
+	Type             string
+	Name             string
+	UserAgent        string
+	RefreshTokenHash string
+	RefreshExpiresAt time.Time
+	CreatedAt        time.Time
+	LastSeenAt       time.Time
+	SignedOutAt      *time.Time
+}
+
 func (ContentModel) TableName() string {
 	return "contents"
 }
@@ -104,3 +414,123 @@ func (VideoModel) TableName() string {
 func (ThumbnailModel) TableName() string {
 	return "thumbnails"
 }
+
+func (PersonModel) TableName() string {
+	return "people"
+}
+
+func (CreditModel) TableName() string {
+	return "credits"
+}
+
+func (AccountModel) TableName() string {
+	return "accounts"
+}
+
+func (ProfileModel) TableName() string {
+	return "profiles"
+}
+
+func (WatchProgressModel) TableName() string {
+	return "watch_progress"
+}
+
+func (WatchlistItemModel) TableName() string {
+	return "watchlist_items"
+}
+
+func (ContentRatingModel) TableName() string {
+	return "content_ratings"
+}
+
+func (RatingSummaryModel) TableName() string {
+	return "content_rating_summaries"
+}
+
+func (ContentGenreModel) TableName() string {
+	return "content_genres"
+}
+
+func (ContentTagModel) TableName() string {
+	return "content_tags"
+}
+
+func (ContentSimilarityModel) TableName() string {
+	return "content_similarities"
+}
+
+func (ProfileRecommendationModel) TableName() string {
+	return "profile_recommendations"
+}
+
+func (PlaybackEventModel) TableName() string {
+	return "playback_events"
+}
+
+func (TrendingScoreModel) TableName() string {
+	return "trending_scores"
+}
+
+func (HomeRailModel) TableName() string {
+	return "home_rails"
+}
+
+func (CollectionModel) TableName() string {
+	return "collections"
+}
+
+func (CollectionItemModel) TableName() string {
+	return "collection_items"
+}
+
+func (ContentAvailabilityModel) TableName() string {
+	return "content_availability"
+}
+
+func (ContentAvailabilityCountryModel) TableName() string {
+	return "content_availability_countries"
+}
+
+func (ContentTranslationModel) TableName() string {
+	return "content_translations"
+}
+
+func (EpisodeTranslationModel) TableName() string {
+	return "episode_translations"
+}
+
+func (PlanModel) TableName() string {
+	return "plans"
+}
+
+func (SubscriptionModel) TableName() string {
+	return "subscriptions"
+}
+
+func (PlaybackSessionModel) TableName() string {
+	return "playback_sessions"
+}
+
+func (VideoPackageModel) TableName() string {
+	return "video_packages"
+}
+
+func (ContentExtraModel) TableName() string {
+	return "content_extras"
+}
+
+func (VideoMarkerModel) TableName() string {
+	return "video_markers"
+}
+
+func (VideoAssetModel) TableName() string {
+	return "video_assets"
+}
+
+func (DownloadLicenseModel) TableName() string {
+	return "download_licenses"
+}
+
+func (DeviceModel) TableName() string {
+	return "devices"
+}
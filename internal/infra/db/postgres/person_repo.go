@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/person"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"gorm.io/gorm"
+)
+
+type personRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewPersonRepository(db *gorm.DB, logger *log.Logger) person.Repository {
+	return &personRepository{db: db, logger: logger}
+}
+
+func (r *personRepository) Save(ctx context.Context, personEntity *person.Person) error {
+	log := r.logger.With("personID", personEntity.ID())
+	log.Debug("Starting save transaction for person")
+
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	var photoID *string
+	if photoEntity := personEntity.Photo(); photoEntity != nil {
+		photoModel := ThumbnailModel{
+			ID:        photoEntity.ID(),
+			URL:       photoEntity.URL(),
+			CreatedAt: photoEntity.CreatedAt(),
+			UpdatedAt: photoEntity.UpdatedAt(),
+		}
+		if err := tx.Create(&photoModel).Error; err != nil {
+			return err
+		}
+		photoID = &photoModel.ID
+	}
+
+	personModel := PersonModel{
+		ID:        personEntity.ID(),
+		PhotoID:   photoID,
+		Name:      personEntity.Name(),
+		Bio:       personEntity.Bio(),
+		CreatedAt: personEntity.CreatedAt(),
+		UpdatedAt: personEntity.UpdatedAt(),
+	}
+	if err := tx.Create(&personModel).Error; err != nil {
+		log.Error("Failed to create person model in transaction", "error", err)
+		return err
+	}
+
+	log.Debug("Finishing save transaction")
+	return tx.Commit().Error
+}
+
+func (r *personRepository) FindByID(ctx context.Context, id string) (*person.Person, error) {
+	var model PersonModel
+
+	err := r.db.WithContext(ctx).
+		Preload("Photo").
+		First(&model, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, person.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainPerson(&model), nil
+}
+
+func (r *personRepository) SaveCredit(ctx context.Context, credit *person.Credit) error {
+	var episodeID *string
+	if credit.EpisodeID() != "" {
+		id := credit.EpisodeID()
+		episodeID = &id
+	}
+
+	creditModel := CreditModel{
+		ID:            credit.ID(),
+		PersonID:      credit.PersonID(),
+		ContentID:     credit.ContentID(),
+		EpisodeID:     episodeID,
+		Role:          string(credit.Role()),
+		CharacterName: credit.Character(),
+		CreatedAt:     credit.CreatedAt(),
+		UpdatedAt:     credit.UpdatedAt(),
+	}
+	if err := r.db.WithContext(ctx).Create(&creditModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return person.ErrConflict
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return person.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *personRepository) FindFilmography(ctx context.Context, personID string) ([]*person.FilmographyEntry, error) {
+	var rows []struct {
+		ContentID     string
+		ContentTitle  string
+		ContentType   string
+		EpisodeID     *string
+		EpisodeTitle  *string
+		Season        *int
+		Number        *int
+		Role          string
+		CharacterName string
+	}
+
+	err := r.db.WithContext(ctx).
+		Table("credits").
+		Select(`credits.content_id, contents.title AS content_title, contents.content_type,
+			credits.episode_id, episodes.title AS episode_title, episodes.season, episodes.number,
+			credits.role, credits.character_name`).
+		Joins("JOIN contents ON contents.id = credits.content_id AND contents.deleted_at IS NULL AND contents.status = ?", content.PublishedStatus).
+		Joins("LEFT JOIN episodes ON episodes.id = credits.episode_id AND episodes.deleted_at IS NULL").
+		Where("credits.person_id = ? AND credits.deleted_at IS NULL", personID).
+		Order("contents.created_at DESC, credits.role, episodes.season, episodes.number").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*person.FilmographyEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := &person.FilmographyEntry{
+			ContentID:    row.ContentID,
+			ContentTitle: row.ContentTitle,
+			ContentType:  row.ContentType,
+			Role:         person.Role(row.Role),
+			Character:    row.CharacterName,
+		}
+		if row.EpisodeID != nil {
+			entry.EpisodeID = *row.EpisodeID
+		}
+		if row.EpisodeTitle != nil {
+			entry.EpisodeTitle = *row.EpisodeTitle
+		}
+		if row.Season != nil {
+			entry.Season = *row.Season
+		}
+		if row.Number != nil {
+			entry.Number = *row.Number
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func toDomainPerson(model *PersonModel) *person.Person {
+	var photoEntity *thumbnail.Thumbnail
+	if model.Photo != nil {
+		photoEntity = toDomainThumbnail(model.Photo)
+	}
+
+	return person.HydratePerson(
+		model.ID,
+		model.Name,
+		model.Bio,
+		photoEntity,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"gorm.io/gorm"
+)
+
+type playbackRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewPlaybackRepository(db *gorm.DB, logger *log.Logger) playback.Repository {
+	return &playbackRepository{db: db, logger: logger}
+}
+
+func (r *playbackRepository) Append(ctx context.Context, event *playback.Event) error {
+	var profileID *string
+	if event.ProfileID() != "" {
+		id := event.ProfileID()
+		profileID = &id
+	}
+
+	eventModel := PlaybackEventModel{
+		ID:         event.ID(),
+		VideoID:    event.VideoID(),
+		ProfileID:  profileID,
+		EventType:  string(event.Type()),
+		OccurredAt: event.OccurredAt(),
+	}
+	return r.db.WithContext(ctx).Create(&eventModel).Error
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"gorm.io/gorm"
+)
+
+type playbackSessionRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewPlaybackSessionRepository(db *gorm.DB, logger *log.Logger) playback.SessionRepository {
+	return &playbackSessionRepository{db: db, logger: logger}
+}
+
+func (r *playbackSessionRepository) Start(ctx context.Context, session *playback.Session, maxActive int, activeSince time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize starts per account so two players cannot both take the
+		// last free stream.
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", session.AccountID()).Error; err != nil {
+			return err
+		}
+
+		var active int64
+		err := tx.Model(&PlaybackSessionModel{}).
+			Where("account_id = ? AND ended_at IS NULL AND last_seen_at > ?", session.AccountID(), activeSince).
+			Count(&active).Error
+		if err != nil {
+			return err
+		}
+		if int(active) >= maxActive {
+			return playback.ErrTooManySessions
+		}
+
+		model := toPlaybackSessionModel(session)
+		if err := tx.Create(&model).Error; err != nil {
+			r.logger.Error("Failed to create playback session", "accountID", session.AccountID(), "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *playbackSessionRepository) Save(ctx context.Context, session *playback.Session) error {
+	model := toPlaybackSessionModel(session)
+	return r.db.WithContext(ctx).
+		Model(&PlaybackSessionModel{}).
+		Where("id = ?", session.ID()).
+		Select("video_id", "last_seen_at", "ended_at", "end_reason", "completed_at").
+		Updates(&model).Error
+}
+
+func (r *playbackSessionRepository) FindByID(ctx context.Context, id string) (*playback.Session, error) {
+	var model PlaybackSessionModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, playback.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlaybackSession(&model), nil
+}
+
+func (r *playbackSessionRepository) ListActive(ctx context.Context, accountID string, activeSince time.Time) ([]*playback.Session, error) {
+	var models []PlaybackSessionModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND ended_at IS NULL AND last_seen_at > ?", accountID, activeSince).
+		Order("started_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*playback.Session, 0, len(models))
+	for _, model := range models {
+		sessions = append(sessions, toDomainPlaybackSession(&model))
+	}
+	return sessions, nil
+}
+
+func (r *playbackSessionRepository) EndStale(ctx context.Context, activeSince, now time.Time) (int, error) {
+	result := r.db.WithContext(ctx).
+		Model(&PlaybackSessionModel{}).
+		Where("ended_at IS NULL AND last_seen_at <= ?", activeSince).
+		Updates(map[string]any{"ended_at": now, "end_reason": string(playback.TimedOutReason)})
+	return int(result.RowsAffected), result.Error
+}
+
+func (r *playbackSessionRepository) EndByDevice(ctx context.Context, deviceID string, reason playback.EndReason, now time.Time) (int, error) {
+	result := r.db.WithContext(ctx).
+		Model(&PlaybackSessionModel{}).
+		Where("device_id = ? AND ended_at IS NULL", deviceID).
+		Updates(map[string]any{"ended_at": now, "end_reason": string(reason)})
+	return int(result.RowsAffected), result.Error
+}
+
+func toPlaybackSessionModel(session *playback.Session) PlaybackSessionModel {
+	model := PlaybackSessionModel{
+		ID:          session.ID(),
+		AccountID:   session.AccountID(),
+		VideoID:     session.VideoID(),
+		UserAgent:   session.UserAgent(),
+		StartedAt:   session.StartedAt(),
+		LastSeenAt:  session.LastSeenAt(),
+		EndedAt:     session.EndedAt(),
+		CompletedAt: session.CompletedAt(),
+	}
+	if session.ProfileID() != "" {
+		profileID := session.ProfileID()
+		model.ProfileID = &profileID
+	}
+	if session.DeviceID() != "" {
+		deviceID := session.DeviceID()
+		model.DeviceID = &deviceID
+	}
+	if session.EndReason() != "" {
+		endReason := string(session.EndReason())
+		model.EndReason = &endReason
+	}
+	return model
+}
+
+func toDomainPlaybackSession(model *PlaybackSessionModel) *playback.Session {
+	var profileID string
+	if model.ProfileID != nil {
+		profileID = *model.ProfileID
+	}
+	var deviceID string
+	if model.DeviceID != nil {
+		deviceID = *model.DeviceID
+	}
+	var endReason playback.EndReason
+	if model.EndReason != nil {
+		endReason = playback.EndReason(*model.EndReason)
+	}
+	return playback.HydrateSession(
+		model.ID,
+		model.AccountID,
+		profileID,
+		deviceID,
+		model.VideoID,
+		model.UserAgent,
+		model.StartedAt,
+		model.LastSeenAt,
+		model.EndedAt,
+		endReason,
+		model.CompletedAt,
+	)
+}
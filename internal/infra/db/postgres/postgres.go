@@ -21,7 +21,7 @@ func NewConnection(cfg *config.Config) (*gorm.DB, error) {
 		cfg.DBPort,
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database using gorm: %w", err)
 	}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"gorm.io/gorm"
+)
+
+type profileRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewProfileRepository(db *gorm.DB, logger *log.Logger) profile.Repository {
+	return &profileRepository{db: db, logger: logger}
+}
+
+func (r *profileRepository) Save(ctx context.Context, profileEntity *profile.Profile) error {
+	var pinHash *string
+	if profileEntity.HasPIN() {
+		hash := profileEntity.PINHash()
+		pinHash = &hash
+	}
+
+	profileModel := ProfileModel{
+		ID:               profileEntity.ID(),
+		AccountID:        profileEntity.AccountID(),
+		Name:             profileEntity.Name(),
+		AvatarURL:        profileEntity.AvatarURL(),
+		Language:         profileEntity.Language(),
+		MaxMaturityLevel: profileEntity.MaxMaturityLevel(),
+		IsKids:           profileEntity.IsKids(),
+		PinHash:          pinHash,
+		FailedPins:       profileEntity.FailedPINs(),
+		PinLockedUntil:   profileEntity.PINLockedUntil(),
+		CreatedAt:        profileEntity.CreatedAt(),
+		UpdatedAt:        profileEntity.UpdatedAt(),
+	}
+	if err := r.db.WithContext(ctx).Save(&profileModel).Error; err != nil {
+		r.logger.Error("Failed to save profile", "profileID", profileEntity.ID(), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *profileRepository) FindByID(ctx context.Context, id string) (*profile.Profile, error) {
+	var model ProfileModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, profile.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainProfile(&model), nil
+}
+
+func (r *profileRepository) ListByAccountID(ctx context.Context, accountID string) ([]*profile.Profile, error) {
+	var models []*ProfileModel
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		Order("created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*profile.Profile, 0, len(models))
+	for _, model := range models {
+		profiles = append(profiles, toDomainProfile(model))
+	}
+	return profiles, nil
+}
+
+func (r *profileRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&ProfileModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return profile.ErrNotFound
+	}
+	return nil
+}
+
+func (r *profileRepository) RecordFailedPIN(ctx context.Context, id string, now time.Time) error {
+	return r.db.WithContext(ctx).Exec(`
+		UPDATE profiles SET
+			failed_pins = CASE WHEN failed_pins + 1 >= ? THEN 0 ELSE failed_pins + 1 END,
+			pin_locked_until = CASE WHEN failed_pins + 1 >= ? THEN ? ELSE pin_locked_until END
+		WHERE id = ?`,
+		profile.MaxPINAttempts, profile.MaxPINAttempts, now.Add(profile.PINLockout), id,
+	).Error
+}
+
+func (r *profileRepository) ResetFailedPINs(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).
+		Model(&ProfileModel{}).
+		Where("id = ?", id).
+		Update("failed_pins", 0).Error
+}
+
+func toDomainProfile(model *ProfileModel) *profile.Profile {
+	var pinHash string
+	if model.PinHash != nil {
+		pinHash = *model.PinHash
+	}
+
+	return profile.HydrateProfile(
+		model.ID,
+		model.AccountID,
+		model.Name,
+		model.AvatarURL,
+		model.Language,
+		model.MaxMaturityLevel,
+		model.IsKids,
+		pinHash,
+		model.FailedPins,
+		model.PinLockedUntil,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
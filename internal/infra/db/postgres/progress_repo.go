@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/progress"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type progressRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewProgressRepository(db *gorm.DB, logger *log.Logger) progress.Repository {
+	return &progressRepository{db: db, logger: logger}
+}
+
+func (r *progressRepository) Save(ctx context.Context, progressEntity *progress.Progress) error {
+	now := time.Now().UTC()
+	progressModel := WatchProgressModel{
+		ProfileID:       progressEntity.ProfileID(),
+		VideoID:         progressEntity.VideoID(),
+		PositionSeconds: progressEntity.PositionSeconds(),
+		DurationSeconds: progressEntity.DurationSeconds(),
+		Completed:       progressEntity.IsCompleted(),
+		RecordedAt:      progressEntity.RecordedAt(),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "profile_id"}, {Name: "video_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"position_seconds", "duration_seconds", "completed", "recorded_at", "updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "watch_progress.recorded_at <= excluded.recorded_at"},
+		}},
+	}).Create(&progressModel).Error
+	if err != nil {
+		r.logger.Error("Failed to upsert watch progress", "profileID", progressEntity.ProfileID(), "videoID", progressEntity.VideoID(), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+type progressRow struct {
+	WatchProgressModel
+	ContentID string
+	EpisodeID *string
+}
+
+func (row progressRow) toDomain() *progress.Progress {
+	var episodeID string
+	if row.EpisodeID != nil {
+		episodeID = *row.EpisodeID
+	}
+	return progress.HydrateProgress(
+		row.ProfileID,
+		row.VideoID,
+		row.ContentID,
+		episodeID,
+		row.PositionSeconds,
+		row.DurationSeconds,
+		row.Completed,
+		row.RecordedAt,
+	)
+}
+
+func (r *progressRepository) ListLatestByContent(ctx context.Context, profileID string, limit int) ([]*progress.Progress, error) {
+	var rows []progressRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT * FROM (
+			SELECT DISTINCT ON (vc.content_id) wp.*, vc.content_id, vc.episode_id
+			FROM watch_progress wp
+			JOIN video_contents vc ON vc.video_id = wp.video_id
+			WHERE wp.profile_id = ?
+			ORDER BY vc.content_id, wp.recorded_at DESC
+		) latest
+		ORDER BY recorded_at DESC
+		LIMIT ?`,
+		profileID, limit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*progress.Progress, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toDomain())
+	}
+
+	return entries, nil
+}
+
+func (r *progressRepository) FindByVideoID(ctx context.Context, profileID, videoID string) (*progress.Progress, error) {
+	return r.findOne(ctx, `
+		SELECT wp.*, vc.content_id, vc.episode_id
+		FROM watch_progress wp
+		JOIN video_contents vc ON vc.video_id = wp.video_id
+		WHERE wp.profile_id = ? AND wp.video_id = ?`,
+		profileID, videoID,
+	)
+}
+
+func (r *progressRepository) FindLatestByContentID(ctx context.Context, profileID, contentID string) (*progress.Progress, error) {
+	return r.findOne(ctx, `
+		SELECT wp.*, vc.content_id, vc.episode_id
+		FROM watch_progress wp
+		JOIN video_contents vc ON vc.video_id = wp.video_id
+		WHERE wp.profile_id = ? AND vc.content_id = ?
+		ORDER BY wp.recorded_at DESC
+		LIMIT 1`,
+		profileID, contentID,
+	)
+}
+
+func (r *progressRepository) findOne(ctx context.Context, query string, args ...any) (*progress.Progress, error) {
+	var rows []progressRow
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, progress.ErrNotFound
+	}
+	return rows[0].toDomain(), nil
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ratingRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewRatingRepository(db *gorm.DB, logger *log.Logger) rating.Repository {
+	return &ratingRepository{db: db, logger: logger}
+}
+
+// summaryDelta is how much a rating change moves each summary counter.
+type summaryDelta struct {
+	thumbsUp, thumbsDown, starsCount, starsTotal int
+}
+
+func (d *summaryDelta) apply(model *ContentRatingModel, sign int) {
+	if model == nil {
+		return
+	}
+	switch rating.Thumb(model.Thumb) {
+	case rating.ThumbUp:
+		d.thumbsUp += sign
+	case rating.ThumbDown:
+		d.thumbsDown += sign
+	}
+	if model.Stars != nil {
+		d.starsCount += sign
+		d.starsTotal += sign * *model.Stars
+	}
+}
+
+func (r *ratingRepository) Save(ctx context.Context, ratingEntity *rating.Rating) error {
+	log := r.logger.With("profileID", ratingEntity.ProfileID(), "contentID", ratingEntity.ContentID())
+
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	previous, err := r.lockRating(tx, ratingEntity.ProfileID(), ratingEntity.ContentID())
+	if err != nil {
+		return err
+	}
+
+	ratingModel := ContentRatingModel{
+		ProfileID: ratingEntity.ProfileID(),
+		ContentID: ratingEntity.ContentID(),
+		Thumb:     string(ratingEntity.Thumb()),
+		CreatedAt: ratingEntity.RatedAt(),
+		UpdatedAt: ratingEntity.RatedAt(),
+	}
+	if stars := ratingEntity.Stars(); stars != 0 {
+		ratingModel.Stars = &stars
+	}
+
+	if previous == nil {
+		err = tx.Create(&ratingModel).Error
+	} else {
+		err = tx.Model(&ContentRatingModel{}).
+			Where("profile_id = ? AND content_id = ?", ratingModel.ProfileID, ratingModel.ContentID).
+			Updates(map[string]any{"thumb": ratingModel.Thumb, "stars": ratingModel.Stars, "updated_at": ratingModel.UpdatedAt}).Error
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return rating.ErrConflict
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return rating.ErrNotFound
+		}
+		log.Error("Failed to save rating", "error", err)
+		return err
+	}
+
+	var delta summaryDelta
+	delta.apply(previous, -1)
+	delta.apply(&ratingModel, 1)
+	if err := r.applySummaryDelta(tx, ratingModel.ContentID, delta); err != nil {
+		log.Error("Failed to update rating summary", "error", err)
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *ratingRepository) Delete(ctx context.Context, profileID, contentID string) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	previous, err := r.lockRating(tx, profileID, contentID)
+	if err != nil {
+		return err
+	}
+	if previous == nil {
+		return nil
+	}
+
+	if err := tx.Delete(&ContentRatingModel{}, "profile_id = ? AND content_id = ?", profileID, contentID).Error; err != nil {
+		return err
+	}
+
+	var delta summaryDelta
+	delta.apply(previous, -1)
+	if err := r.applySummaryDelta(tx, contentID, delta); err != nil {
+		r.logger.Error("Failed to update rating summary", "contentID", contentID, "error", err)
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *ratingRepository) FindSummaries(ctx context.Context, contentIDs []string) (map[string]*rating.Summary, error) {
+	summaries := make(map[string]*rating.Summary, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return summaries, nil
+	}
+
+	var models []RatingSummaryModel
+	if err := r.db.WithContext(ctx).Find(&models, "content_id IN ?", contentIDs).Error; err != nil {
+		return nil, err
+	}
+
+	for _, model := range models {
+		summaries[model.ContentID] = &rating.Summary{
+			ContentID:  model.ContentID,
+			ThumbsUp:   model.ThumbsUp,
+			ThumbsDown: model.ThumbsDown,
+			StarsCount: model.StarsCount,
+			StarsTotal: model.StarsTotal,
+		}
+	}
+
+	return summaries, nil
+}
+
+func (r *ratingRepository) FindByProfile(ctx context.Context, profileID string, contentIDs []string) (map[string]*rating.Rating, error) {
+	ratings := make(map[string]*rating.Rating, len(contentIDs))
+	if profileID == "" || len(contentIDs) == 0 {
+		return ratings, nil
+	}
+
+	var models []ContentRatingModel
+	err := r.db.WithContext(ctx).
+		Find(&models, "profile_id = ? AND content_id IN ?", profileID, contentIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, model := range models {
+		var stars int
+		if model.Stars != nil {
+			stars = *model.Stars
+		}
+		ratings[model.ContentID] = rating.HydrateRating(
+			model.ProfileID,
+			model.ContentID,
+			rating.Thumb(model.Thumb),
+			stars,
+			model.UpdatedAt,
+		)
+	}
+
+	return ratings, nil
+}
+
+// lockRating loads the current rating of the profile, if any, locking it
+// until the transaction ends so concurrent changes cannot skew the summary.
+func (r *ratingRepository) lockRating(tx *gorm.DB, profileID, contentID string) (*ContentRatingModel, error) {
+	var model ContentRatingModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&model, "profile_id = ? AND content_id = ?", profileID, contentID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &model, nil
+}
+
+func (r *ratingRepository) applySummaryDelta(tx *gorm.DB, contentID string, delta summaryDelta) error {
+	return tx.Exec(`
+		INSERT INTO content_rating_summaries (content_id, thumbs_up, thumbs_down, stars_count, stars_total, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (content_id) DO UPDATE SET
+			thumbs_up = content_rating_summaries.thumbs_up + excluded.thumbs_up,
+			thumbs_down = content_rating_summaries.thumbs_down + excluded.thumbs_down,
+			stars_count = content_rating_summaries.stars_count + excluded.stars_count,
+			stars_total = content_rating_summaries.stars_total + excluded.stars_total,
+			updated_at = excluded.updated_at`,
+		contentID, delta.thumbsUp, delta.thumbsDown, delta.starsCount, delta.starsTotal, time.Now().UTC(),
+	).Error
+}
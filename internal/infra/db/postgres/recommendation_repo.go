@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/recommendation"
+	"gorm.io/gorm"
+)
+
+// replaceBatchSize bounds how many cache rows are inserted per statement.
+const replaceBatchSize = 500
+
+type recommendationRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewRecommendationRepository(db *gorm.DB, logger *log.Logger) recommendation.Repository {
+	return &recommendationRepository{db: db, logger: logger}
+}
+
+func (r *recommendationRepository) LoadFeatures(ctx context.Context) ([]recommendation.Features, error) {
+	var rows []struct {
+		ContentID string
+		Term      string
+	}
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT contents.id AS content_id, terms.term
+		FROM contents
+		LEFT JOIN (
+			SELECT content_id, 'genre:' || genre AS term FROM content_genres
+			UNION
+			SELECT content_id, 'tag:' || tag FROM content_tags
+			UNION
+			SELECT content_id, 'person:' || person_id FROM credits WHERE deleted_at IS NULL
+		) terms ON terms.content_id = contents.id
+		WHERE contents.deleted_at IS NULL
+		ORDER BY contents.id`,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]recommendation.Features, 0)
+	for _, row := range rows {
+		if len(features) == 0 || features[len(features)-1].ContentID != row.ContentID {
+			features = append(features, recommendation.Features{ContentID: row.ContentID})
+		}
+		if row.Term != "" {
+			last := &features[len(features)-1]
+			last.Terms = append(last.Terms, row.Term)
+		}
+	}
+
+	return features, nil
+}
+
+func (r *recommendationRepository) LoadSignals(ctx context.Context) ([]recommendation.Signal, error) {
+	var rows []struct {
+		ProfileID string
+		ContentID string
+		Watched   bool
+		Completed bool
+		Thumb     *string
+		Stars     *int
+	}
+
+	err := r.db.WithContext(ctx).Raw(`
+		WITH watched AS (
+			SELECT wp.profile_id, vc.content_id, BOOL_OR(wp.completed) AS completed
+			FROM watch_progress wp
+			JOIN video_contents vc ON vc.video_id = wp.video_id
+			GROUP BY wp.profile_id, vc.content_id
+		)
+		SELECT
+			COALESCE(w.profile_id, cr.profile_id) AS profile_id,
+			COALESCE(w.content_id, cr.content_id) AS content_id,
+			w.profile_id IS NOT NULL AS watched,
+			COALESCE(w.completed, FALSE) AS completed,
+			cr.thumb,
+			cr.stars
+		FROM watched w
+		FULL OUTER JOIN content_ratings cr ON cr.profile_id = w.profile_id AND cr.content_id = w.content_id`,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	signals := make([]recommendation.Signal, 0, len(rows))
+	for _, row := range rows {
+		signal := recommendation.Signal{
+			ProfileID: row.ProfileID,
+			ContentID: row.ContentID,
+			Watched:   row.Watched,
+			Completed: row.Completed,
+		}
+		if row.Thumb != nil {
+			signal.Thumb = rating.Thumb(*row.Thumb)
+		}
+		if row.Stars != nil {
+			signal.Stars = *row.Stars
+		}
+		signals = append(signals, signal)
+	}
+
+	return signals, nil
+}
+
+func (r *recommendationRepository) ReplaceSimilarities(ctx context.Context, similarities []recommendation.Similarity) error {
+	models := make([]ContentSimilarityModel, 0, len(similarities))
+	for _, similarity := range similarities {
+		models = append(models, ContentSimilarityModel{
+			ContentID:        similarity.ContentID,
+			SimilarContentID: similarity.SimilarContentID,
+			Score:            similarity.Score,
+			Position:         similarity.Rank,
+		})
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM content_similarities").Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(models, replaceBatchSize).Error
+	})
+}
+
+func (r *recommendationRepository) ReplaceRecommendations(ctx context.Context, recommendations []recommendation.Recommendation) error {
+	models := make([]ProfileRecommendationModel, 0, len(recommendations))
+	for _, rec := range recommendations {
+		models = append(models, ProfileRecommendationModel{
+			ProfileID:  rec.ProfileID,
+			ContentID:  rec.ContentID,
+			Score:      rec.Score,
+			Position:   rec.Rank,
+			ComputedAt: rec.ComputedAt,
+		})
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM profile_recommendations").Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(models, replaceBatchSize).Error
+	})
+}
+
+func (r *recommendationRepository) ListSimilar(ctx context.Context, contentID string, limit int) ([]recommendation.Scored, error) {
+	var models []ContentSimilarityModel
+	err := r.db.WithContext(ctx).
+		Where("content_id = ?", contentID).
+		Order("position").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scored := make([]recommendation.Scored, 0, len(models))
+	for _, model := range models {
+		scored = append(scored, recommendation.Scored{ContentID: model.SimilarContentID, Score: model.Score})
+	}
+	return scored, nil
+}
+
+func (r *recommendationRepository) ListForProfile(ctx context.Context, profileID string, limit int) ([]recommendation.Scored, error) {
+	var models []ProfileRecommendationModel
+	err := r.db.WithContext(ctx).
+		Where("profile_id = ?", profileID).
+		Order("position").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scored := make([]recommendation.Scored, 0, len(models))
+	for _, model := range models {
+		scored = append(scored, recommendation.Scored{ContentID: model.ContentID, Score: model.Score})
+	}
+	return scored, nil
+}
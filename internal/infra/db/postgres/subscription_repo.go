@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type subscriptionRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewSubscriptionRepository(db *gorm.DB, logger *log.Logger) subscription.Repository {
+	return &subscriptionRepository{db: db, logger: logger}
+}
+
+func (r *subscriptionRepository) ListPlans(ctx context.Context) ([]*subscription.Plan, error) {
+	var models []PlanModel
+	if err := r.db.WithContext(ctx).Order("price_cents").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	plans := make([]*subscription.Plan, 0, len(models))
+	for _, model := range models {
+		plans = append(plans, toDomainPlan(&model))
+	}
+	return plans, nil
+}
+
+func (r *subscriptionRepository) FindPlan(ctx context.Context, code string) (*subscription.Plan, error) {
+	var model PlanModel
+	if err := r.db.WithContext(ctx).First(&model, "code = ?", code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, subscription.ErrPlanNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlan(&model), nil
+}
+
+func (r *subscriptionRepository) Save(ctx context.Context, subscriptionEntity *subscription.Subscription) error {
+	model := SubscriptionModel{
+		ID:                 subscriptionEntity.ID(),
+		AccountID:          subscriptionEntity.AccountID(),
+		PlanCode:           subscriptionEntity.PlanCode(),
+		Status:             string(subscriptionEntity.Status()),
+		PaymentReference:   subscriptionEntity.PaymentReference(),
+		CurrentPeriodStart: subscriptionEntity.CurrentPeriodStart(),
+		CurrentPeriodEnd:   subscriptionEntity.CurrentPeriodEnd(),
+		CreatedAt:          subscriptionEntity.CreatedAt(),
+		UpdatedAt:          subscriptionEntity.UpdatedAt(),
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"plan_code", "status", "payment_reference", "current_period_start", "current_period_end", "updated_at"}),
+	}).Create(&model).Error
+	if err != nil {
+		r.logger.Error("Failed to upsert subscription", "accountID", subscriptionEntity.AccountID(), "error", err)
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return subscription.ErrPlanNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *subscriptionRepository) FindByAccountID(ctx context.Context, accountID string) (*subscription.Subscription, error) {
+	var model SubscriptionModel
+	if err := r.db.WithContext(ctx).First(&model, "account_id = ?", accountID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, subscription.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSubscription(&model), nil
+}
+
+func (r *subscriptionRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*subscription.Subscription, error) {
+	var models []SubscriptionModel
+	err := r.db.WithContext(ctx).
+		Where("status <> ? AND current_period_end <= ?", subscription.ExpiredStatus, now).
+		Order("current_period_end").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*subscription.Subscription, 0, len(models))
+	for _, model := range models {
+		subscriptions = append(subscriptions, toDomainSubscription(&model))
+	}
+	return subscriptions, nil
+}
+
+func toDomainPlan(model *PlanModel) *subscription.Plan {
+	return subscription.HydratePlan(model.Code, model.Name, model.MaxStreams, model.MaxHeight, model.MaxDownloads, model.MaxDevices, model.PriceCents)
+}
+
+func toDomainSubscription(model *SubscriptionModel) *subscription.Subscription {
+	return subscription.HydrateSubscription(
+		model.ID,
+		model.AccountID,
+		model.PlanCode,
+		subscription.Status(model.Status),
+		model.PaymentReference,
+		model.CurrentPeriodStart,
+		model.CurrentPeriodEnd,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/taxonomy"
+	"gorm.io/gorm"
+)
+
+type taxonomyRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewTaxonomyRepository(db *gorm.DB, logger *log.Logger) taxonomy.Repository {
+	return &taxonomyRepository{db: db, logger: logger}
+}
+
+func (r *taxonomyRepository) Replace(ctx context.Context, taxonomyEntity *taxonomy.Taxonomy) error {
+	log := r.logger.With("contentID", taxonomyEntity.ContentID())
+
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	contentID := taxonomyEntity.ContentID()
+	if err := tx.Delete(&ContentGenreModel{}, "content_id = ?", contentID).Error; err != nil {
+		return err
+	}
+	if err := tx.Delete(&ContentTagModel{}, "content_id = ?", contentID).Error; err != nil {
+		return err
+	}
+
+	genres := make([]ContentGenreModel, 0, len(taxonomyEntity.Genres()))
+	for i, genre := range taxonomyEntity.Genres() {
+		genres = append(genres, ContentGenreModel{ContentID: contentID, Genre: genre, Position: i})
+	}
+	tags := make([]ContentTagModel, 0, len(taxonomyEntity.Tags()))
+	for i, tag := range taxonomyEntity.Tags() {
+		tags = append(tags, ContentTagModel{ContentID: contentID, Tag: tag, Position: i})
+	}
+
+	if len(genres) > 0 {
+		if err := tx.Create(&genres).Error; err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return taxonomy.ErrNotFound
+			}
+			log.Error("Failed to create content genres", "error", err)
+			return err
+		}
+	}
+	if len(tags) > 0 {
+		if err := tx.Create(&tags).Error; err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return taxonomy.ErrNotFound
+			}
+			log.Error("Failed to create content tags", "error", err)
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *taxonomyRepository) FindByContentID(ctx context.Context, contentID string) (*taxonomy.Taxonomy, error) {
+	var genres, tags []string
+
+	err := r.db.WithContext(ctx).Model(&ContentGenreModel{}).
+		Where("content_id = ?", contentID).
+		Order("position").
+		Pluck("genre", &genres).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.WithContext(ctx).Model(&ContentTagModel{}).
+		Where("content_id = ?", contentID).
+		Order("position").
+		Pluck("tag", &tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return taxonomy.HydrateTaxonomy(contentID, genres, tags), nil
+}
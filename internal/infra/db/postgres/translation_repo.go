@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type translationRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewTranslationRepository(db *gorm.DB, logger *log.Logger) localization.Repository {
+	return &translationRepository{db: db, logger: logger}
+}
+
+func (r *translationRepository) Save(ctx context.Context, translation *localization.Translation) error {
+	log := r.logger.With("subjectKind", translation.SubjectKind(), "subjectID", translation.SubjectID(), "locale", translation.Locale())
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var thumbnailID *string
+		if thumbnailEntity := translation.Thumbnail(); thumbnailEntity != nil {
+			thumbnailModel := ThumbnailModel{
+				ID:        thumbnailEntity.ID(),
+				URL:       thumbnailEntity.URL(),
+				CreatedAt: thumbnailEntity.CreatedAt(),
+				UpdatedAt: thumbnailEntity.UpdatedAt(),
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&thumbnailModel).Error; err != nil {
+				return err
+			}
+			thumbnailID = &thumbnailModel.ID
+		}
+
+		var model any
+		var subjectColumn string
+		switch translation.SubjectKind() {
+		case localization.ContentSubject:
+			subjectColumn = "content_id"
+			model = &ContentTranslationModel{
+				ContentID:   translation.SubjectID(),
+				Locale:      translation.Locale(),
+				Title:       translation.Title(),
+				Description: translation.Description(),
+				ThumbnailID: thumbnailID,
+				UpdatedAt:   translation.UpdatedAt(),
+			}
+		case localization.EpisodeSubject:
+			subjectColumn = "episode_id"
+			model = &EpisodeTranslationModel{
+				EpisodeID:   translation.SubjectID(),
+				Locale:      translation.Locale(),
+				Title:       translation.Title(),
+				Description: translation.Description(),
+				ThumbnailID: thumbnailID,
+				UpdatedAt:   translation.UpdatedAt(),
+			}
+		default:
+			return fmt.Errorf("unsupported translation subject %q", translation.SubjectKind())
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: subjectColumn}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"title", "description", "thumbnail_id", "updated_at"}),
+		}).Omit("Thumbnail").Create(model).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return localization.ErrSubjectNotFound
+			}
+			log.Error("Failed to upsert translation", "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *translationRepository) Find(ctx context.Context, subjectKind localization.SubjectKind, subjectID, locale string) (*localization.Translation, error) {
+	translations, err := r.list(ctx, subjectKind, []string{subjectID}, locale)
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) == 0 {
+		return nil, localization.ErrNotFound
+	}
+	return translations[0], nil
+}
+
+func (r *translationRepository) ListBySubjects(ctx context.Context, subjectKind localization.SubjectKind, subjectIDs []string) ([]*localization.Translation, error) {
+	if len(subjectIDs) == 0 {
+		return []*localization.Translation{}, nil
+	}
+	return r.list(ctx, subjectKind, subjectIDs, "")
+}
+
+// list loads the translations of the given subjects, restricted to one
+// locale when it is not empty.
+func (r *translationRepository) list(ctx context.Context, subjectKind localization.SubjectKind, subjectIDs []string, locale string) ([]*localization.Translation, error) {
+	query := r.db.WithContext(ctx).Preload("Thumbnail").Order("locale")
+	if locale != "" {
+		query = query.Where("locale = ?", locale)
+	}
+
+	switch subjectKind {
+	case localization.ContentSubject:
+		var models []ContentTranslationModel
+		if err := query.Where("content_id IN ?", subjectIDs).Find(&models).Error; err != nil {
+			return nil, err
+		}
+		translations := make([]*localization.Translation, 0, len(models))
+		for _, model := range models {
+			translations = append(translations, localization.HydrateTranslation(
+				localization.ContentSubject,
+				model.ContentID,
+				model.Locale,
+				model.Title,
+				model.Description,
+				toDomainTranslationThumbnail(model.Thumbnail),
+				model.UpdatedAt,
+			))
+		}
+		return translations, nil
+	case localization.EpisodeSubject:
+		var models []EpisodeTranslationModel
+		if err := query.Where("episode_id IN ?", subjectIDs).Find(&models).Error; err != nil {
+			return nil, err
+		}
+		translations := make([]*localization.Translation, 0, len(models))
+		for _, model := range models {
+			translations = append(translations, localization.HydrateTranslation(
+				localization.EpisodeSubject,
+				model.EpisodeID,
+				model.Locale,
+				model.Title,
+				model.Description,
+				toDomainTranslationThumbnail(model.Thumbnail),
+				model.UpdatedAt,
+			))
+		}
+		return translations, nil
+	}
+	return nil, fmt.Errorf("unsupported translation subject %q", subjectKind)
+}
+
+func (r *translationRepository) Delete(ctx context.Context, subjectKind localization.SubjectKind, subjectID, locale string) error {
+	var result *gorm.DB
+	switch subjectKind {
+	case localization.ContentSubject:
+		result = r.db.WithContext(ctx).Delete(&ContentTranslationModel{}, "content_id = ? AND locale = ?", subjectID, locale)
+	case localization.EpisodeSubject:
+		result = r.db.WithContext(ctx).Delete(&EpisodeTranslationModel{}, "episode_id = ? AND locale = ?", subjectID, locale)
+	default:
+		return fmt.Errorf("unsupported translation subject %q", subjectKind)
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return localization.ErrNotFound
+	}
+	return nil
+}
+
+func toDomainTranslationThumbnail(model *ThumbnailModel) *thumbnail.Thumbnail {
+	if model == nil {
+		return nil
+	}
+	return toDomainThumbnail(model)
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/trending"
+	"gorm.io/gorm"
+)
+
+type trendingRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewTrendingRepository(db *gorm.DB, logger *log.Logger) trending.Repository {
+	return &trendingRepository{db: db, logger: logger}
+}
+
+func (r *trendingRepository) Rollup(ctx context.Context, window trending.Window, now time.Time) (int, error) {
+	var ranked int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&TrendingScoreModel{}, "time_window = ?", string(window)).Error; err != nil {
+			return err
+		}
+
+		// Every event adds its weight halved for each half-life of age.
+		result := tx.Exec(`
+			INSERT INTO trending_scores (time_window, content_id, score, position, computed_at)
+			SELECT ?, content_id, score, ROW_NUMBER() OVER (ORDER BY score DESC, content_id), ?
+			FROM (
+				SELECT vc.content_id, SUM(
+					CASE e.event_type WHEN 'COMPLETE' THEN ?::float8 ELSE ?::float8 END
+					* POWER(0.5, EXTRACT(EPOCH FROM (?::timestamptz - e.occurred_at)) / ?::float8)
+				) AS score
+				FROM playback_events e
+				JOIN video_contents vc ON vc.video_id = e.video_id
+				WHERE e.occurred_at > ? AND e.occurred_at <= ?
+				GROUP BY vc.content_id
+				ORDER BY score DESC, vc.content_id
+				LIMIT ?
+			) scored`,
+			string(window), now,
+			trending.CompleteWeight, trending.StartWeight,
+			now, window.HalfLife().Seconds(),
+			now.Add(-window.Duration()), now,
+			trending.MaxRanked,
+		)
+		ranked = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to roll up trending scores", "window", window, "error", err)
+		return 0, err
+	}
+
+	return int(ranked), nil
+}
+
+func (r *trendingRepository) List(ctx context.Context, window trending.Window, limit int) ([]trending.Score, error) {
+	var models []TrendingScoreModel
+	err := r.db.WithContext(ctx).
+		Where("time_window = ?", string(window)).
+		Order("position").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]trending.Score, 0, len(models))
+	for _, model := range models {
+		scores = append(scores, trending.Score{
+			ContentID:  model.ContentID,
+			Score:      model.Score,
+			Rank:       model.Position,
+			ComputedAt: model.ComputedAt,
+		})
+	}
+	return scores, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"gorm.io/gorm"
+)
+
+type videoAssetRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewVideoAssetRepository(db *gorm.DB, logger *log.Logger) video.AssetRepository {
+	return &videoAssetRepository{db: db, logger: logger}
+}
+
+func (r *videoAssetRepository) Save(ctx context.Context, asset *video.Asset) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// A video has a single trick-play track and DASH manifest, so a new
+		// one replaces the previous.
+		if asset.Kind() == video.TrickPlayAsset || asset.Kind() == video.DASHAsset {
+			err := tx.Where("video_id = ? AND kind = ?", asset.VideoID(), asset.Kind()).Delete(&VideoAssetModel{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		model := VideoAssetModel{
+			ID:        asset.ID(),
+			VideoID:   asset.VideoID(),
+			Kind:      string(asset.Kind()),
+			Language:  asset.Language(),
+			Label:     asset.Label(),
+			URL:       asset.URL(),
+			CreatedAt: asset.CreatedAt(),
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			r.logger.Error("Failed to create video asset", "videoID", asset.VideoID(), "error", err)
+			if errors.Is(err, gorm.ErrForeignKeyViolated) {
+				return video.ErrNotFound
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *videoAssetRepository) FindByID(ctx context.Context, id string) (*video.Asset, error) {
+	var model VideoAssetModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, video.ErrAssetNotFound
+		}
+		return nil, err
+	}
+	return toDomainAsset(&model), nil
+}
+
+func (r *videoAssetRepository) ListByVideoID(ctx context.Context, videoID string) ([]*video.Asset, error) {
+	var models []VideoAssetModel
+	err := r.db.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("kind, language, created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]*video.Asset, 0, len(models))
+	for _, model := range models {
+		assets = append(assets, toDomainAsset(&model))
+	}
+	return assets, nil
+}
+
+func (r *videoAssetRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&VideoAssetModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return video.ErrAssetNotFound
+	}
+	return nil
+}
+
+func toDomainAsset(model *VideoAssetModel) *video.Asset {
+	return video.HydrateAsset(
+		model.ID,
+		model.VideoID,
+		video.AssetKind(model.Kind),
+		model.Language,
+		model.Label,
+		model.URL,
+		model.CreatedAt,
+	)
+}
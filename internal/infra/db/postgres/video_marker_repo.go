@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type videoMarkerRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewVideoMarkerRepository(db *gorm.DB, logger *log.Logger) video.MarkerRepository {
+	return &videoMarkerRepository{db: db, logger: logger}
+}
+
+func (r *videoMarkerRepository) Save(ctx context.Context, markers *video.Markers) error {
+	model := VideoMarkerModel{
+		VideoID:      markers.VideoID(),
+		CreditsStart: markers.CreditsStart(),
+		Source:       string(markers.Source()),
+		UpdatedAt:    markers.UpdatedAt(),
+	}
+	if intro := markers.Intro(); intro != nil {
+		model.IntroStart, model.IntroEnd = &intro.Start, &intro.End
+	}
+	if recap := markers.Recap(); recap != nil {
+		model.RecapStart, model.RecapEnd = &recap.Start, &recap.End
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"intro_start", "intro_end", "recap_start", "recap_end", "credits_start", "source", "updated_at"}),
+	}).Create(&model).Error
+	if err != nil {
+		r.logger.Error("Failed to upsert video markers", "videoID", markers.VideoID(), "error", err)
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return video.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *videoMarkerRepository) FindByVideoID(ctx context.Context, videoID string) (*video.Markers, error) {
+	var model VideoMarkerModel
+	if err := r.db.WithContext(ctx).First(&model, "video_id = ?", videoID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, video.ErrMarkersNotFound
+		}
+		return nil, err
+	}
+	return toDomainMarkers(&model), nil
+}
+
+func (r *videoMarkerRepository) ListByVideoIDs(ctx context.Context, videoIDs []string) ([]*video.Markers, error) {
+	if len(videoIDs) == 0 {
+		return []*video.Markers{}, nil
+	}
+
+	var models []VideoMarkerModel
+	if err := r.db.WithContext(ctx).Where("video_id IN ?", videoIDs).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	markers := make([]*video.Markers, 0, len(models))
+	for _, model := range models {
+		markers = append(markers, toDomainMarkers(&model))
+	}
+	return markers, nil
+}
+
+func (r *videoMarkerRepository) Delete(ctx context.Context, videoID string) error {
+	result := r.db.WithContext(ctx).Delete(&VideoMarkerModel{}, "video_id = ?", videoID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return video.ErrMarkersNotFound
+	}
+	return nil
+}
+
+func toDomainMarkers(model *VideoMarkerModel) *video.Markers {
+	var intro, recap *video.Span
+	if model.IntroStart != nil && model.IntroEnd != nil {
+		intro = &video.Span{Start: *model.IntroStart, End: *model.IntroEnd}
+	}
+	if model.RecapStart != nil && model.RecapEnd != nil {
+		recap = &video.Span{Start: *model.RecapStart, End: *model.RecapEnd}
+	}
+	return video.HydrateMarkers(model.VideoID, intro, recap, model.CreditsStart, video.MarkerSource(model.Source), model.UpdatedAt)
+}
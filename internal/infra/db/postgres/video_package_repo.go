@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/vault"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type videoPackageRepository struct {
+	db     *gorm.DB
+	sealer vault.KeySealer
+	logger *log.Logger
+}
+
+func NewVideoPackageRepository(db *gorm.DB, sealer vault.KeySealer, logger *log.Logger) video.PackageRepository {
+	return &videoPackageRepository{db: db, sealer: sealer, logger: logger}
+}
+
+func (r *videoPackageRepository) Save(ctx context.Context, hlsPackage *video.HLSPackage) error {
+	encryptedKey, err := r.sealer.Seal(hlsPackage.Key())
+	if err != nil {
+		return err
+	}
+
+	model := VideoPackageModel{
+		VideoID:      hlsPackage.VideoID(),
+		PlaylistURL:  hlsPackage.PlaylistURL(),
+		EncryptedKey: encryptedKey,
+		IV:           hlsPackage.IV(),
+		CreatedAt:    hlsPackage.CreatedAt(),
+	}
+	err = r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"playlist_url", "encrypted_key", "iv", "created_at"}),
+	}).Create(&model).Error
+	if err != nil {
+		r.logger.Error("Failed to upsert video package", "videoID", hlsPackage.VideoID(), "error", err)
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return video.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *videoPackageRepository) FindByVideoID(ctx context.Context, videoID string) (*video.HLSPackage, error) {
+	var model VideoPackageModel
+	if err := r.db.WithContext(ctx).First(&model, "video_id = ?", videoID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, video.ErrPackageNotFound
+		}
+		return nil, err
+	}
+
+	key, err := r.sealer.Open(model.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	return video.HydrateHLSPackage(model.VideoID, model.PlaylistURL, key, model.IV, model.CreatedAt), nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/watchlist"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type watchlistRepository struct {
+	db     *gorm.DB
+	logger *log.Logger
+}
+
+func NewWatchlistRepository(db *gorm.DB, logger *log.Logger) watchlist.Repository {
+	return &watchlistRepository{db: db, logger: logger}
+}
+
+func (r *watchlistRepository) Add(ctx context.Context, entry *watchlist.Entry) error {
+	err := r.db.WithContext(ctx).Exec(`
+		INSERT INTO watchlist_items (profile_id, content_id, position, created_at, updated_at)
+		SELECT ?, ?, COALESCE(MIN(position), 1) - 1, ?, ?
+		FROM watchlist_items WHERE profile_id = ?
+		ON CONFLICT (profile_id, content_id) DO NOTHING`,
+		entry.ProfileID(), entry.ContentID(), entry.AddedAt(), entry.AddedAt(), entry.ProfileID(),
+	).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return watchlist.ErrNotFound
+		}
+		r.logger.Error("Failed to add watchlist item", "profileID", entry.ProfileID(), "contentID", entry.ContentID(), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *watchlistRepository) Move(ctx context.Context, profileID, contentID string, position int) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	var contentIDs []string
+	err := tx.Model(&WatchlistItemModel{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("profile_id = ?", profileID).
+		Order("position, created_at DESC").
+		Pluck("content_id", &contentIDs).Error
+	if err != nil {
+		return err
+	}
+
+	current := slices.Index(contentIDs, contentID)
+	if current < 0 {
+		return watchlist.ErrNotFound
+	}
+	contentIDs = slices.Delete(contentIDs, current, current+1)
+	target := min(position-1, len(contentIDs))
+	contentIDs = slices.Insert(contentIDs, target, contentID)
+
+	now := time.Now().UTC()
+	for i, id := range contentIDs {
+		err := tx.Model(&WatchlistItemModel{}).
+			Where("profile_id = ? AND content_id = ?", profileID, id).
+			Updates(map[string]any{"position": i + 1, "updated_at": now}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *watchlistRepository) Remove(ctx context.Context, profileID, contentID string) error {
+	return r.db.WithContext(ctx).
+		Delete(&WatchlistItemModel{}, "profile_id = ? AND content_id = ?", profileID, contentID).Error
+}
+
+func (r *watchlistRepository) List(ctx context.Context, profileID string, offset, limit int) ([]*watchlist.Entry, int, error) {
+	listed := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Model(&WatchlistItemModel{}).
+			Joins("JOIN contents ON contents.id = watchlist_items.content_id AND contents.deleted_at IS NULL AND contents.status = ?", content.PublishedStatus).
+			Where("watchlist_items.profile_id = ?", profileID)
+	}
+
+	var total int64
+	if err := listed().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*WatchlistItemModel
+	err := listed().
+		Select("watchlist_items.*").
+		Order("watchlist_items.position, watchlist_items.created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*watchlist.Entry, 0, len(models))
+	for _, model := range models {
+		entries = append(entries, watchlist.HydrateEntry(
+			model.ProfileID,
+			model.ContentID,
+			model.Position,
+			model.CreatedAt,
+		))
+	}
+
+	return entries, int(total), nil
+}
@@ -0,0 +1,95 @@
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/charmbracelet/log"
+)
+
+type block struct {
+	prefix  netip.Prefix
+	country string
+}
+
+type fileCountryResolver struct {
+	blocks []block
+}
+
+// NewFileCountryResolver loads a GeoIP database from a CSV file with one
+// "network,country_code" row per address block, e.g. "203.0.113.0/24,BR".
+// A header row is allowed and blocks must not overlap.
+func NewFileCountryResolver(path string, logger *log.Logger) (CountryResolver, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.Comment = '#'
+
+	var blocks []block
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		prefix, err := netip.ParsePrefix(record[0])
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		country := normalizeCountry(record[1])
+		if country == "" {
+			return nil, fmt.Errorf("line %d: invalid country code %q", line, record[1])
+		}
+		blocks = append(blocks, block{prefix: prefix.Masked(), country: country})
+	}
+
+	slices.SortFunc(blocks, func(a, b block) int {
+		return a.prefix.Addr().Compare(b.prefix.Addr())
+	})
+
+	logger.Info("GeoIP database loaded", "path", path, "blocks", len(blocks))
+	return &fileCountryResolver{blocks: blocks}, nil
+}
+
+func (f *fileCountryResolver) Country(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return ""
+	}
+	return f.lookup(addr.Unmap())
+}
+
+// lookup finds the block starting at or right before addr and checks that
+// it covers addr.
+func (f *fileCountryResolver) lookup(addr netip.Addr) string {
+	i := sort.Search(len(f.blocks), func(i int) bool {
+		return f.blocks[i].prefix.Addr().Compare(addr) > 0
+	})
+	if i == 0 || !f.blocks[i-1].prefix.Contains(addr) {
+		return ""
+	}
+	return f.blocks[i-1].country
+}
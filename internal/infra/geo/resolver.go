@@ -0,0 +1,58 @@
+// Package geo resolves the country an HTTP request comes from, either from
+// a header set by a trusted proxy or CDN, or by looking the client address
+// up in a local GeoIP database file.
+package geo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/infra/config"
+)
+
+const (
+	HeaderResolver = "header"
+	FileResolver   = "geoip"
+)
+
+// CountryResolver returns the upper-case ISO 3166-1 alpha-2 code of the
+// country a request comes from, or an empty string when it is unknown.
+type CountryResolver interface {
+	Country(r *http.Request) string
+}
+
+// NewCountryResolver builds the resolver selected in the configuration.
+func NewCountryResolver(cfg *config.Config, logger *log.Logger) (CountryResolver, error) {
+	switch cfg.GeoResolver {
+	case HeaderResolver, "":
+		return NewHeaderCountryResolver(cfg.GeoCountryHeader), nil
+	case FileResolver:
+		return NewFileCountryResolver(cfg.GeoIPDatabasePath, logger)
+	default:
+		return nil, fmt.Errorf("unknown geo resolver %q", cfg.GeoResolver)
+	}
+}
+
+type headerCountryResolver struct {
+	header string
+}
+
+// NewHeaderCountryResolver trusts the country in the given header, such as
+// the one a CDN adds in front of the API.
+func NewHeaderCountryResolver(header string) CountryResolver {
+	return &headerCountryResolver{header: header}
+}
+
+func (h *headerCountryResolver) Country(r *http.Request) string {
+	return normalizeCountry(r.Header.Get(h.header))
+}
+
+func normalizeCountry(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 {
+		return ""
+	}
+	return country
+}
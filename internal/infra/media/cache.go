@@ -0,0 +1,221 @@
+package media
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"golang.org/x/sync/singleflight"
+)
+
+type Cache interface {
+	Stats() CacheStats
+	Purge(prefix string) int
+}
+
+type CacheStats struct {
+	Hits          int64        `json:"hits"`
+	Misses        int64        `json:"misses"`
+	Evictions     int64        `json:"evictions"`
+	Bytes         int64        `json:"bytes"`
+	MaxBytes      int64        `json:"max_bytes"`
+	MaxEntryBytes int64        `json:"max_entry_bytes"`
+	Entries       []CacheEntry `json:"entries"`
+}
+
+type CacheEntry struct {
+	Path       string    `json:"path"`
+	Bytes      int64     `json:"bytes"`
+	Hits       int64     `json:"hits"`
+	LastAccess time.Time `json:"last_access"`
+}
+
+type cacheItem struct {
+	path       string
+	data       []byte
+	info       os.FileInfo
+	hits       int64
+	lastAccess time.Time
+}
+
+type cachedMediaService struct {
+	MediaService
+
+	maxBytes      int64
+	maxEntryBytes int64
+
+	mu        sync.Mutex
+	items     map[string]*list.Element
+	lru       *list.List
+	bytes     int64
+	hits      int64
+	misses    int64
+	evictions int64
+
+	group  singleflight.Group
+	logger *log.Logger
+}
+
+func NewCachedMediaService(next MediaService, maxBytes, maxEntryBytes int64, logger *log.Logger) (MediaService, Cache) {
+	c := &cachedMediaService{
+		MediaService:  next,
+		maxBytes:      maxBytes,
+		maxEntryBytes: min(maxEntryBytes, maxBytes),
+		items:         make(map[string]*list.Element),
+		lru:           list.New(),
+		logger:        logger,
+	}
+	return c, c
+}
+
+type cachedFile struct {
+	*bytes.Reader
+}
+
+func (cachedFile) Close() error {
+	return nil
+}
+
+func (c *cachedMediaService) GetStream(filePath string) (io.ReadSeekCloser, os.FileInfo, error) {
+	// A stat is much cheaper than a read and catches files replaced on
+	// disk, such as segments of a repackaged video.
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		c.remove(filePath)
+		return c.MediaService.GetStream(filePath)
+	}
+	if stat.Size() > c.maxEntryBytes {
+		return c.MediaService.GetStream(filePath)
+	}
+
+	if item := c.lookup(filePath, stat); item != nil {
+		return cachedFile{bytes.NewReader(item.data)}, item.info, nil
+	}
+
+	value, err, _ := c.group.Do(filePath, func() (any, error) {
+		return c.load(filePath)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	item := value.(*cacheItem)
+	return cachedFile{bytes.NewReader(item.data)}, item.info, nil
+}
+
+func (c *cachedMediaService) lookup(filePath string, stat os.FileInfo) *cacheItem {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[filePath]
+	if ok {
+		item := elem.Value.(*cacheItem)
+		if item.info.ModTime().Equal(stat.ModTime()) && item.info.Size() == stat.Size() {
+			item.hits++
+			item.lastAccess = time.Now()
+			c.hits++
+			c.lru.MoveToFront(elem)
+			return item
+		}
+		c.removeElement(elem)
+	}
+	c.misses++
+	return nil
+}
+
+func (c *cachedMediaService) load(filePath string) (*cacheItem, error) {
+	file, info, err := c.MediaService.GetStream(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.logger.Error("Failed to read file into the media cache", "filePath", filePath, "error", err)
+		return nil, err
+	}
+
+	item := &cacheItem{path: filePath, data: data, info: info, lastAccess: time.Now()}
+	if int64(len(data)) > c.maxEntryBytes {
+		return item, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[filePath]; ok {
+		c.removeElement(elem)
+	}
+	c.items[filePath] = c.lru.PushFront(item)
+	c.bytes += int64(len(data))
+	for c.bytes > c.maxBytes {
+		c.removeElement(c.lru.Back())
+		c.evictions++
+	}
+	return item, nil
+}
+
+func (c *cachedMediaService) remove(filePath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[filePath]; ok {
+		c.removeElement(elem)
+	}
+}
+
+func (c *cachedMediaService) removeElement(elem *list.Element) {
+	item := c.lru.Remove(elem).(*cacheItem)
+	delete(c.items, item.path)
+	c.bytes -= int64(len(item.data))
+}
+
+func (c *cachedMediaService) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]CacheEntry, 0, len(c.items))
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		item := elem.Value.(*cacheItem)
+		entries = append(entries, CacheEntry{
+			Path:       item.path,
+			Bytes:      int64(len(item.data)),
+			Hits:       item.hits,
+			LastAccess: item.lastAccess,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Hits > entries[j].Hits
+	})
+
+	return CacheStats{
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Evictions:     c.evictions,
+		Bytes:         c.bytes,
+		MaxBytes:      c.maxBytes,
+		MaxEntryBytes: c.maxEntryBytes,
+		Entries:       entries,
+	}
+}
+
+func (c *cachedMediaService) Purge(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	purged := 0
+	for path, elem := range c.items {
+		if strings.HasPrefix(path, prefix) {
+			c.removeElement(elem)
+			purged++
+		}
+	}
+	c.logger.Info("Media cache purged", "prefix", prefix, "entries", purged)
+	return purged
+}
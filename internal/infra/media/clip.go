@@ -0,0 +1,109 @@
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// sceneThreshold is the ffmpeg scene score above which a frame counts as a
+// scene change.
+const sceneThreshold = "0.3"
+
+var ptsTimePattern = regexp.MustCompile(`pts_time:([0-9.]+)`)
+
+// CutClip copies length seconds of the video at srcPath, starting at start
+// seconds, into a new file under destFolder. The cut snaps to the nearest
+// keyframe since the streams are copied rather than re-encoded.
+func (s *localMediaService) CutClip(srcPath, destFolder string, start, length int) (*StoredFileInfo, error) {
+	log := s.logger.With("srcPath", srcPath, "start", start, "length", length)
+	log.Debug("Starting clip cut")
+
+	if err := os.MkdirAll(destFolder, os.ModePerm); err != nil {
+		log.Error("Failed to create destination directory", "error", err)
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	destPath := filepath.Join(destFolder, uuid.NewString()+".mp4")
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-y",
+		"-ss", strconv.Itoa(start),
+		"-i", srcPath,
+		"-t", strconv.Itoa(length),
+		"-c", "copy",
+		"-avoid_negative_ts", "make_zero",
+		"-movflags", "+faststart",
+		destPath,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		log.Error("Failed to run ffmpeg command", "error", err, "output", string(output))
+		os.Remove(destPath)
+		return nil, fmt.Errorf("failed to run ffmpeg: %w", err)
+	}
+
+	stat, err := os.Stat(destPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat clip: %w", err)
+	}
+	duration, err := getVideoDuration(s.logger, destPath)
+	if err != nil {
+		log.Warn("Could not get clip duration", "path", destPath, "error", err)
+	}
+	height, err := getVideoHeight(s.logger, destPath)
+	if err != nil {
+		log.Warn("Could not get clip height", "path", destPath, "error", err)
+	}
+
+	info := &StoredFileInfo{
+		URL:      "/" + destPath,
+		SizeInKb: int(stat.Size() / 1024),
+		Duration: duration,
+		Height:   height,
+	}
+	log.Info("Clip cut successfully", "url", info.URL)
+	return info, nil
+}
+
+// SceneChanges returns the times in seconds at which the video at srcPath
+// cuts to a new scene, in order.
+func (s *localMediaService) SceneChanges(srcPath string) ([]float64, error) {
+	log := s.logger.With("srcPath", srcPath)
+
+	cmd := exec.Command("ffmpeg",
+		"-hide_banner",
+		"-i", srcPath,
+		"-an",
+		"-vf", "select='gt(scene,"+sceneThreshold+")',showinfo",
+		"-f", "null",
+		"-",
+	)
+	// showinfo reports the selected frames on stderr.
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Error("Failed to run ffmpeg command", "error", err)
+		return nil, fmt.Errorf("failed to run ffmpeg: %w", err)
+	}
+
+	var changes []float64
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		match := ptsTimePattern.FindSubmatch(scanner.Bytes())
+		if match == nil {
+			continue
+		}
+		if at, err := strconv.ParseFloat(string(match[1]), 64); err == nil {
+			changes = append(changes, at)
+		}
+	}
+
+	log.Debug("Scene changes detected", "count", len(changes))
+	return changes, nil
+}
@@ -0,0 +1,127 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"os/exec"
+	"strconv"
+)
+
+const (
+	fingerprintSampleRate = 8000
+	fingerprintFrameSize  = 2048
+	fingerprintHopSize    = 512
+	fingerprintBands      = 33
+	fingerprintMinHz      = 300.0
+	fingerprintMaxHz      = 2000.0
+)
+
+// AudioFingerprint fingerprints the first seconds of audio of the video at
+// srcPath and returns one 32 bit sub-fingerprint per frame along with the
+// frame step in seconds. Each bit tells whether the energy difference of
+// two neighbouring frequency bands grew since the previous frame.
+func (s *localMediaService) AudioFingerprint(srcPath string, seconds int) ([]uint32, float64, error) {
+	log := s.logger.With("srcPath", srcPath)
+
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-t", strconv.Itoa(seconds),
+		"-i", srcPath,
+		"-vn",
+		"-ac", "1",
+		"-ar", strconv.Itoa(fingerprintSampleRate),
+		"-f", "s16le",
+		"-",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	raw, err := cmd.Output()
+	if err != nil {
+		log.Error("Failed to run ffmpeg command", "error", err, "output", stderr.String())
+		return nil, 0, fmt.Errorf("failed to run ffmpeg: %w", err)
+	}
+
+	samples := make([]float64, len(raw)/2)
+	for i := range samples {
+		samples[i] = float64(int16(binary.LittleEndian.Uint16(raw[2*i:])))
+	}
+
+	frames := fingerprintFrames(samples)
+	log.Debug("Audio fingerprinted", "frames", len(frames))
+	return frames, float64(fingerprintHopSize) / fingerprintSampleRate, nil
+}
+
+func fingerprintFrames(samples []float64) []uint32 {
+	window := make([]float64, fingerprintFrameSize)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(fingerprintFrameSize-1))
+	}
+
+	// Bands are spaced logarithmically, as hearing is.
+	edges := make([]int, fingerprintBands+1)
+	for i := range edges {
+		hz := fingerprintMinHz * math.Pow(fingerprintMaxHz/fingerprintMinHz, float64(i)/fingerprintBands)
+		edges[i] = int(hz * fingerprintFrameSize / fingerprintSampleRate)
+	}
+
+	var frames []uint32
+	var previous []float64
+	buffer := make([]complex128, fingerprintFrameSize)
+	for start := 0; start+fingerprintFrameSize <= len(samples); start += fingerprintHopSize {
+		for i := range buffer {
+			buffer[i] = complex(samples[start+i]*window[i], 0)
+		}
+		fft(buffer)
+
+		energies := make([]float64, fingerprintBands)
+		for band := range energies {
+			for bin := edges[band]; bin < max(edges[band+1], edges[band]+1); bin++ {
+				energies[band] += math.Pow(cmplx.Abs(buffer[bin]), 2)
+			}
+		}
+
+		if previous != nil {
+			var frame uint32
+			for band := 0; band < fingerprintBands-1; band++ {
+				diff := (energies[band] - energies[band+1]) - (previous[band] - previous[band+1])
+				if diff > 0 {
+					frame |= 1 << band
+				}
+			}
+			frames = append(frames, frame)
+		}
+		previous = energies
+	}
+	return frames
+}
+
+// fft is an in-place radix-2 Cooley-Tukey transform; len(x) must be a
+// power of two.
+func fft(x []complex128) {
+	n := len(x)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even, odd := x[start+k], x[start+k+size/2]*w
+				x[start+k], x[start+k+size/2] = even+odd, even-odd
+				w *= step
+			}
+		}
+	}
+}
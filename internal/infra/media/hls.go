@@ -0,0 +1,130 @@
+package media
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// HLSPlaylistName and HLSSegmentPattern name the files PackageHLS
+	// writes into its destination folder.
+	HLSPlaylistName   = "index.m3u8"
+	HLSSegmentPattern = "segment_%04d.ts"
+	// HLSSegmentSeconds is the target duration of each segment.
+	HLSSegmentSeconds = 6
+)
+
+// HLSKey is the AES-128 key segments are encrypted with. URI is written
+// to the playlist as the #EXT-X-KEY players fetch the key from.
+type HLSKey struct {
+	URI string
+	Key []byte
+	IV  []byte
+}
+
+// HLSStage is a package written next to its destination folder, so the
+// package already there keeps being served until Publish swaps it in.
+type HLSStage struct {
+	// PlaylistURL is where the playlist is served from once published.
+	PlaylistURL   string
+	stagingFolder string
+	destFolder    string
+	logger        *log.Logger
+}
+
+// Publish moves the staged package into its destination folder, replacing
+// any previous package. The previous package is put back if the move fails.
+func (st *HLSStage) Publish() error {
+	backupFolder := st.destFolder + "." + uuid.NewString() + ".old"
+	if err := os.Rename(st.destFolder, backupFolder); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to move previous package aside: %w", err)
+	}
+	if err := os.Rename(st.stagingFolder, st.destFolder); err != nil {
+		if restoreErr := os.Rename(backupFolder, st.destFolder); restoreErr != nil && !os.IsNotExist(restoreErr) {
+			st.logger.Error("Failed to restore previous hls package", "destFolder", st.destFolder, "error", restoreErr)
+		}
+		return fmt.Errorf("failed to publish package: %w", err)
+	}
+	if err := os.RemoveAll(backupFolder); err != nil {
+		st.logger.Warn("Failed to remove previous hls package", "folder", backupFolder, "error", err)
+	}
+	return nil
+}
+
+// Discard removes the staged package, leaving the destination untouched.
+func (st *HLSStage) Discard() {
+	if err := os.RemoveAll(st.stagingFolder); err != nil {
+		st.logger.Warn("Failed to remove staged hls package", "folder", st.stagingFolder, "error", err)
+	}
+}
+
+// PackageHLS remuxes the video at srcPath into AES-128 encrypted HLS
+// segments staged next to destFolder. Nothing in destFolder changes until
+// the returned stage is published, so a failed packaging keeps the
+// previous package playable.
+func (s *localMediaService) PackageHLS(srcPath, destFolder string, key HLSKey) (*HLSStage, error) {
+	log := s.logger.With("srcPath", srcPath, "destFolder", destFolder)
+	log.Debug("Starting hls packaging")
+
+	stage := &HLSStage{
+		PlaylistURL:   "/" + filepath.Join(destFolder, HLSPlaylistName),
+		stagingFolder: destFolder + "." + uuid.NewString() + ".tmp",
+		destFolder:    destFolder,
+		logger:        s.logger,
+	}
+	if err := os.MkdirAll(stage.stagingFolder, os.ModePerm); err != nil {
+		log.Error("Failed to create staging directory", "error", err)
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// ffmpeg reads the key from a file, which must never land next to the
+	// segments where it could be served.
+	keyDir, err := os.MkdirTemp("", "fakeflix-hls-key-")
+	if err != nil {
+		stage.Discard()
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+	defer os.RemoveAll(keyDir)
+
+	keyPath := filepath.Join(keyDir, "content.key")
+	if err := os.WriteFile(keyPath, key.Key, 0o600); err != nil {
+		stage.Discard()
+		return nil, fmt.Errorf("failed to write key file: %w", err)
+	}
+	keyInfoPath := filepath.Join(keyDir, "content.keyinfo")
+	keyInfo := fmt.Sprintf("%s\n%s\n%s\n", key.URI, keyPath, hex.EncodeToString(key.IV))
+	if err := os.WriteFile(keyInfoPath, []byte(keyInfo), 0o600); err != nil {
+		stage.Discard()
+		return nil, fmt.Errorf("failed to write key info file: %w", err)
+	}
+
+	playlistPath := filepath.Join(stage.stagingFolder, HLSPlaylistName)
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-y",
+		"-i", srcPath,
+		"-c", "copy",
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(HLSSegmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_key_info_file", keyInfoPath,
+		"-hls_segment_filename", filepath.Join(stage.stagingFolder, HLSSegmentPattern),
+		playlistPath,
+	)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		log.Error("Failed to run ffmpeg command", "error", err, "output", string(output))
+		stage.Discard()
+		return nil, fmt.Errorf("failed to run ffmpeg: %w", err)
+	}
+
+	log.Info("Video packaged for hls", "stagingFolder", stage.stagingFolder)
+	return stage, nil
+}
@@ -0,0 +1,71 @@
+package media
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Rendition returns a progressive copy of the video at srcPath scaled down
+// to height, transcoding it into destFolder the first time it is asked for
+// and reusing that file afterwards.
+func (s *localMediaService) Rendition(srcPath, destFolder string, height int) (*StoredFileInfo, error) {
+	log := s.logger.With("srcPath", srcPath, "height", height)
+
+	if err := os.MkdirAll(destFolder, os.ModePerm); err != nil {
+		log.Error("Failed to create destination directory", "error", err)
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(srcPath), filepath.Ext(srcPath))
+	destPath := filepath.Join(destFolder, fmt.Sprintf("%s_%dp.mp4", name, height))
+
+	stat, err := os.Stat(destPath)
+	if os.IsNotExist(err) {
+		log.Debug("Transcoding rendition", "destPath", destPath)
+		// Transcode to a temporary file so concurrent requests never pick
+		// up a half written rendition.
+		tmpPath := filepath.Join(destFolder, uuid.NewString()+".tmp.mp4")
+		cmd := exec.Command("ffmpeg",
+			"-v", "error",
+			"-y",
+			"-i", srcPath,
+			"-vf", "scale=-2:"+strconv.Itoa(height),
+			"-c:v", "libx264",
+			"-preset", "veryfast",
+			"-c:a", "copy",
+			"-movflags", "+faststart",
+			tmpPath,
+		)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			log.Error("Failed to run ffmpeg command", "error", err, "output", string(output))
+			os.Remove(tmpPath)
+			return nil, fmt.Errorf("failed to run ffmpeg: %w", err)
+		}
+		if err := os.Rename(tmpPath, destPath); err != nil {
+			os.Remove(tmpPath)
+			return nil, fmt.Errorf("failed to move rendition: %w", err)
+		}
+		stat, err = os.Stat(destPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat rendition: %w", err)
+	}
+
+	duration, err := getVideoDuration(s.logger, destPath)
+	if err != nil {
+		log.Warn("Could not get rendition duration", "path", destPath, "error", err)
+	}
+
+	return &StoredFileInfo{
+		URL:      "/" + destPath,
+		SizeInKb: int(stat.Size() / 1024),
+		Duration: duration,
+		Height:   height,
+	}, nil
+}
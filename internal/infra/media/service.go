@@ -17,11 +17,17 @@ type StoredFileInfo struct {
 	URL      string
 	SizeInKb int
 	Duration int
+	Height   int
 }
 
 type MediaService interface {
 	Store(fileHeader *multipart.FileHeader, destFolder string) (*StoredFileInfo, error)
 	GetStream(filePath string) (io.ReadSeekCloser, os.FileInfo, error)
+	PackageHLS(srcPath, destFolder string, key HLSKey) (*HLSStage, error)
+	CutClip(srcPath, destFolder string, start, length int) (*StoredFileInfo, error)
+	SceneChanges(srcPath string) ([]float64, error)
+	AudioFingerprint(srcPath string, seconds int) ([]uint32, float64, error)
+	Rendition(srcPath, destFolder string, height int) (*StoredFileInfo, error)
 }
 
 type localMediaService struct {
@@ -57,10 +63,17 @@ func (s *localMediaService) Store(fileHeader *multipart.FileHeader, destFolder s
 	}
 	log.Debug("Video duration retrieved", "duration_sec", duration)
 
+	height, err := getVideoHeight(s.logger, destPath)
+	if err != nil {
+		log.Warn("Could not get video height", "path", destPath, "error", err)
+		height = 0
+	}
+
 	info := &StoredFileInfo{
 		URL:      "/" + destPath,
 		SizeInKb: int(fileHeader.Size / 1024),
 		Duration: duration,
+		Height:   height,
 	}
 
 	log.Info("File stored and processed successfully", "url", info.URL, "sizeKB", info.SizeInKb)
@@ -134,3 +147,34 @@ func getVideoDuration(logger *log.Logger, filePath string) (int, error) {
 
 	return int(durationFloat), nil
 }
+
+func getVideoHeight(logger *log.Logger, filePath string) (int, error) {
+	log := logger.With("filePath", filePath)
+	if !strings.HasSuffix(strings.ToLower(filePath), ".mp4") {
+		log.Debug("File is not an mp4, skipping height check")
+		return 0, nil
+	}
+
+	cmd := exec.Command("ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=height",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		filePath,
+	)
+
+	output, err := cmd.Output()
+	if err != nil {
+		log.Error("Failed to run ffprobe command", "error", err, "output", string(output))
+		return 0, fmt.Errorf("failed to run ffprobe: %w", err)
+	}
+
+	heightStr := strings.TrimSpace(string(output))
+	height, err := strconv.Atoi(heightStr)
+	if err != nil {
+		log.Warn("Failed to parse ffprobe height output", "output", heightStr, "error", err)
+		return 0, fmt.Errorf("failed to parse height: %w", err)
+	}
+
+	return height, nil
+}
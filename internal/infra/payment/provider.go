@@ -0,0 +1,35 @@
+// Package payment charges subscribers through a payment provider. Only a
+// local fake provider exists; a real gateway plugs in behind Provider.
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+var ErrDeclined = errors.New("payment declined")
+
+type Provider interface {
+	// Charge bills the account and returns the provider's reference for the
+	// payment, or ErrDeclined.
+	Charge(ctx context.Context, accountID string, amountCents int, description string) (string, error)
+}
+
+type fakeProvider struct {
+	logger *log.Logger
+}
+
+// NewFakeProvider returns a provider that approves every charge without
+// moving any money.
+func NewFakeProvider(logger *log.Logger) Provider {
+	return &fakeProvider{logger: logger}
+}
+
+func (p *fakeProvider) Charge(ctx context.Context, accountID string, amountCents int, description string) (string, error) {
+	reference := "fake_" + uuid.NewString()
+	p.logger.Info("Fake payment approved", "accountID", accountID, "amountCents", amountCents, "description", description, "reference", reference)
+	return reference, nil
+}
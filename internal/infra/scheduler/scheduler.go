@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+type Job func(ctx context.Context) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+// Scheduler runs background jobs in-process at fixed intervals. A job never
+// overlaps with itself: the next tick is skipped while a run is in progress.
+type Scheduler struct {
+	entries []entry
+	logger  *log.Logger
+}
+
+func New(logger *log.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+// Every registers a job to run once on start and then at every interval.
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	s.entries = append(s.entries, entry{name: name, interval: interval, job: job})
+}
+
+// Start launches every registered job until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, e := range s.entries {
+		go s.loop(ctx, e)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	log := s.logger.With("job", e.name)
+	if e.interval <= 0 {
+		log.Warn("Job disabled, interval must be positive", "interval", e.interval)
+		return
+	}
+	log.Info("Scheduling job", "interval", e.interval)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(ctx, log, e)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, log *log.Logger, e entry) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("Job panicked", "panic", r)
+		}
+	}()
+
+	startedAt := time.Now()
+	if err := e.job(ctx); err != nil {
+		log.Error("Job failed", "error", err, "elapsed", time.Since(startedAt))
+		return
+	}
+	log.Debug("Job finished", "elapsed", time.Since(startedAt))
+}
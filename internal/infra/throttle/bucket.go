@@ -0,0 +1,98 @@
+package throttle
+
+import (
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastSeen time.Time
+}
+
+func newBucket(rate, burst int, now time.Time) *bucket {
+	return &bucket{
+		rate:     float64(rate),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastSeen: now,
+	}
+}
+
+func (b *bucket) reserve(n int, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *bucket) raiseBurst(burst int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if float64(burst) > b.burst {
+		b.burst = float64(burst)
+	}
+}
+
+func (b *bucket) idleSince(before, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	return b.lastSeen.Before(before) && b.tokens >= b.burst
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.lastSeen); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.lastSeen = now
+	}
+}
+
+type keyedBuckets struct {
+	mu        sync.Mutex
+	rate      int
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+const pruneInterval = time.Minute
+
+func newKeyedBuckets(rate int, now time.Time) *keyedBuckets {
+	return &keyedBuckets{
+		rate:      rate,
+		buckets:   make(map[string]*bucket),
+		lastPrune: now,
+	}
+}
+
+func (k *keyedBuckets) get(key string, burst int, now time.Time) *bucket {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if now.Sub(k.lastPrune) > pruneInterval {
+		for key, b := range k.buckets {
+			if b.idleSince(k.lastPrune, now) {
+				delete(k.buckets, key)
+			}
+		}
+		k.lastPrune = now
+	}
+
+	b, ok := k.buckets[key]
+	if !ok {
+		b = newBucket(k.rate, burst, now)
+		k.buckets[key] = b
+	}
+	b.raiseBurst(burst)
+	return b
+}
@@ -0,0 +1,54 @@
+package throttle
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// chunkSize caps each read so that waits stay short and even.
+const chunkSize = 32 << 10
+
+type reader struct {
+	ctx     context.Context
+	rs      io.ReadSeeker
+	buckets []*bucket
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(p) > chunkSize {
+		p = p[:chunkSize]
+	}
+
+	n, err := r.rs.Read(p)
+	if n > 0 {
+		if waitErr := r.wait(n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+func (r *reader) Seek(offset int64, whence int) (int64, error) {
+	return r.rs.Seek(offset, whence)
+}
+
+func (r *reader) wait(n int) error {
+	now := time.Now()
+	var delay time.Duration
+	for _, b := range r.buckets {
+		delay = max(delay, b.reserve(n, now))
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+}
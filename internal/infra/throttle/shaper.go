@@ -0,0 +1,75 @@
+package throttle
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/infra/config"
+)
+
+// minBurst keeps the burst of slow videos above a single read.
+const minBurst = chunkSize
+
+type Stream struct {
+	ClientIP  string
+	SessionID string
+	Bitrate   int
+}
+
+type Shaper struct {
+	global      *bucket
+	perClient   *keyedBuckets
+	perSession  *keyedBuckets
+	burstWindow time.Duration
+	logger      *log.Logger
+}
+
+func NewShaper(cfg *config.Config, logger *log.Logger) *Shaper {
+	now := time.Now()
+	s := &Shaper{
+		burstWindow: time.Duration(cfg.StreamBurstSeconds) * time.Second,
+		logger:      logger,
+	}
+	if cfg.StreamGlobalBytesPerSecond > 0 {
+		s.global = newBucket(cfg.StreamGlobalBytesPerSecond, max(cfg.StreamGlobalBytesPerSecond, minBurst), now)
+	}
+	if cfg.StreamClientBytesPerSecond > 0 {
+		s.perClient = newKeyedBuckets(cfg.StreamClientBytesPerSecond, now)
+	}
+	if cfg.StreamSessionBytesPerSecond > 0 {
+		s.perSession = newKeyedBuckets(cfg.StreamSessionBytesPerSecond, now)
+	}
+
+	logger.Info("Stream shaping configured",
+		"globalBytesPerSecond", cfg.StreamGlobalBytesPerSecond,
+		"clientBytesPerSecond", cfg.StreamClientBytesPerSecond,
+		"sessionBytesPerSecond", cfg.StreamSessionBytesPerSecond,
+		"burstSeconds", cfg.StreamBurstSeconds,
+	)
+	return s
+}
+
+func (s *Shaper) Wrap(ctx context.Context, rs io.ReadSeeker, stream Stream) io.ReadSeeker {
+	now := time.Now()
+	// Clients may buffer ahead by burstWindow worth of the video, which
+	// lets playback start quickly without lifting the sustained limit.
+	burst := max(int(float64(stream.Bitrate)*s.burstWindow.Seconds()), minBurst)
+
+	var buckets []*bucket
+	if s.global != nil {
+		buckets = append(buckets, s.global)
+	}
+	if s.perClient != nil && stream.ClientIP != "" {
+		buckets = append(buckets, s.perClient.get(stream.ClientIP, burst, now))
+	}
+	if s.perSession != nil && stream.SessionID != "" {
+		buckets = append(buckets, s.perSession.get(stream.SessionID, burst, now))
+	}
+
+	if len(buckets) == 0 {
+		return rs
+	}
+	return &reader{ctx: ctx, rs: rs, buckets: buckets}
+}
@@ -0,0 +1,68 @@
+// Package vault encrypts secrets, such as HLS content keys, before they are
+// stored.
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+)
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+type KeySealer interface {
+	Seal(plaintext []byte) ([]byte, error)
+	Open(ciphertext []byte) ([]byte, error)
+}
+
+// aesGCMSealer seals with AES-256-GCM under the master key. Each ciphertext
+// is prefixed with its random nonce.
+type aesGCMSealer struct {
+	aead   cipher.AEAD
+	logger *log.Logger
+}
+
+// NewKeySealer builds a sealer from a hex encoded 32 byte master key.
+func NewKeySealer(masterKeyHex string, logger *log.Logger) (KeySealer, error) {
+	masterKey, err := hex.DecodeString(masterKeyHex)
+	if err != nil || len(masterKey) != 32 {
+		return nil, errors.New("master key must be 32 hex encoded bytes")
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return &aesGCMSealer{aead: aead, logger: logger}, nil
+}
+
+func (s *aesGCMSealer) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (s *aesGCMSealer) Open(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < s.aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, sealed := ciphertext[:s.aead.NonceSize()], ciphertext[s.aead.NonceSize():]
+	plaintext, err := s.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		s.logger.Error("Failed to open sealed key", "error", err)
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/account"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type AccountHandler struct {
+	registerAccountUseCase *account.RegisterAccountUseCase
+	loginUseCase           *account.LoginUseCase
+	switchProfileUseCase   *account.SwitchProfileUseCase
+	refreshTokenUseCase    *account.RefreshTokenUseCase
+	logger                 *log.Logger
+}
+
+func NewAccountHandler(
+	registerAccountUseCase *account.RegisterAccountUseCase,
+	loginUseCase *account.LoginUseCase,
+	switchProfileUseCase *account.SwitchProfileUseCase,
+	refreshTokenUseCase *account.RefreshTokenUseCase,
+	logger *log.Logger,
+) *AccountHandler {
+	return &AccountHandler{
+		registerAccountUseCase: registerAccountUseCase,
+		loginUseCase:           loginUseCase,
+		switchProfileUseCase:   switchProfileUseCase,
+		refreshTokenUseCase:    refreshTokenUseCase,
+		logger:                 logger,
+	}
+}
+
+func (h *AccountHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var requestDTO account.RegisterAccountInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.registerAccountUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Account registered successfully", "accountID", output.ID)
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *AccountHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var requestDTO account.LoginInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.UserAgent = r.UserAgent()
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.loginUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *AccountHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var requestDTO account.RefreshTokenInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.UserAgent = r.UserAgent()
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.refreshTokenUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *AccountHandler) SwitchProfile(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := account.SwitchProfileInputDTO{
+		AccountID: v.AccountID,
+		ProfileID: chi.URLParam(r, "profileID"),
+		DeviceID:  v.DeviceID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.switchProfileUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
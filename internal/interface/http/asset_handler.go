@@ -0,0 +1,155 @@
+package http
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+// assetContentTypes covers the asset files Go does not know the type of.
+var assetContentTypes = map[string]string{
+	".vtt": "text/vtt",
+	".srt": "application/x-subrip",
+	".mpd": "application/dash+xml",
+	".bif": "application/octet-stream",
+	".m4a": "audio/mp4",
+}
+
+type AssetHandler struct {
+	addAssetUseCase    *video.AddAssetUseCase
+	getAssetUseCase    *video.GetAssetUseCase
+	deleteAssetUseCase *video.DeleteAssetUseCase
+	mediaService       media.MediaService
+	logger             *log.Logger
+}
+
+func NewAssetHandler(
+	addAssetUseCase *video.AddAssetUseCase,
+	getAssetUseCase *video.GetAssetUseCase,
+	deleteAssetUseCase *video.DeleteAssetUseCase,
+	mediaService media.MediaService,
+	logger *log.Logger,
+) *AssetHandler {
+	return &AssetHandler{
+		addAssetUseCase:    addAssetUseCase,
+		getAssetUseCase:    getAssetUseCase,
+		deleteAssetUseCase: deleteAssetUseCase,
+		mediaService:       mediaService,
+		logger:             logger,
+	}
+}
+
+func (h *AssetHandler) AddAsset(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
+		return
+	}
+
+	_, fileHeader, _ := r.FormFile("file")
+
+	requestDTO := video.AddAssetInputDTO{
+		VideoID:  chi.URLParam(r, "videoID"),
+		Kind:     strings.ToUpper(r.FormValue("kind")),
+		Language: r.FormValue("language"),
+		Label:    r.FormValue("label"),
+		File:     fileHeader,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.addAssetUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *AssetHandler) GetAsset(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := video.GetAssetInputDTO{
+		GetStreamInfoInputDTO: video.GetStreamInfoInputDTO{
+			VideoID:     chi.URLParam(r, "videoID"),
+			AccountID:   v.AccountID,
+			ProfileID:   v.ProfileID,
+			DeviceID:    v.DeviceID,
+			ProfilePIN:  r.Header.Get(profilePINHeader),
+			PINUnlocked: v.PINUnlocked,
+			Country:     countryFromContext(r.Context()),
+			UserAgent:   r.UserAgent(),
+		},
+		AssetID: chi.URLParam(r, "assetID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getAssetUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	file, fileStat, err := h.mediaService.GetStream(output.FilePath)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"video asset not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		))
+		return
+	}
+	defer file.Close()
+
+	if contentType, ok := assetContentTypes[strings.ToLower(filepath.Ext(output.FilePath))]; ok {
+		w.Header().Set("Content-Type", contentType)
+	}
+	http.ServeContent(w, r, filepath.Base(output.FilePath), fileStat.ModTime(), file)
+}
+
+func (h *AssetHandler) DeleteAsset(w http.ResponseWriter, r *http.Request) {
+	requestDTO := video.DeleteAssetInputDTO{
+		VideoID: chi.URLParam(r, "videoID"),
+		AssetID: chi.URLParam(r, "assetID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteAssetUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
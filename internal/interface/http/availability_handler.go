@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/availability"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type AvailabilityHandler struct {
+	setAvailabilityUseCase   *availability.SetAvailabilityUseCase
+	clearAvailabilityUseCase *availability.ClearAvailabilityUseCase
+	logger                   *log.Logger
+}
+
+func NewAvailabilityHandler(
+	setAvailabilityUseCase *availability.SetAvailabilityUseCase,
+	clearAvailabilityUseCase *availability.ClearAvailabilityUseCase,
+	logger *log.Logger,
+) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		setAvailabilityUseCase:   setAvailabilityUseCase,
+		clearAvailabilityUseCase: clearAvailabilityUseCase,
+		logger:                   logger,
+	}
+}
+
+func (h *AvailabilityHandler) SetAvailability(w http.ResponseWriter, r *http.Request) {
+	var requestDTO availability.SetAvailabilityInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setAvailabilityUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *AvailabilityHandler) ClearAvailability(w http.ResponseWriter, r *http.Request) {
+	requestDTO := availability.ClearAvailabilityInputDTO{
+		ContentID: chi.URLParam(r, "contentID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.clearAvailabilityUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type CatalogHandler struct {
+	listContentsUseCase *catalog.ListContentsUseCase
+	logger              *log.Logger
+}
+
+func NewCatalogHandler(listContentsUseCase *catalog.ListContentsUseCase, logger *log.Logger) *CatalogHandler {
+	return &CatalogHandler{
+		listContentsUseCase: listContentsUseCase,
+		logger:              logger,
+	}
+}
+
+func (h *CatalogHandler) ListContents(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, err := pagination(r)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := catalog.ListContentsInputDTO{
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Country:    countryFromContext(r.Context()),
+		Locales:    acceptedLocales(r),
+		Genre:      r.URL.Query().Get("genre"),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listContentsUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,221 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/collection"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type CollectionHandler struct {
+	createCollectionUseCase     *collection.CreateCollectionUseCase
+	updateCollectionUseCase     *collection.UpdateCollectionUseCase
+	setCollectionArtworkUseCase *collection.SetCollectionArtworkUseCase
+	deleteCollectionUseCase     *collection.DeleteCollectionUseCase
+	listCollectionsUseCase      *collection.ListCollectionsUseCase
+	getCollectionUseCase        *collection.GetCollectionUseCase
+	logger                      *log.Logger
+}
+
+func NewCollectionHandler(
+	createCollectionUseCase *collection.CreateCollectionUseCase,
+	updateCollectionUseCase *collection.UpdateCollectionUseCase,
+	setCollectionArtworkUseCase *collection.SetCollectionArtworkUseCase,
+	deleteCollectionUseCase *collection.DeleteCollectionUseCase,
+	listCollectionsUseCase *collection.ListCollectionsUseCase,
+	getCollectionUseCase *collection.GetCollectionUseCase,
+	logger *log.Logger,
+) *CollectionHandler {
+	return &CollectionHandler{
+		createCollectionUseCase:     createCollectionUseCase,
+		updateCollectionUseCase:     updateCollectionUseCase,
+		setCollectionArtworkUseCase: setCollectionArtworkUseCase,
+		deleteCollectionUseCase:     deleteCollectionUseCase,
+		listCollectionsUseCase:      listCollectionsUseCase,
+		getCollectionUseCase:        getCollectionUseCase,
+		logger:                      logger,
+	}
+}
+
+func (h *CollectionHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	var requestDTO collection.CreateCollectionInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.createCollectionUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Collection created successfully", "collectionID", output.ID, "slug", output.Slug)
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *CollectionHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	var requestDTO collection.UpdateCollectionInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.Slug = chi.URLParam(r, "slug")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.updateCollectionUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *CollectionHandler) SetArtwork(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
+		return
+	}
+
+	_, artworkHeader, _ := r.FormFile("artwork")
+
+	requestDTO := collection.SetCollectionArtworkInputDTO{
+		Slug:    chi.URLParam(r, "slug"),
+		Artwork: artworkHeader,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setCollectionArtworkUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *CollectionHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	requestDTO := collection.DeleteCollectionInputDTO{
+		Slug: chi.URLParam(r, "slug"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteCollectionUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CollectionHandler) ListCollections(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, err := pagination(r)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := collection.ListCollectionsInputDTO{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listCollectionsUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *CollectionHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	requestDTO := collection.GetCollectionInputDTO{
+		Slug:       chi.URLParam(r, "slug"),
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Locales:    acceptedLocales(r),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getCollectionUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
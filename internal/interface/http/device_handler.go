@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/device"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type DeviceHandler struct {
+	listDevicesUseCase   *device.ListDevicesUseCase
+	signOutDeviceUseCase *device.SignOutDeviceUseCase
+	logger               *log.Logger
+}
+
+func NewDeviceHandler(listDevicesUseCase *device.ListDevicesUseCase, signOutDeviceUseCase *device.SignOutDeviceUseCase, logger *log.Logger) *DeviceHandler {
+	return &DeviceHandler{
+		listDevicesUseCase:   listDevicesUseCase,
+		signOutDeviceUseCase: signOutDeviceUseCase,
+		logger:               logger,
+	}
+}
+
+func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := device.ListDevicesInputDTO{
+		AccountID: v.AccountID,
+		DeviceID:  v.DeviceID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listDevicesUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *DeviceHandler) SignOutDevice(w http.ResponseWriter, r *http.Request) {
+	requestDTO := device.SignOutDeviceInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		DeviceID:  chi.URLParam(r, "deviceID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.signOutDeviceUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
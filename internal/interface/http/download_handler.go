@@ -0,0 +1,193 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/usecase/download"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type DownloadHandler struct {
+	issueLicenseUseCase    *download.IssueLicenseUseCase
+	listLicensesUseCase    *download.ListLicensesUseCase
+	renewLicenseUseCase    *download.RenewLicenseUseCase
+	returnLicenseUseCase   *download.ReturnLicenseUseCase
+	getDownloadFileUseCase *download.GetDownloadFileUseCase
+	mediaService           media.MediaService
+	logger                 *log.Logger
+}
+
+func NewDownloadHandler(
+	issueLicenseUseCase *download.IssueLicenseUseCase,
+	listLicensesUseCase *download.ListLicensesUseCase,
+	renewLicenseUseCase *download.RenewLicenseUseCase,
+	returnLicenseUseCase *download.ReturnLicenseUseCase,
+	getDownloadFileUseCase *download.GetDownloadFileUseCase,
+	mediaService media.MediaService,
+	logger *log.Logger,
+) *DownloadHandler {
+	return &DownloadHandler{
+		issueLicenseUseCase:    issueLicenseUseCase,
+		listLicensesUseCase:    listLicensesUseCase,
+		renewLicenseUseCase:    renewLicenseUseCase,
+		returnLicenseUseCase:   returnLicenseUseCase,
+		getDownloadFileUseCase: getDownloadFileUseCase,
+		mediaService:           mediaService,
+		logger:                 logger,
+	}
+}
+
+func (h *DownloadHandler) IssueLicense(w http.ResponseWriter, r *http.Request) {
+	var requestDTO download.IssueLicenseInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	v := viewerFromContext(r.Context())
+	if v.DeviceID == "" {
+		httputils.RespondWithError(w, r, fault.New(
+			"downloads need a signed in device",
+			fault.WithKind(fault.KindForbidden),
+		))
+		return
+	}
+	requestDTO.AccountID = v.AccountID
+	requestDTO.ProfileID = v.ProfileID
+	requestDTO.DeviceID = v.DeviceID
+	requestDTO.ProfilePIN = r.Header.Get(profilePINHeader)
+	requestDTO.Country = countryFromContext(r.Context())
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.issueLicenseUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *DownloadHandler) ListLicenses(w http.ResponseWriter, r *http.Request) {
+	requestDTO := download.ListLicensesInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listLicensesUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *DownloadHandler) RenewLicense(w http.ResponseWriter, r *http.Request) {
+	requestDTO := download.RenewLicenseInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		LicenseID: chi.URLParam(r, "licenseID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.renewLicenseUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *DownloadHandler) ReturnLicense(w http.ResponseWriter, r *http.Request) {
+	requestDTO := download.ReturnLicenseInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		LicenseID: chi.URLParam(r, "licenseID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.returnLicenseUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DownloadHandler) GetFile(w http.ResponseWriter, r *http.Request) {
+	requestDTO := download.GetDownloadFileInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		LicenseID: chi.URLParam(r, "licenseID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getDownloadFileUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	file, fileStat, err := h.mediaService.GetStream(output.FilePath)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filepath.Base(output.FilePath)+`"`)
+	http.ServeContent(w, r, filepath.Base(output.FilePath), fileStat.ModTime(), file)
+}
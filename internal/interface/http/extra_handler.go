@@ -0,0 +1,153 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/extra"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type ExtraHandler struct {
+	addTrailerUseCase      *extra.AddTrailerUseCase
+	generatePreviewUseCase *extra.GeneratePreviewUseCase
+	listExtrasUseCase      *extra.ListExtrasUseCase
+	deleteExtraUseCase     *extra.DeleteExtraUseCase
+	logger                 *log.Logger
+}
+
+func NewExtraHandler(
+	addTrailerUseCase *extra.AddTrailerUseCase,
+	generatePreviewUseCase *extra.GeneratePreviewUseCase,
+	listExtrasUseCase *extra.ListExtrasUseCase,
+	deleteExtraUseCase *extra.DeleteExtraUseCase,
+	logger *log.Logger,
+) *ExtraHandler {
+	return &ExtraHandler{
+		addTrailerUseCase:      addTrailerUseCase,
+		generatePreviewUseCase: generatePreviewUseCase,
+		listExtrasUseCase:      listExtrasUseCase,
+		deleteExtraUseCase:     deleteExtraUseCase,
+		logger:                 logger,
+	}
+}
+
+func (h *ExtraHandler) AddTrailer(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
+		return
+	}
+
+	_, videoHeader, _ := r.FormFile("video")
+
+	requestDTO := extra.AddTrailerInputDTO{
+		ContentID: chi.URLParam(r, "contentID"),
+		Title:     r.FormValue("title"),
+		Video:     videoHeader,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.addTrailerUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Trailer added", "contentID", requestDTO.ContentID, "extraID", output.ID)
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *ExtraHandler) GeneratePreview(w http.ResponseWriter, r *http.Request) {
+	// The body is optional: without it the preview gets the default length
+	// at a detected offset.
+	var requestDTO extra.GeneratePreviewInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil && !errors.Is(err, io.EOF) {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.generatePreviewUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *ExtraHandler) ListExtras(w http.ResponseWriter, r *http.Request) {
+	requestDTO := extra.ListExtrasInputDTO{
+		ContentID: chi.URLParam(r, "contentID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listExtrasUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *ExtraHandler) DeleteExtra(w http.ResponseWriter, r *http.Request) {
+	requestDTO := extra.DeleteExtraInputDTO{
+		ContentID: chi.URLParam(r, "contentID"),
+		ExtraID:   chi.URLParam(r, "extraID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteExtraUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
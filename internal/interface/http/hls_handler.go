@@ -0,0 +1,202 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/infra/throttle"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type HLSHandler struct {
+	packageVideoUseCase  *video.PackageVideoUseCase
+	getPlaylistUseCase   *video.GetPlaylistUseCase
+	getSegmentUseCase    *video.GetSegmentUseCase
+	getContentKeyUseCase *video.GetContentKeyUseCase
+	mediaService         media.MediaService
+	shaper               *throttle.Shaper
+	logger               *log.Logger
+}
+
+func NewHLSHandler(
+	packageVideoUseCase *video.PackageVideoUseCase,
+	getPlaylistUseCase *video.GetPlaylistUseCase,
+	getSegmentUseCase *video.GetSegmentUseCase,
+	getContentKeyUseCase *video.GetContentKeyUseCase,
+	mediaService media.MediaService,
+	shaper *throttle.Shaper,
+	logger *log.Logger,
+) *HLSHandler {
+	return &HLSHandler{
+		packageVideoUseCase:  packageVideoUseCase,
+		getPlaylistUseCase:   getPlaylistUseCase,
+		getSegmentUseCase:    getSegmentUseCase,
+		getContentKeyUseCase: getContentKeyUseCase,
+		mediaService:         mediaService,
+		shaper:               shaper,
+		logger:               logger,
+	}
+}
+
+func (h *HLSHandler) PackageVideo(w http.ResponseWriter, r *http.Request) {
+	requestDTO := video.PackageVideoInputDTO{
+		VideoID: chi.URLParam(r, "videoID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.packageVideoUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *HLSHandler) GetPlaylist(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := video.GetPlaylistInputDTO{
+		GetStreamInfoInputDTO: video.GetStreamInfoInputDTO{
+			VideoID:     chi.URLParam(r, "videoID"),
+			AccountID:   v.AccountID,
+			ProfileID:   v.ProfileID,
+			DeviceID:    v.DeviceID,
+			ProfilePIN:  r.Header.Get(profilePINHeader),
+			PINUnlocked: v.PINUnlocked,
+			Country:     countryFromContext(r.Context()),
+			SessionID:   playbackSessionID(r),
+			UserAgent:   r.UserAgent(),
+		},
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getPlaylistUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	if output.SessionID != "" {
+		w.Header().Set(playbackSessionHeader, output.SessionID)
+	}
+	// Playlists carry the session in their key URI and must not be shared.
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+	http.ServeContent(w, r, media.HLSPlaylistName, time.Time{}, bytes.NewReader(output.Playlist))
+}
+
+func (h *HLSHandler) GetSegment(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := video.GetSegmentInputDTO{
+		GetStreamInfoInputDTO: video.GetStreamInfoInputDTO{
+			VideoID:     chi.URLParam(r, "videoID"),
+			AccountID:   v.AccountID,
+			ProfileID:   v.ProfileID,
+			DeviceID:    v.DeviceID,
+			ProfilePIN:  r.Header.Get(profilePINHeader),
+			PINUnlocked: v.PINUnlocked,
+			Country:     countryFromContext(r.Context()),
+			SessionID:   playbackSessionID(r),
+			UserAgent:   r.UserAgent(),
+		},
+		Segment: chi.URLParam(r, "segment"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getSegmentUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	file, fileStat, err := h.mediaService.GetStream(output.FilePath)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"segment not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		))
+		return
+	}
+	defer file.Close()
+
+	throttled := h.shaper.Wrap(r.Context(), file, throttle.Stream{
+		ClientIP:  clientIP(r),
+		SessionID: output.SessionID,
+		Bitrate:   int(fileStat.Size()) / media.HLSSegmentSeconds,
+	})
+	w.Header().Set("Content-Type", "video/mp2t")
+	http.ServeContent(w, r, filepath.Base(output.FilePath), fileStat.ModTime(), throttled)
+}
+
+func (h *HLSHandler) GetContentKey(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := video.GetContentKeyInputDTO{
+		GetStreamInfoInputDTO: video.GetStreamInfoInputDTO{
+			VideoID:     chi.URLParam(r, "videoID"),
+			AccountID:   v.AccountID,
+			ProfileID:   v.ProfileID,
+			DeviceID:    v.DeviceID,
+			ProfilePIN:  r.Header.Get(profilePINHeader),
+			PINUnlocked: v.PINUnlocked,
+			Country:     countryFromContext(r.Context()),
+			SessionID:   playbackSessionID(r),
+			UserAgent:   r.UserAgent(),
+		},
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getContentKeyUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output.Key)
+}
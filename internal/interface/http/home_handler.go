@@ -0,0 +1,81 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/home"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type HomeHandler struct {
+	getHomeUseCase     *home.GetHomeUseCase
+	getRailPageUseCase *home.GetRailPageUseCase
+	logger             *log.Logger
+}
+
+func NewHomeHandler(getHomeUseCase *home.GetHomeUseCase, getRailPageUseCase *home.GetRailPageUseCase, logger *log.Logger) *HomeHandler {
+	return &HomeHandler{
+		getHomeUseCase:     getHomeUseCase,
+		getRailPageUseCase: getRailPageUseCase,
+		logger:             logger,
+	}
+}
+
+func (h *HomeHandler) GetHome(w http.ResponseWriter, r *http.Request) {
+	requestDTO := home.GetHomeInputDTO{
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Country:    countryFromContext(r.Context()),
+		Locales:    acceptedLocales(r),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getHomeUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *HomeHandler) GetRailPage(w http.ResponseWriter, r *http.Request) {
+	requestDTO := home.GetRailPageInputDTO{
+		RailID:     chi.URLParam(r, "railID"),
+		Token:      r.URL.Query().Get("token"),
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Country:    countryFromContext(r.Context()),
+		Locales:    acceptedLocales(r),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getRailPageUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
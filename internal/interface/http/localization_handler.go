@@ -0,0 +1,158 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	domainlocalization "github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/usecase/localization"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type LocalizationHandler struct {
+	setTranslationUseCase          *localization.SetTranslationUseCase
+	setTranslationThumbnailUseCase *localization.SetTranslationThumbnailUseCase
+	deleteTranslationUseCase       *localization.DeleteTranslationUseCase
+	listTranslationsUseCase        *localization.ListTranslationsUseCase
+	logger                         *log.Logger
+}
+
+func NewLocalizationHandler(
+	setTranslationUseCase *localization.SetTranslationUseCase,
+	setTranslationThumbnailUseCase *localization.SetTranslationThumbnailUseCase,
+	deleteTranslationUseCase *localization.DeleteTranslationUseCase,
+	listTranslationsUseCase *localization.ListTranslationsUseCase,
+	logger *log.Logger,
+) *LocalizationHandler {
+	return &LocalizationHandler{
+		setTranslationUseCase:          setTranslationUseCase,
+		setTranslationThumbnailUseCase: setTranslationThumbnailUseCase,
+		deleteTranslationUseCase:       deleteTranslationUseCase,
+		listTranslationsUseCase:        listTranslationsUseCase,
+		logger:                         logger,
+	}
+}
+
+// translationSubject tells which content or episode the route is about.
+// Content and episode routes share the handler.
+func translationSubject(r *http.Request) (kind, id string) {
+	if episodeID := chi.URLParam(r, "episodeID"); episodeID != "" {
+		return string(domainlocalization.EpisodeSubject), episodeID
+	}
+	return string(domainlocalization.ContentSubject), chi.URLParam(r, "contentID")
+}
+
+func (h *LocalizationHandler) ListTranslations(w http.ResponseWriter, r *http.Request) {
+	var requestDTO localization.ListTranslationsInputDTO
+	requestDTO.SubjectKind, requestDTO.SubjectID = translationSubject(r)
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listTranslationsUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *LocalizationHandler) SetTranslation(w http.ResponseWriter, r *http.Request) {
+	var requestDTO localization.SetTranslationInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.SubjectKind, requestDTO.SubjectID = translationSubject(r)
+	requestDTO.Locale = chi.URLParam(r, "locale")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setTranslationUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *LocalizationHandler) SetTranslationThumbnail(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
+		return
+	}
+
+	_, thumbnailHeader, _ := r.FormFile("thumbnail")
+
+	requestDTO := localization.SetTranslationThumbnailInputDTO{
+		Locale:    chi.URLParam(r, "locale"),
+		Thumbnail: thumbnailHeader,
+	}
+	requestDTO.SubjectKind, requestDTO.SubjectID = translationSubject(r)
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setTranslationThumbnailUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *LocalizationHandler) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	requestDTO := localization.DeleteTranslationInputDTO{
+		Locale: chi.URLParam(r, "locale"),
+	}
+	requestDTO.SubjectKind, requestDTO.SubjectID = translationSubject(r)
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteTranslationUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
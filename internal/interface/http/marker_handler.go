@@ -0,0 +1,150 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type MarkerHandler struct {
+	setMarkersUseCase    *video.SetMarkersUseCase
+	getMarkersUseCase    *video.GetMarkersUseCase
+	deleteMarkersUseCase *video.DeleteMarkersUseCase
+	detectIntrosUseCase  *video.DetectIntrosUseCase
+	logger               *log.Logger
+}
+
+func NewMarkerHandler(
+	setMarkersUseCase *video.SetMarkersUseCase,
+	getMarkersUseCase *video.GetMarkersUseCase,
+	deleteMarkersUseCase *video.DeleteMarkersUseCase,
+	detectIntrosUseCase *video.DetectIntrosUseCase,
+	logger *log.Logger,
+) *MarkerHandler {
+	return &MarkerHandler{
+		setMarkersUseCase:    setMarkersUseCase,
+		getMarkersUseCase:    getMarkersUseCase,
+		deleteMarkersUseCase: deleteMarkersUseCase,
+		detectIntrosUseCase:  detectIntrosUseCase,
+		logger:               logger,
+	}
+}
+
+func (h *MarkerHandler) SetMarkers(w http.ResponseWriter, r *http.Request) {
+	var requestDTO video.SetMarkersInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.VideoID = chi.URLParam(r, "videoID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setMarkersUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *MarkerHandler) GetMarkers(w http.ResponseWriter, r *http.Request) {
+	requestDTO := video.GetMarkersInputDTO{
+		VideoID: chi.URLParam(r, "videoID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getMarkersUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *MarkerHandler) DeleteMarkers(w http.ResponseWriter, r *http.Request) {
+	requestDTO := video.DeleteMarkersInputDTO{
+		VideoID: chi.URLParam(r, "videoID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteMarkersUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *MarkerHandler) DetectIntros(w http.ResponseWriter, r *http.Request) {
+	season, err := strconv.Atoi(chi.URLParam(r, "season"))
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"season must be an integer",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+
+	requestDTO := video.DetectIntrosInputDTO{
+		ContentID: chi.URLParam(r, "contentID"),
+		Season:    season,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.detectIntrosUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
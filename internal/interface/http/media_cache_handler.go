@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/usecase/mediacache"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type MediaCacheHandler struct {
+	getCacheStatsUseCase *mediacache.GetCacheStatsUseCase
+	purgeCacheUseCase    *mediacache.PurgeCacheUseCase
+	logger               *log.Logger
+}
+
+func NewMediaCacheHandler(getCacheStatsUseCase *mediacache.GetCacheStatsUseCase, purgeCacheUseCase *mediacache.PurgeCacheUseCase, logger *log.Logger) *MediaCacheHandler {
+	return &MediaCacheHandler{
+		getCacheStatsUseCase: getCacheStatsUseCase,
+		purgeCacheUseCase:    purgeCacheUseCase,
+		logger:               logger,
+	}
+}
+
+func (h *MediaCacheHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getCacheStatsUseCase.Execute(r.Context())
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *MediaCacheHandler) Purge(w http.ResponseWriter, r *http.Request) {
+	requestDTO := mediacache.PurgeCacheInputDTO{
+		Prefix: r.URL.Query().Get("prefix"),
+	}
+
+	output, err := h.purgeCacheUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,280 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/infra/geo"
+	"github.com/hoyci/fakeflix/internal/usecase/account"
+	"github.com/hoyci/fakeflix/internal/usecase/device"
+	"github.com/hoyci/fakeflix/internal/usecase/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type contextKey string
+
+const (
+	viewerContextKey  contextKey = "viewer"
+	countryContextKey contextKey = "country"
+)
+
+// viewer identifies who is making the request. All fields are empty for
+// anonymous requests.
+type viewer struct {
+	AccountID string
+	ProfileID string
+	DeviceID  string
+	// PINUnlocked is set by signed urls issued once the profile PIN was
+	// checked for the content they point to.
+	PINUnlocked bool
+}
+
+func viewerFromContext(ctx context.Context) viewer {
+	v, _ := ctx.Value(viewerContextKey).(viewer)
+	return v
+}
+
+func countryFromContext(ctx context.Context) string {
+	country, _ := ctx.Value(countryContextKey).(string)
+	return country
+}
+
+// maxRequestIDLength bounds request ids taken from clients so they can be
+// echoed and logged safely.
+const maxRequestIDLength = 128
+
+// RequestID keeps the X-Request-ID a client sent, or assigns a new one,
+// echoes it back and stores it in the context for problem responses.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(httputils.RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(httputils.RequestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(httputils.ContextWithRequestID(r.Context(), requestID)))
+	})
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("-_.:", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveCountry stores the country the request comes from in its context
+// so availability rules can be enforced.
+func ResolveCountry(resolver geo.CountryResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), countryContextKey, resolver.Country(r))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+type AuthMiddleware struct {
+	tokenService           auth.TokenService
+	urlSigner              auth.URLSigner
+	resolveProfileUseCase  *profile.ResolveProfileUseCase
+	resolveDeviceUseCase   *device.ResolveDeviceUseCase
+	authorizeEditorUseCase *account.AuthorizeEditorUseCase
+	logger                 *log.Logger
+}
+
+func NewAuthMiddleware(
+	tokenService auth.TokenService,
+	urlSigner auth.URLSigner,
+	resolveProfileUseCase *profile.ResolveProfileUseCase,
+	resolveDeviceUseCase *device.ResolveDeviceUseCase,
+	authorizeEditorUseCase *account.AuthorizeEditorUseCase,
+	logger *log.Logger,
+) *AuthMiddleware {
+	return &AuthMiddleware{
+		tokenService:           tokenService,
+		urlSigner:              urlSigner,
+		resolveProfileUseCase:  resolveProfileUseCase,
+		resolveDeviceUseCase:   resolveDeviceUseCase,
+		authorizeEditorUseCase: authorizeEditorUseCase,
+		logger:                 logger,
+	}
+}
+
+// Authenticate identifies the account behind a bearer token and the profile
+// it is acting as, taken from the X-Profile-ID header or, when absent, from
+// the token claim. Tokens of devices that were signed out are refused.
+// Signed media URLs identify the viewer and device they were issued to
+// instead. Requests without either pass through anonymously.
+func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" && m.urlSigner.IsSigned(r.URL) {
+			m.authenticateSignedURL(w, r, next)
+			return
+		}
+		if header == "" {
+			if r.Header.Get(profileIDHeader) != "" {
+				httputils.RespondWithError(w, r, fault.New(
+					"selecting a profile requires authentication",
+					fault.WithKind(fault.KindUnauthenticated),
+					fault.WithCode("authentication_required"),
+				))
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok {
+			httputils.RespondWithError(w, r, fault.New(
+				"authorization header must use the Bearer scheme",
+				fault.WithKind(fault.KindUnauthenticated),
+				fault.WithCode("invalid_authorization_header"),
+			))
+			return
+		}
+
+		claims, err := m.tokenService.Parse(token)
+		if err != nil {
+			m.logger.Warn("Rejected access token", "error", err)
+			httputils.RespondWithError(w, r, fault.New(
+				"invalid or expired access token",
+				fault.WithKind(fault.KindUnauthenticated),
+				fault.WithError(err),
+				fault.WithCode("invalid_access_token"),
+			))
+			return
+		}
+
+		v := viewer{AccountID: claims.AccountID, ProfileID: claims.ProfileID, DeviceID: claims.DeviceID}
+		if selected := r.Header.Get(profileIDHeader); selected != "" {
+			v.ProfileID = selected
+		}
+
+		m.serveAs(w, r, next, v)
+	})
+}
+
+func (m *AuthMiddleware) authenticateSignedURL(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	claims, err := m.urlSigner.Verify(r.URL)
+	if err != nil {
+		m.logger.Warn("Rejected signed url", "path", r.URL.Path, "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid or expired signed url",
+			fault.WithKind(fault.KindUnauthenticated),
+			fault.WithError(err),
+			fault.WithCode("invalid_signed_url"),
+		))
+		return
+	}
+
+	if claims.AccountID == "" {
+		next.ServeHTTP(w, r)
+		return
+	}
+	m.serveAs(w, r, next, viewer{
+		AccountID:   claims.AccountID,
+		ProfileID:   claims.ProfileID,
+		DeviceID:    claims.DeviceID,
+		PINUnlocked: claims.PINUnlocked,
+	})
+}
+
+// serveAs checks the device is still signed in and the profile still
+// belongs to the account before handing the request over as the viewer.
+func (m *AuthMiddleware) serveAs(w http.ResponseWriter, r *http.Request, next http.Handler, v viewer) {
+	if v.DeviceID != "" {
+		err := m.resolveDeviceUseCase.Execute(r.Context(), device.ResolveDeviceInputDTO{
+			AccountID: v.AccountID,
+			DeviceID:  v.DeviceID,
+		})
+		if err != nil {
+			httputils.RespondWithError(w, r, err)
+			return
+		}
+	}
+
+	if v.ProfileID != "" {
+		_, err := m.resolveProfileUseCase.Execute(r.Context(), profile.ResolveProfileInputDTO{
+			AccountID: v.AccountID,
+			ProfileID: v.ProfileID,
+		})
+		if err != nil {
+			httputils.RespondWithError(w, r, err)
+			return
+		}
+	}
+
+	next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), viewerContextKey, v)))
+}
+
+func RequireAccount(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if viewerFromContext(r.Context()).AccountID == "" {
+			httputils.RespondWithError(w, r, fault.New(
+				"authentication required",
+				fault.WithKind(fault.KindUnauthenticated),
+				fault.WithCode("authentication_required"),
+			))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func RequireProfile(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := viewerFromContext(r.Context())
+		if v.AccountID == "" {
+			httputils.RespondWithError(w, r, fault.New(
+				"authentication required",
+				fault.WithKind(fault.KindUnauthenticated),
+				fault.WithCode("authentication_required"),
+			))
+			return
+		}
+		if v.ProfileID == "" {
+			httputils.RespondWithError(w, r, fault.New(
+				"a profile must be selected",
+				fault.WithKind(fault.KindValidation),
+				fault.WithCode("profile_required"),
+			))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireEditor only lets through accounts with the editor role.
+func (m *AuthMiddleware) RequireEditor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := viewerFromContext(r.Context())
+		if v.AccountID == "" {
+			httputils.RespondWithError(w, r, fault.New(
+				"authentication required",
+				fault.WithKind(fault.KindUnauthenticated),
+				fault.WithCode("authentication_required"),
+			))
+			return
+		}
+
+		err := m.authorizeEditorUseCase.Execute(r.Context(), account.AuthorizeEditorInputDTO{AccountID: v.AccountID})
+		if err != nil {
+			httputils.RespondWithError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -30,7 +30,7 @@ func (h *MovieHandler) CreateMovie(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info("Received request to create a new movie", "method", r.Method, "path", r.URL.Path)
 
 	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
-		httputils.RespondWithError(w, fault.New("invalid form data", fault.WithError(err)))
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
 		return
 	}
 
@@ -38,17 +38,20 @@ func (h *MovieHandler) CreateMovie(w http.ResponseWriter, r *http.Request) {
 	_, thumbHeader, _ := r.FormFile("thumbnail")
 
 	requestDTO := movie.CreateMovieInputDTO{
-		Title:       r.FormValue("title"),
-		Description: r.FormValue("description"),
-		Video:       videoHeader,
-		Thumbnail:   thumbHeader,
+		Title:        r.FormValue("title"),
+		Description:  r.FormValue("description"),
+		RatingSystem: r.FormValue("rating_system"),
+		Rating:       r.FormValue("rating"),
+		Video:        videoHeader,
+		Thumbnail:    thumbHeader,
 	}
 
 	if err := requestDTO.Validate(); err != nil {
 		h.logger.Warn("Request validation failed", "error", err)
-		httputils.RespondWithError(w, fault.New(
+		httputils.RespondWithError(w, r, fault.New(
 			err.Error(),
 			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
 		))
 		return
 	}
@@ -56,7 +59,7 @@ func (h *MovieHandler) CreateMovie(w http.ResponseWriter, r *http.Request) {
 	output, err := h.createMovieUseCase.Execute(r.Context(), requestDTO)
 	if err != nil {
 		h.logger.Error("Failed to execute create movie use case", "error", err)
-		httputils.RespondWithError(w, err)
+		httputils.RespondWithError(w, r, err)
 		return
 	}
 
@@ -0,0 +1,835 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hoyci/fakeflix/internal/usecase/account"
+	"github.com/hoyci/fakeflix/internal/usecase/availability"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/collection"
+	"github.com/hoyci/fakeflix/internal/usecase/device"
+	"github.com/hoyci/fakeflix/internal/usecase/download"
+	"github.com/hoyci/fakeflix/internal/usecase/extra"
+	"github.com/hoyci/fakeflix/internal/usecase/home"
+	"github.com/hoyci/fakeflix/internal/usecase/localization"
+	"github.com/hoyci/fakeflix/internal/usecase/mediacache"
+	"github.com/hoyci/fakeflix/internal/usecase/movie"
+	"github.com/hoyci/fakeflix/internal/usecase/person"
+	"github.com/hoyci/fakeflix/internal/usecase/playback"
+	"github.com/hoyci/fakeflix/internal/usecase/profile"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/publishing"
+	"github.com/hoyci/fakeflix/internal/usecase/rating"
+	"github.com/hoyci/fakeflix/internal/usecase/recommendation"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/internal/usecase/taxonomy"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+const openAPIVersion = "3.1.0"
+
+// access is the middleware guarding a route.
+type access int
+
+const (
+	// accessPublic routes serve anonymous requests and personalise the
+	// response when a bearer token comes along.
+	accessPublic access = iota
+	accessAccount
+	accessProfile
+	accessEditor
+)
+
+type parameter struct {
+	name        string
+	in          string
+	typ         string
+	description string
+}
+
+type formField struct {
+	name        string
+	file        bool
+	required    bool
+	description string
+}
+
+// operation describes one route as its handler serves it. Requests carry
+// either a JSON body or a multipart form; responses either a JSON output
+// DTO or raw media.
+type operation struct {
+	method      string
+	path        string
+	tag         string
+	summary     string
+	description string
+	access      access
+	// signed routes also accept a signed media URL instead of a token.
+	signed     bool
+	parameters []parameter
+	body       any
+	// optionalBody requests may be sent without a body.
+	optionalBody bool
+	form         []formField
+	status       int
+	output       any
+	// media is the content type of a raw response; ranged ones honour
+	// Range requests through http.ServeContent.
+	media         string
+	ranged        bool
+	sessionHeader bool
+	// faults lists the kinds the route answers with besides the ones every
+	// route can produce.
+	faults []string
+}
+
+var (
+	pageParam          = parameter{name: "page", in: "query", typ: "integer", description: "1-based page number, defaults to 1."}
+	pageSizeParam      = parameter{name: "page_size", in: "query", typ: "integer", description: "Items per page, defaults to 20."}
+	limitParam         = parameter{name: "limit", in: "query", typ: "integer", description: "Maximum number of items."}
+	acceptLanguage     = parameter{name: "Accept-Language", in: "header", typ: "string", description: "Preferred locales for translated titles and descriptions."}
+	profilePINParam    = parameter{name: profilePINHeader, in: "header", typ: "string", description: "PIN of a locked profile."}
+	sessionHeaderParam = parameter{name: playbackSessionHeader, in: "header", typ: "string", description: "Playback session to continue."}
+	sessionQueryParam  = parameter{name: playbackSessionQuery, in: "query", typ: "string", description: "Playback session to continue, for players that cannot send headers."}
+	rangeParam         = parameter{name: "Range", in: "header", typ: "string", description: "Byte range(s) to fetch, e.g. bytes=0-1023 or bytes=-500."}
+	ifRangeParam       = parameter{name: "If-Range", in: "header", typ: "string", description: "Only honour Range when the file is unchanged since this date."}
+)
+
+// playbackParams are read by every route that opens or continues a
+// playback session.
+var playbackParams = []parameter{profilePINParam, sessionHeaderParam, sessionQueryParam}
+
+var streamFaults = []string{
+	fault.KindNotFound, fault.KindForbidden, fault.KindGeoBlocked,
+	fault.KindPaymentRequired, fault.KindLimitExceeded,
+}
+
+var operations = []operation{
+	{
+		method: http.MethodPost, path: "/movies", tag: "Movies",
+		summary: "Upload a movie", access: accessEditor,
+		form: []formField{
+			{name: "title", required: true},
+			{name: "description", required: true},
+			{name: "rating_system", description: "Rating system of rating, e.g. MPAA."},
+			{name: "rating", description: "Maturity rating within rating_system."},
+			{name: "video", file: true, required: true, description: "MP4 video file."},
+			{name: "thumbnail", file: true, description: "Thumbnail image."},
+		},
+		status: http.StatusCreated, output: movie.CreateMovieOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/stream", tag: "Playback",
+		summary: "Stream a video file",
+		description: "Serves the file with byte range support. Opening a playback session records a playback start " +
+			"and serving the last byte on it records its completion, once per session. Videos above the plan resolution are served scaled " +
+			"down to it. Throughput is shaped per client and playback session.",
+		access: accessAccount, signed: true, parameters: append([]parameter{rangeParam, ifRangeParam}, playbackParams...),
+		media: "video/mp4", ranged: true, sessionHeader: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/hls/index.m3u8", tag: "Playback",
+		summary: "Get the HLS playlist of a video",
+		description: "Key and segment URIs are signed for the viewer, device and playback session, so players can " +
+			"fetch them without an Authorization header. Packages come in the resolution of their video only, so " +
+			"videos above the plan resolution are refused.",
+		access: accessAccount, signed: true, parameters: playbackParams,
+		media: "application/vnd.apple.mpegurl", sessionHeader: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/hls/{segment}", tag: "Playback",
+		summary: "Get an HLS segment",
+		description: "Segments are served to the playback session the playlist opened. Trailers and previews need none. " +
+			"Throughput is shaped per client and playback session.",
+		access: accessAccount, signed: true,
+		parameters: append([]parameter{rangeParam, ifRangeParam}, playbackParams...),
+		media:      "video/mp2t", ranged: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/hls/key", tag: "Playback",
+		summary: "Get the content key of an HLS package", access: accessAccount, signed: true, parameters: playbackParams,
+		media: "application/octet-stream", faults: streamFaults,
+	},
+	{
+		method: http.MethodPut, path: "/videos/{videoID}/hls", tag: "Videos",
+		summary: "Package a video for HLS", access: accessEditor,
+		output: video.PackageVideoOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/markers", tag: "Videos",
+		summary: "Get intro, recap and credits markers",
+		output:  video.MarkersOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/videos/{videoID}/markers", tag: "Videos",
+		summary: "Set markers", access: accessEditor,
+		body: video.SetMarkersInputDTO{}, output: video.MarkersOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/videos/{videoID}/markers", tag: "Videos",
+		summary: "Clear markers", access: accessEditor,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/videos/{videoID}/progress", tag: "Progress",
+		summary: "Record playback progress", access: accessProfile,
+		body: progress.RecordProgressInputDTO{}, output: progress.RecordProgressOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPost, path: "/videos/{videoID}/assets", tag: "Videos",
+		summary: "Add a subtitle, audio track or image asset", access: accessEditor,
+		form: []formField{
+			{name: "file", file: true, required: true},
+			{name: "kind", required: true},
+			{name: "language", description: "Language of subtitle and audio assets."},
+			{name: "label"},
+		},
+		status: http.StatusCreated, output: video.AssetOutputDTO{},
+		faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodGet, path: "/videos/{videoID}/assets/{assetID}", tag: "Videos",
+		summary: "Download an asset", access: accessAccount, signed: true,
+		parameters: []parameter{rangeParam, ifRangeParam, profilePINParam},
+		media:      "application/octet-stream", ranged: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodDelete, path: "/videos/{videoID}/assets/{assetID}", tag: "Videos",
+		summary: "Delete an asset", access: accessEditor,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPost, path: "/people", tag: "People",
+		summary: "Create a person", access: accessEditor,
+		form: []formField{
+			{name: "name", required: true},
+			{name: "bio"},
+			{name: "photo", file: true},
+		},
+		status: http.StatusCreated, output: person.CreatePersonOutputDTO{},
+	},
+	{
+		method: http.MethodPost, path: "/people/{personID}/credits", tag: "People",
+		summary: "Credit a person on a content", access: accessEditor,
+		body: person.AddCreditInputDTO{}, status: http.StatusCreated, output: person.AddCreditOutputDTO{},
+		faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodGet, path: "/people/{personID}", tag: "People",
+		summary: "Get a person and their filmography", parameters: []parameter{acceptLanguage, profilePINParam},
+		output: person.GetPersonOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/contents", tag: "Catalog",
+		summary: "List contents",
+		parameters: []parameter{
+			{name: "genre", in: "query", typ: "string", description: "Only list contents of this genre."},
+			pageParam, pageSizeParam, acceptLanguage, profilePINParam,
+		},
+		output: catalog.ListContentsOutputDTO{}, faults: []string{fault.KindForbidden},
+	},
+	{
+		method: http.MethodPut, path: "/contents/{contentID}/taxonomy", tag: "Catalog",
+		summary: "Set genres and tags", access: accessEditor,
+		body: taxonomy.SetTaxonomyInputDTO{}, output: taxonomy.TaxonomyOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/contents/{contentID}/similar", tag: "Recommendations",
+		summary: "List contents similar to a content", parameters: []parameter{limitParam, acceptLanguage, profilePINParam},
+		output: recommendation.ListSimilarOutputDTO{}, faults: []string{fault.KindNotFound, fault.KindForbidden},
+	},
+	{
+		method: http.MethodGet, path: "/contents/{contentID}/extras", tag: "Extras",
+		summary: "List trailers and previews of a content",
+		output:  extra.ListExtrasOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPost, path: "/contents/{contentID}/trailers", tag: "Extras",
+		summary: "Upload a trailer", access: accessEditor,
+		form: []formField{
+			{name: "video", file: true, required: true, description: "MP4 video file."},
+			{name: "title"},
+		},
+		status: http.StatusCreated, output: extra.ExtraOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/contents/{contentID}/preview", tag: "Extras",
+		summary: "Generate a preview clip", access: accessEditor,
+		body: extra.GeneratePreviewInputDTO{}, optionalBody: true, output: extra.ExtraOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/contents/{contentID}/extras/{extraID}", tag: "Extras",
+		summary: "Delete an extra", access: accessEditor,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/contents/{contentID}/playback", tag: "Playback",
+		summary: "Get the playback document of a movie", access: accessAccount,
+		description: "Opens a playback session and returns signed stream URLs, tracks, markers and where to resume.",
+		parameters:  append([]parameter{acceptLanguage}, playbackParams...),
+		output:      video.GetPlaybackInfoOutputDTO{}, sessionHeader: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodGet, path: "/contents/{contentID}/episodes/{episodeID}/playback", tag: "Playback",
+		summary: "Get the playback document of an episode", access: accessAccount,
+		parameters: append([]parameter{acceptLanguage}, playbackParams...),
+		output:     video.GetPlaybackInfoOutputDTO{}, sessionHeader: true, faults: streamFaults,
+	},
+	{
+		method: http.MethodPost, path: "/contents/{contentID}/seasons/{season}/detect-intros", tag: "Videos",
+		summary: "Detect intros across the episodes of a season", access: accessEditor,
+		output: video.DetectIntrosOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/contents/{contentID}/rating", tag: "Ratings",
+		summary: "Rate a content", access: accessProfile,
+		body: rating.RateContentInputDTO{}, output: rating.RateContentOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/contents/{contentID}/rating", tag: "Ratings",
+		summary: "Clear a rating", access: accessProfile,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/contents/{contentID}/status", tag: "Publishing",
+		summary: "Change the publishing status", access: accessEditor,
+		body: publishing.ChangeStatusInputDTO{}, output: publishing.StatusOutputDTO{},
+		faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodPut, path: "/contents/{contentID}/availability", tag: "Publishing",
+		summary: "Set availability windows and regions", access: accessEditor,
+		body: availability.SetAvailabilityInputDTO{}, output: availability.AvailabilityOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/contents/{contentID}/availability", tag: "Publishing",
+		summary: "Clear availability restrictions", access: accessEditor,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/trending", tag: "Recommendations",
+		summary: "List trending contents",
+		parameters: []parameter{
+			{name: "window", in: "query", typ: "string", description: "DAY or WEEK, defaults to DAY."},
+			limitParam, acceptLanguage, profilePINParam,
+		},
+		output: trending.ListTrendingOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/trending/top-10", tag: "Recommendations",
+		summary: "List today's top 10", parameters: []parameter{acceptLanguage, profilePINParam},
+		output: trending.ListTrendingOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/collections", tag: "Collections",
+		summary: "List collections", access: accessEditor, parameters: []parameter{pageParam, pageSizeParam},
+		output: collection.ListCollectionsOutputDTO{},
+	},
+	{
+		method: http.MethodPost, path: "/collections", tag: "Collections",
+		summary: "Create a collection", access: accessEditor,
+		body: collection.CreateCollectionInputDTO{}, status: http.StatusCreated, output: collection.CollectionOutputDTO{},
+		faults: []string{fault.KindConflict},
+	},
+	{
+		method: http.MethodGet, path: "/collections/{slug}", tag: "Collections",
+		summary: "Get a collection with its contents", parameters: []parameter{acceptLanguage, profilePINParam},
+		output: collection.GetCollectionOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/collections/{slug}", tag: "Collections",
+		summary: "Update a collection", access: accessEditor,
+		body: collection.UpdateCollectionInputDTO{}, output: collection.CollectionOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/collections/{slug}/artwork", tag: "Collections",
+		summary: "Set the artwork of a collection", access: accessEditor,
+		form:   []formField{{name: "artwork", file: true, required: true}},
+		output: collection.CollectionOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/collections/{slug}", tag: "Collections",
+		summary: "Delete a collection", access: accessEditor,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/plans", tag: "Subscriptions",
+		summary: "List plans", output: subscription.ListPlansOutputDTO{},
+	},
+	{
+		method: http.MethodPost, path: "/accounts", tag: "Accounts",
+		summary: "Register an account",
+		body:    account.RegisterAccountInputDTO{}, status: http.StatusCreated, output: account.RegisterAccountOutputDTO{},
+		faults: []string{fault.KindConflict},
+	},
+	{
+		method: http.MethodPost, path: "/auth/login", tag: "Accounts",
+		summary: "Log in and register the device",
+		body:    account.LoginInputDTO{}, output: account.TokenOutputDTO{},
+		faults: []string{fault.KindLimitExceeded},
+	},
+	{
+		method: http.MethodPost, path: "/auth/refresh", tag: "Accounts",
+		summary: "Exchange a refresh token for a new access token",
+		body:    account.RefreshTokenInputDTO{}, output: account.TokenOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/me/profiles", tag: "Profiles",
+		summary: "List profiles", access: accessAccount, output: profile.ListProfilesOutputDTO{},
+	},
+	{
+		method: http.MethodPost, path: "/me/profiles", tag: "Profiles",
+		summary: "Create a profile", access: accessAccount,
+		body: profile.CreateProfileInputDTO{}, status: http.StatusCreated, output: profile.ProfileOutputDTO{},
+		faults: []string{fault.KindConflict},
+	},
+	{
+		method: http.MethodDelete, path: "/me/profiles/{profileID}", tag: "Profiles",
+		summary: "Delete a profile", access: accessAccount,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPost, path: "/me/profiles/{profileID}/select", tag: "Profiles",
+		summary: "Switch to a profile", access: accessAccount,
+		output: account.TokenOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/me/subscription", tag: "Subscriptions",
+		summary: "Get the subscription", access: accessAccount,
+		output: subscription.SubscriptionOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodPut, path: "/me/subscription", tag: "Subscriptions",
+		summary: "Subscribe or change plan", access: accessAccount,
+		body: subscription.SubscribeInputDTO{}, output: subscription.SubscriptionOutputDTO{},
+		faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/me/subscription", tag: "Subscriptions",
+		summary: "Cancel at the end of the period", access: accessAccount,
+		output: subscription.SubscriptionOutputDTO{}, faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodGet, path: "/me/sessions", tag: "Sessions",
+		summary: "List active playback sessions", access: accessAccount,
+		output: playback.ListSessionsOutputDTO{},
+	},
+	{
+		method: http.MethodPut, path: "/me/sessions/{sessionID}/heartbeat", tag: "Sessions",
+		summary: "Keep a playback session alive", access: accessAccount,
+		body: playback.HeartbeatSessionInputDTO{}, optionalBody: true,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodDelete, path: "/me/sessions/{sessionID}", tag: "Sessions",
+		summary: "End a playback session", access: accessAccount,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/me/devices", tag: "Devices",
+		summary: "List signed in devices", access: accessAccount, output: device.ListDevicesOutputDTO{},
+	},
+	{
+		method: http.MethodDelete, path: "/me/devices/{deviceID}", tag: "Devices",
+		summary: "Sign a device out", access: accessAccount,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/me/downloads", tag: "Downloads",
+		summary: "List download licenses", access: accessAccount, output: download.ListLicensesOutputDTO{},
+	},
+	{
+		method: http.MethodPost, path: "/me/downloads", tag: "Downloads",
+		summary: "Issue a download license", access: accessAccount,
+		parameters: []parameter{profilePINParam},
+		body:       download.IssueLicenseInputDTO{}, status: http.StatusCreated, output: download.LicenseOutputDTO{},
+		faults: streamFaults,
+	},
+	{
+		method: http.MethodPut, path: "/me/downloads/{licenseID}/renew", tag: "Downloads",
+		summary: "Renew a download license", access: accessAccount,
+		output: download.LicenseOutputDTO{},
+		faults: []string{fault.KindNotFound, fault.KindConflict, fault.KindPaymentRequired, fault.KindLimitExceeded},
+	},
+	{
+		method: http.MethodDelete, path: "/me/downloads/{licenseID}", tag: "Downloads",
+		summary: "Return a download license", access: accessAccount,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/me/downloads/{licenseID}/file", tag: "Downloads",
+		summary: "Download the licensed file", access: accessAccount, signed: true,
+		parameters: []parameter{rangeParam, ifRangeParam},
+		media:      "video/mp4", ranged: true, faults: []string{fault.KindNotFound, fault.KindConflict},
+	},
+	{
+		method: http.MethodGet, path: "/me/continue-watching", tag: "Progress",
+		summary: "List contents to continue watching", access: accessProfile,
+		parameters: []parameter{limitParam, acceptLanguage},
+		output:     progress.ListContinueWatchingOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/me/list", tag: "My list",
+		summary: "List the profile's list", access: accessProfile,
+		parameters: []parameter{pageParam, pageSizeParam, acceptLanguage},
+		output:     watchlist.ListMyListOutputDTO{},
+	},
+	{
+		method: http.MethodPut, path: "/me/list/{contentID}", tag: "My list",
+		summary: "Add a content to the list", access: accessProfile,
+		body: watchlist.AddToListInputDTO{}, optionalBody: true,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodDelete, path: "/me/list/{contentID}", tag: "My list",
+		summary: "Remove a content from the list", access: accessProfile,
+		status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/me/recommendations", tag: "Recommendations",
+		summary: "List recommendations for the profile", access: accessProfile,
+		parameters: []parameter{limitParam, acceptLanguage, profilePINParam},
+		output:     recommendation.ListRecommendationsOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/home", tag: "Home",
+		summary: "Get the home page", access: accessProfile,
+		parameters: []parameter{acceptLanguage, profilePINParam},
+		output:     home.GetHomeOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/home/rails/{railID}", tag: "Home",
+		summary: "Get the next page of a home rail", access: accessProfile,
+		parameters: []parameter{
+			{name: "token", in: "query", typ: "string", description: "Continuation token from the previous page."},
+			acceptLanguage, profilePINParam,
+		},
+		output: home.RailOutputDTO{}, faults: []string{fault.KindNotFound},
+	},
+	{
+		method: http.MethodGet, path: "/admin/media-cache", tag: "Admin",
+		summary: "Get media cache statistics", access: accessEditor,
+		output: mediacache.GetCacheStatsOutputDTO{},
+	},
+	{
+		method: http.MethodDelete, path: "/admin/media-cache", tag: "Admin",
+		summary: "Purge the media cache", access: accessEditor,
+		parameters: []parameter{{name: "prefix", in: "query", typ: "string", description: "Only purge files under this path."}},
+		output:     mediacache.PurgeCacheOutputDTO{},
+	},
+	{
+		method: http.MethodGet, path: "/openapi.json", tag: "Meta",
+		summary: "Get this document", output: map[string]any{},
+	},
+}
+
+// translationOperations are served for both contents and episodes.
+func translationOperations(subject, tag string) []operation {
+	base := "/" + subject + "s/{" + subject + "ID}/translations"
+	return []operation{
+		{
+			method: http.MethodGet, path: base, tag: tag,
+			summary: "List translations of a " + subject, access: accessEditor,
+			output: localization.ListTranslationsOutputDTO{}, faults: []string{fault.KindNotFound},
+		},
+		{
+			method: http.MethodPut, path: base + "/{locale}", tag: tag,
+			summary: "Set the translation of a " + subject, access: accessEditor,
+			body: localization.SetTranslationInputDTO{}, output: localization.TranslationOutputDTO{},
+			faults: []string{fault.KindNotFound},
+		},
+		{
+			method: http.MethodPut, path: base + "/{locale}/thumbnail", tag: tag,
+			summary: "Set the thumbnail of a translation", access: accessEditor,
+			form:   []formField{{name: "thumbnail", file: true, required: true}},
+			output: localization.TranslationOutputDTO{}, faults: []string{fault.KindNotFound},
+		},
+		{
+			method: http.MethodDelete, path: base + "/{locale}", tag: tag,
+			summary: "Delete the translation of a " + subject, access: accessEditor,
+			status: http.StatusNoContent, faults: []string{fault.KindNotFound},
+		},
+	}
+}
+
+var requestIDResponseHeader = map[string]any{
+	"description": "Id of the request, also reported as request_id in problem documents.",
+	"schema":      map[string]any{"type": "string"},
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// integerPathParams are path parameters handlers parse as integers.
+var integerPathParams = map[string]bool{"season": true}
+
+// BuildOpenAPIDocument describes every route of the API as an OpenAPI 3.1
+// document.
+func BuildOpenAPIDocument(title string) map[string]any {
+	schemas := newSchemaRegistry()
+	all := append(append(append([]operation{}, operations...),
+		translationOperations("content", "Localization")...),
+		translationOperations("episode", "Localization")...)
+
+	paths := map[string]any{}
+	for _, op := range all {
+		item, ok := paths[op.path].(map[string]any)
+		if !ok {
+			item = map[string]any{}
+			paths[op.path] = item
+		}
+		item[strings.ToLower(op.method)] = op.document(schemas)
+	}
+
+	problem := schemas.ref(httputils.Problem{})
+	errorResponses := map[string]any{}
+	for _, kind := range faultKinds {
+		status := httputils.StatusCodeForKind(kind)
+		errorResponses[kind] = map[string]any{
+			"description": fmt.Sprintf("%s (%d %s). Unless a more specific one applies, code is %s.",
+				kind, status, http.StatusText(status), httputils.CodeForKind(kind)),
+			"headers": map[string]any{httputils.RequestIDHeader: requestIDResponseHeader},
+			"content": map[string]any{
+				httputils.ProblemContentType: map[string]any{"schema": problem},
+			},
+		}
+	}
+	problemSchema := schemas.components["httputils.Problem"].(map[string]any)
+	problemSchema["description"] = "RFC 9457 problem document. type is " + httputils.ProblemTypePrefix +
+		"<code> unless stated otherwise; errors maps invalid request fields, nested ones joined by dots, to what is wrong with them."
+	problemSchema["required"] = []string{"type", "title", "status", "code"}
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":   title,
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas":   schemas.components,
+			"responses": errorResponses,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+					"description":  "Access token from /auth/login, /auth/refresh or a profile switch. X-Profile-ID selects another profile of the account.",
+				},
+				"signedURL": map[string]any{
+					"type":        "apiKey",
+					"in":          "query",
+					"name":        "sig",
+					"description": "Signature of a media URL handed out by the API, valid until its exp query parameter.",
+				},
+			},
+		},
+	}
+}
+
+// faultKinds are the kinds errors are answered with, in the order their
+// responses are listed.
+var faultKinds = []string{
+	fault.KindValidation,
+	fault.KindUnauthenticated,
+	fault.KindPaymentRequired,
+	fault.KindForbidden,
+	fault.KindNotFound,
+	fault.KindConflict,
+	fault.KindLimitExceeded,
+	fault.KindGeoBlocked,
+	fault.KindUnexpected,
+}
+
+func (op operation) document(schemas *schemaRegistry) map[string]any {
+	doc := map[string]any{
+		"operationId": operationID(op.method, op.path),
+		"tags":        []string{op.tag},
+		"summary":     op.summary,
+		"security":    op.security(),
+	}
+	if op.description != "" {
+		doc["description"] = op.description
+	}
+
+	params := []any{
+		map[string]any{
+			"name": profileIDHeader, "in": "header", "required": false,
+			"description": "Act as another profile of the authenticated account.",
+			"schema":      map[string]any{"type": "string", "format": "uuid"},
+		},
+		map[string]any{
+			"name": httputils.RequestIDHeader, "in": "header", "required": false,
+			"description": "Id to trace the request by, generated when absent or malformed.",
+			"schema":      map[string]any{"type": "string", "maxLength": maxRequestIDLength, "pattern": "^[A-Za-z0-9._:-]+$"},
+		},
+	}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(op.path, -1) {
+		typ := "string"
+		if integerPathParams[match[1]] {
+			typ = "integer"
+		}
+		params = append(params, map[string]any{
+			"name": match[1], "in": "path", "required": true,
+			"schema": map[string]any{"type": typ},
+		})
+	}
+	for _, p := range op.parameters {
+		params = append(params, map[string]any{
+			"name": p.name, "in": p.in, "required": false, "description": p.description,
+			"schema": map[string]any{"type": p.typ},
+		})
+	}
+	doc["parameters"] = params
+
+	switch {
+	case op.body != nil:
+		doc["requestBody"] = map[string]any{
+			"required": !op.optionalBody,
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": schemas.ref(op.body)},
+			},
+		}
+	case op.form != nil:
+		doc["requestBody"] = formBody(op.form)
+	}
+
+	doc["responses"] = op.responses(schemas)
+	return doc
+}
+
+func (op operation) security() []any {
+	bearer := map[string]any{"bearerAuth": []string{}}
+	var requirements []any
+	if op.access == accessPublic {
+		requirements = append(requirements, map[string]any{})
+	}
+	requirements = append(requirements, bearer)
+	if op.signed {
+		requirements = append(requirements, map[string]any{"signedURL": []string{}})
+	}
+	return requirements
+}
+
+func formBody(fields []formField) map[string]any {
+	properties := map[string]any{}
+	encoding := map[string]any{}
+	required := []string{}
+	for _, f := range fields {
+		schema := map[string]any{"type": "string"}
+		if f.file {
+			schema["contentMediaType"] = "application/octet-stream"
+			encoding[f.name] = map[string]any{"contentType": "application/octet-stream"}
+		}
+		if f.description != "" {
+			schema["description"] = f.description
+		}
+		properties[f.name] = schema
+		if f.required {
+			required = append(required, f.name)
+		}
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties, "required": required}
+	media := map[string]any{"schema": schema}
+	if len(encoding) > 0 {
+		media["encoding"] = encoding
+	}
+	return map[string]any{
+		"required":    true,
+		"description": "Multipart form of at most 10 MB kept in memory; larger files spill to disk.",
+		"content":     map[string]any{"multipart/form-data": media},
+	}
+}
+
+func (op operation) responses(schemas *schemaRegistry) map[string]any {
+	status := op.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := map[string]any{"description": http.StatusText(status)}
+	headers := map[string]any{httputils.RequestIDHeader: requestIDResponseHeader}
+	if op.sessionHeader {
+		headers[playbackSessionHeader] = map[string]any{
+			"description": "Playback session the request was counted against.",
+			"schema":      map[string]any{"type": "string"},
+		}
+	}
+	switch {
+	case op.media != "":
+		success["content"] = map[string]any{
+			op.media: map[string]any{"schema": map[string]any{"type": "string", "contentMediaType": op.media}},
+		}
+	case op.output != nil:
+		success["content"] = map[string]any{
+			"application/json": map[string]any{"schema": schemas.ref(op.output)},
+		}
+	}
+
+	responses := map[string]any{}
+	if op.ranged {
+		headers["Accept-Ranges"] = map[string]any{"schema": map[string]any{"type": "string", "const": "bytes"}}
+		success["description"] = "The whole file, when no Range was asked for."
+		responses[strconv.Itoa(http.StatusPartialContent)] = map[string]any{
+			"description": "The requested range. Several ranges come back as multipart/byteranges.",
+			"headers": map[string]any{
+				httputils.RequestIDHeader: requestIDResponseHeader,
+				"Content-Range": map[string]any{
+					"description": "Range served, as bytes first-last/size.",
+					"schema":      map[string]any{"type": "string"},
+				},
+			},
+			"content": success["content"],
+		}
+		responses[strconv.Itoa(http.StatusRequestedRangeNotSatisfiable)] = map[string]any{
+			"description": "No requested range overlaps the file.",
+			"headers": map[string]any{
+				httputils.RequestIDHeader: requestIDResponseHeader,
+				"Content-Range": map[string]any{
+					"description": "Size of the file, as bytes */size.",
+					"schema":      map[string]any{"type": "string"},
+				},
+			},
+		}
+	}
+	if len(headers) > 0 {
+		success["headers"] = headers
+	}
+	responses[strconv.Itoa(status)] = success
+
+	kinds := append([]string{fault.KindValidation, fault.KindUnauthenticated, fault.KindUnexpected}, op.faults...)
+	if op.access == accessEditor {
+		kinds = append(kinds, fault.KindForbidden)
+	}
+	for _, kind := range kinds {
+		responses[strconv.Itoa(httputils.StatusCodeForKind(kind))] = map[string]any{
+			"$ref": "#/components/responses/" + kind,
+		}
+	}
+	return responses
+}
+
+// operationID derives a stable id such as getVideosVideoIDStream.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '.'
+	}) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
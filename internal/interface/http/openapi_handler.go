@@ -0,0 +1,21 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type OpenAPIHandler struct {
+	document map[string]any
+}
+
+func NewOpenAPIHandler(title string) *OpenAPIHandler {
+	return &OpenAPIHandler{
+		document: BuildOpenAPIDocument(title),
+	}
+}
+
+func (h *OpenAPIHandler) GetDocument(w http.ResponseWriter, r *http.Request) {
+	httputils.RespondWithJSON(w, http.StatusOK, h.document)
+}
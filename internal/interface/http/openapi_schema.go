@@ -0,0 +1,118 @@
+package http
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// schemaRegistry turns DTO types into JSON Schemas and collects the named
+// ones as components. Components are keyed by package and type name, e.g.
+// "movie.CreateMovieOutputDTO".
+//
+// Only fields with a json tag are described: untagged DTO fields are filled
+// by handlers from the path, headers or the authenticated viewer and are
+// never read from or written to a body. No property is marked required as
+// input DTOs enforce that in Validate, which answers with a 422.
+type schemaRegistry struct {
+	components map[string]any
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{components: map[string]any{}}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// ref returns a reference to the component describing v's type, registering
+// it on first use.
+func (s *schemaRegistry) ref(v any) map[string]any {
+	return s.schema(reflect.TypeOf(v))
+}
+
+func (s *schemaRegistry) schema(t reflect.Type) map[string]any {
+	if t == timeType {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		inner := s.schema(t.Elem())
+		if typ, ok := inner["type"].(string); ok {
+			inner["type"] = []string{typ, "null"}
+		}
+		return inner
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]any{"type": "array", "items": s.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": s.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		name := componentName(t)
+		if _, ok := s.components[name]; !ok {
+			// Reserve the name first so self-referencing types terminate.
+			s.components[name] = nil
+			s.components[name] = s.object(t)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	default:
+		return map[string]any{}
+	}
+}
+
+func (s *schemaRegistry) object(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	s.collectFields(t, properties)
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// collectFields follows encoding/json in promoting the fields of embedded
+// structs that have no tag of their own.
+func (s *schemaRegistry) collectFields(t reflect.Type, properties map[string]any) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, tagged := field.Tag.Lookup("json")
+
+		if field.Anonymous && !tagged {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				s.collectFields(embedded, properties)
+			}
+			continue
+		}
+		if !field.IsExported() || !tagged || tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = s.schema(field.Type)
+	}
+}
+
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return pkg + "." + t.Name()
+}
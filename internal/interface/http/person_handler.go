@@ -0,0 +1,125 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/person"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type PersonHandler struct {
+	createPersonUseCase *person.CreatePersonUseCase
+	addCreditUseCase    *person.AddCreditUseCase
+	getPersonUseCase    *person.GetPersonUseCase
+	logger              *log.Logger
+}
+
+func NewPersonHandler(
+	createPersonUseCase *person.CreatePersonUseCase,
+	addCreditUseCase *person.AddCreditUseCase,
+	getPersonUseCase *person.GetPersonUseCase,
+	logger *log.Logger,
+) *PersonHandler {
+	return &PersonHandler{
+		createPersonUseCase: createPersonUseCase,
+		addCreditUseCase:    addCreditUseCase,
+		getPersonUseCase:    getPersonUseCase,
+		logger:              logger,
+	}
+}
+
+func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("Received request to create a new person", "method", r.Method, "path", r.URL.Path)
+
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max memory
+		httputils.RespondWithError(w, r, fault.New("invalid form data", fault.WithError(err)))
+		return
+	}
+
+	_, photoHeader, _ := r.FormFile("photo")
+
+	requestDTO := person.CreatePersonInputDTO{
+		Name:  r.FormValue("name"),
+		Bio:   r.FormValue("bio"),
+		Photo: photoHeader,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.createPersonUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		h.logger.Error("Failed to execute create person use case", "error", err)
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Person created successfully", "personID", output.ID)
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *PersonHandler) AddCredit(w http.ResponseWriter, r *http.Request) {
+	var requestDTO person.AddCreditInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.PersonID = chi.URLParam(r, "personID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.addCreditUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *PersonHandler) GetPerson(w http.ResponseWriter, r *http.Request) {
+	requestDTO := person.GetPersonInputDTO{
+		PersonID: chi.URLParam(r, "personID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getPersonUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
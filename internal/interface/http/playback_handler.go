@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type PlaybackHandler struct {
+	getPlaybackInfoUseCase *video.GetPlaybackInfoUseCase
+	logger                 *log.Logger
+}
+
+func NewPlaybackHandler(getPlaybackInfoUseCase *video.GetPlaybackInfoUseCase, logger *log.Logger) *PlaybackHandler {
+	return &PlaybackHandler{
+		getPlaybackInfoUseCase: getPlaybackInfoUseCase,
+		logger:                 logger,
+	}
+}
+
+func (h *PlaybackHandler) GetPlaybackInfo(w http.ResponseWriter, r *http.Request) {
+	v := viewerFromContext(r.Context())
+	requestDTO := video.GetPlaybackInfoInputDTO{
+		ContentID:   chi.URLParam(r, "contentID"),
+		EpisodeID:   chi.URLParam(r, "episodeID"),
+		AccountID:   v.AccountID,
+		ProfileID:   v.ProfileID,
+		DeviceID:    v.DeviceID,
+		ProfilePIN:  r.Header.Get(profilePINHeader),
+		PINUnlocked: v.PINUnlocked,
+		Country:     countryFromContext(r.Context()),
+		SessionID:   playbackSessionID(r),
+		UserAgent:   r.UserAgent(),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getPlaybackInfoUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	if output.SessionID != "" {
+		w.Header().Set(playbackSessionHeader, output.SessionID)
+	}
+	// The document holds URLs signed for this viewer.
+	w.Header().Set("Cache-Control", "no-store")
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
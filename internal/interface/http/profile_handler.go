@@ -0,0 +1,102 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type ProfileHandler struct {
+	createProfileUseCase *profile.CreateProfileUseCase
+	listProfilesUseCase  *profile.ListProfilesUseCase
+	deleteProfileUseCase *profile.DeleteProfileUseCase
+	logger               *log.Logger
+}
+
+func NewProfileHandler(
+	createProfileUseCase *profile.CreateProfileUseCase,
+	listProfilesUseCase *profile.ListProfilesUseCase,
+	deleteProfileUseCase *profile.DeleteProfileUseCase,
+	logger *log.Logger,
+) *ProfileHandler {
+	return &ProfileHandler{
+		createProfileUseCase: createProfileUseCase,
+		listProfilesUseCase:  listProfilesUseCase,
+		deleteProfileUseCase: deleteProfileUseCase,
+		logger:               logger,
+	}
+}
+
+func (h *ProfileHandler) CreateProfile(w http.ResponseWriter, r *http.Request) {
+	var requestDTO profile.CreateProfileInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.AccountID = viewerFromContext(r.Context()).AccountID
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.createProfileUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		h.logger.Error("Failed to execute create profile use case", "error", err)
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Profile created successfully", "profileID", output.ID)
+	httputils.RespondWithJSON(w, http.StatusCreated, output)
+}
+
+func (h *ProfileHandler) ListProfiles(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listProfilesUseCase.Execute(r.Context(), profile.ListProfilesInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+	})
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *ProfileHandler) DeleteProfile(w http.ResponseWriter, r *http.Request) {
+	requestDTO := profile.DeleteProfileInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		ProfileID: chi.URLParam(r, "profileID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.deleteProfileUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type ProgressHandler struct {
+	recordProgressUseCase       *progress.RecordProgressUseCase
+	listContinueWatchingUseCase *progress.ListContinueWatchingUseCase
+	logger                      *log.Logger
+}
+
+func NewProgressHandler(
+	recordProgressUseCase *progress.RecordProgressUseCase,
+	listContinueWatchingUseCase *progress.ListContinueWatchingUseCase,
+	logger *log.Logger,
+) *ProgressHandler {
+	return &ProgressHandler{
+		recordProgressUseCase:       recordProgressUseCase,
+		listContinueWatchingUseCase: listContinueWatchingUseCase,
+		logger:                      logger,
+	}
+}
+
+func (h *ProgressHandler) RecordProgress(w http.ResponseWriter, r *http.Request) {
+	var requestDTO progress.RecordProgressInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ProfileID = viewerFromContext(r.Context()).ProfileID
+	requestDTO.VideoID = chi.URLParam(r, "videoID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.recordProgressUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *ProgressHandler) ListContinueWatching(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", defaultPageSize)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := progress.ListContinueWatchingInputDTO{
+		ProfileID: viewerFromContext(r.Context()).ProfileID,
+		Locales:   acceptedLocales(r),
+		Limit:     limit,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listContinueWatchingUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
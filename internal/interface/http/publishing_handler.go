@@ -0,0 +1,55 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/publishing"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type PublishingHandler struct {
+	changeStatusUseCase *publishing.ChangeStatusUseCase
+	logger              *log.Logger
+}
+
+func NewPublishingHandler(changeStatusUseCase *publishing.ChangeStatusUseCase, logger *log.Logger) *PublishingHandler {
+	return &PublishingHandler{
+		changeStatusUseCase: changeStatusUseCase,
+		logger:              logger,
+	}
+}
+
+func (h *PublishingHandler) ChangeStatus(w http.ResponseWriter, r *http.Request) {
+	var requestDTO publishing.ChangeStatusInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.changeStatusUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
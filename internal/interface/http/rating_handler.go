@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/rating"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type RatingHandler struct {
+	rateContentUseCase *rating.RateContentUseCase
+	clearRatingUseCase *rating.ClearRatingUseCase
+	logger             *log.Logger
+}
+
+func NewRatingHandler(
+	rateContentUseCase *rating.RateContentUseCase,
+	clearRatingUseCase *rating.ClearRatingUseCase,
+	logger *log.Logger,
+) *RatingHandler {
+	return &RatingHandler{
+		rateContentUseCase: rateContentUseCase,
+		clearRatingUseCase: clearRatingUseCase,
+		logger:             logger,
+	}
+}
+
+func (h *RatingHandler) RateContent(w http.ResponseWriter, r *http.Request) {
+	var requestDTO rating.RateContentInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ProfileID = viewerFromContext(r.Context()).ProfileID
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.rateContentUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *RatingHandler) ClearRating(w http.ResponseWriter, r *http.Request) {
+	requestDTO := rating.ClearRatingInputDTO{
+		ProfileID: viewerFromContext(r.Context()).ProfileID,
+		ContentID: chi.URLParam(r, "contentID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.clearRatingUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/recommendation"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+const defaultSimilarLimit = 10
+
+type RecommendationHandler struct {
+	listRecommendationsUseCase *recommendation.ListRecommendationsUseCase
+	listSimilarUseCase         *recommendation.ListSimilarUseCase
+	logger                     *log.Logger
+}
+
+func NewRecommendationHandler(
+	listRecommendationsUseCase *recommendation.ListRecommendationsUseCase,
+	listSimilarUseCase *recommendation.ListSimilarUseCase,
+	logger *log.Logger,
+) *RecommendationHandler {
+	return &RecommendationHandler{
+		listRecommendationsUseCase: listRecommendationsUseCase,
+		listSimilarUseCase:         listSimilarUseCase,
+		logger:                     logger,
+	}
+}
+
+func (h *RecommendationHandler) ListRecommendations(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", defaultPageSize)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := recommendation.ListRecommendationsInputDTO{
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Locales:    acceptedLocales(r),
+		Limit:      limit,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listRecommendationsUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *RecommendationHandler) ListSimilar(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", defaultSimilarLimit)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := recommendation.ListSimilarInputDTO{
+		ContentID:  chi.URLParam(r, "contentID"),
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Locales:    acceptedLocales(r),
+		Limit:      limit,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listSimilarUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
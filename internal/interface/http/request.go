@@ -0,0 +1,102 @@
+package http
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+)
+
+const (
+	profileIDHeader  = "X-Profile-ID"
+	profilePINHeader = "X-Profile-PIN"
+
+	// Players send the playback session back on every stream request,
+	// either as a header or, for plain <video> sources, as a query param.
+	playbackSessionHeader = "X-Playback-Session"
+	playbackSessionQuery  = "session"
+
+	defaultPageSize = 20
+)
+
+// clientIP returns the address of the peer the request came from.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func queryInt(r *http.Request, key string, fallback int) (int, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return fallback, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, validation.Errors{key: errors.New("must be an integer")}
+	}
+	return value, nil
+}
+
+func pagination(r *http.Request) (page, pageSize int, err error) {
+	if page, err = queryInt(r, "page", 1); err != nil {
+		return 0, 0, err
+	}
+	if pageSize, err = queryInt(r, "page_size", defaultPageSize); err != nil {
+		return 0, 0, err
+	}
+	return page, pageSize, nil
+}
+
+// acceptedLocales reads the Accept-Language header into locales ordered by
+// preference. Wildcards, refused (q=0) and malformed tags are dropped.
+func acceptedLocales(r *http.Request) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var accepted []weighted
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, err := localization.NormalizeLocale(tag)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		accepted = append(accepted, weighted{locale: locale, q: q})
+	}
+
+	slices.SortStableFunc(accepted, func(a, b weighted) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		}
+		return 0
+	})
+
+	locales := make([]string, 0, len(accepted))
+	for _, a := range accepted {
+		if !slices.Contains(locales, a.locale) {
+			locales = append(locales, a.locale)
+		}
+	}
+	return locales
+}
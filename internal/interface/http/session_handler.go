@@ -0,0 +1,115 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type SessionHandler struct {
+	listSessionsUseCase     *playback.ListSessionsUseCase
+	heartbeatSessionUseCase *playback.HeartbeatSessionUseCase
+	terminateSessionUseCase *playback.TerminateSessionUseCase
+	logger                  *log.Logger
+}
+
+func NewSessionHandler(
+	listSessionsUseCase *playback.ListSessionsUseCase,
+	heartbeatSessionUseCase *playback.HeartbeatSessionUseCase,
+	terminateSessionUseCase *playback.TerminateSessionUseCase,
+	logger *log.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUseCase:     listSessionsUseCase,
+		heartbeatSessionUseCase: heartbeatSessionUseCase,
+		terminateSessionUseCase: terminateSessionUseCase,
+		logger:                  logger,
+	}
+}
+
+func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	requestDTO := playback.ListSessionsInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listSessionsUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *SessionHandler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	// The body is optional: an empty heartbeat keeps the current video.
+	var requestDTO playback.HeartbeatSessionInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil && !errors.Is(err, io.EOF) {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.AccountID = viewerFromContext(r.Context()).AccountID
+	requestDTO.SessionID = chi.URLParam(r, "sessionID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.heartbeatSessionUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SessionHandler) TerminateSession(w http.ResponseWriter, r *http.Request) {
+	requestDTO := playback.TerminateSessionInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+		SessionID: chi.URLParam(r, "sessionID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.terminateSessionUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
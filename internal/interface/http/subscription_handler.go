@@ -0,0 +1,125 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type SubscriptionHandler struct {
+	listPlansUseCase          *subscription.ListPlansUseCase
+	subscribeUseCase          *subscription.SubscribeUseCase
+	getSubscriptionUseCase    *subscription.GetSubscriptionUseCase
+	cancelSubscriptionUseCase *subscription.CancelSubscriptionUseCase
+	logger                    *log.Logger
+}
+
+func NewSubscriptionHandler(
+	listPlansUseCase *subscription.ListPlansUseCase,
+	subscribeUseCase *subscription.SubscribeUseCase,
+	getSubscriptionUseCase *subscription.GetSubscriptionUseCase,
+	cancelSubscriptionUseCase *subscription.CancelSubscriptionUseCase,
+	logger *log.Logger,
+) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		listPlansUseCase:          listPlansUseCase,
+		subscribeUseCase:          subscribeUseCase,
+		getSubscriptionUseCase:    getSubscriptionUseCase,
+		cancelSubscriptionUseCase: cancelSubscriptionUseCase,
+		logger:                    logger,
+	}
+}
+
+func (h *SubscriptionHandler) ListPlans(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listPlansUseCase.Execute(r.Context())
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *SubscriptionHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	var requestDTO subscription.SubscribeInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.AccountID = viewerFromContext(r.Context()).AccountID
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.subscribeUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.logger.Info("Account subscribed", "accountID", requestDTO.AccountID, "plan", output.Plan.Code)
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *SubscriptionHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	requestDTO := subscription.GetSubscriptionInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.getSubscriptionUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
+
+func (h *SubscriptionHandler) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+	requestDTO := subscription.CancelSubscriptionInputDTO{
+		AccountID: viewerFromContext(r.Context()).AccountID,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.cancelSubscriptionUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/taxonomy"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type TaxonomyHandler struct {
+	setTaxonomyUseCase *taxonomy.SetTaxonomyUseCase
+	logger             *log.Logger
+}
+
+func NewTaxonomyHandler(setTaxonomyUseCase *taxonomy.SetTaxonomyUseCase, logger *log.Logger) *TaxonomyHandler {
+	return &TaxonomyHandler{
+		setTaxonomyUseCase: setTaxonomyUseCase,
+		logger:             logger,
+	}
+}
+
+func (h *TaxonomyHandler) SetTaxonomy(w http.ResponseWriter, r *http.Request) {
+	var requestDTO taxonomy.SetTaxonomyInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.setTaxonomyUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	domaintrending "github.com/hoyci/fakeflix/internal/domain/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+const topTenTodayTitle = "Top 10 today"
+
+type TrendingHandler struct {
+	listTrendingUseCase *trending.ListTrendingUseCase
+	logger              *log.Logger
+}
+
+func NewTrendingHandler(listTrendingUseCase *trending.ListTrendingUseCase, logger *log.Logger) *TrendingHandler {
+	return &TrendingHandler{
+		listTrendingUseCase: listTrendingUseCase,
+		logger:              logger,
+	}
+}
+
+func (h *TrendingHandler) ListTrending(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", defaultPageSize)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	window := r.URL.Query().Get("window")
+	if window == "" {
+		window = string(domaintrending.Day)
+	}
+
+	h.respond(w, r, trending.ListTrendingInputDTO{
+		Window:     window,
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Locales:    acceptedLocales(r),
+		Limit:      limit,
+	}, "")
+}
+
+// TopTenToday is the ready-made "Top 10 today" rail.
+func (h *TrendingHandler) TopTenToday(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, trending.ListTrendingInputDTO{
+		Window:     string(domaintrending.Day),
+		ProfileID:  viewerFromContext(r.Context()).ProfileID,
+		ProfilePIN: r.Header.Get(profilePINHeader),
+		Locales:    acceptedLocales(r),
+		Limit:      domaintrending.TopTenSize,
+	}, topTenTodayTitle)
+}
+
+func (h *TrendingHandler) respond(w http.ResponseWriter, r *http.Request, requestDTO trending.ListTrendingInputDTO, title string) {
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listTrendingUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+	output.Title = title
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
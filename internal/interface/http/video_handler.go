@@ -1,62 +1,151 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 	"path/filepath"
+	"strings"
 
 	"github.com/charmbracelet/log"
 
 	"github.com/go-chi/chi"
 	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/infra/throttle"
+	"github.com/hoyci/fakeflix/internal/usecase/playback"
 	"github.com/hoyci/fakeflix/internal/usecase/video"
 	"github.com/hoyci/fakeflix/pkg/fault"
 	"github.com/hoyci/fakeflix/pkg/httputils"
 )
 
 type VideoHandler struct {
-	getStreamInfoUseCase *video.GetStreamInfoUseCase
-	mediaService         media.MediaService
-	logger               *log.Logger
+	getStreamFileUseCase   *video.GetStreamFileUseCase
+	completeSessionUseCase *playback.CompleteSessionUseCase
+	mediaService           media.MediaService
+	shaper                 *throttle.Shaper
+	logger                 *log.Logger
 }
 
-func NewVideoHandler(uc *video.GetStreamInfoUseCase, ms media.MediaService, logger *log.Logger) *VideoHandler {
+func NewVideoHandler(uc *video.GetStreamFileUseCase, completeSessionUseCase *playback.CompleteSessionUseCase, ms media.MediaService, shaper *throttle.Shaper, logger *log.Logger) *VideoHandler {
 	return &VideoHandler{
-		getStreamInfoUseCase: uc,
-		mediaService:         ms,
-		logger:               logger,
+		getStreamFileUseCase:   uc,
+		completeSessionUseCase: completeSessionUseCase,
+		mediaService:           ms,
+		shaper:                 shaper,
+		logger:                 logger,
 	}
 }
 
 func (h *VideoHandler) StreamVideo(w http.ResponseWriter, r *http.Request) {
 	videoID := chi.URLParam(r, "videoID")
 
+	v := viewerFromContext(r.Context())
 	requestDTO := video.GetStreamInfoInputDTO{
-		VideoID: videoID,
+		VideoID:     videoID,
+		AccountID:   v.AccountID,
+		ProfileID:   v.ProfileID,
+		DeviceID:    v.DeviceID,
+		ProfilePIN:  r.Header.Get(profilePINHeader),
+		PINUnlocked: v.PINUnlocked,
+		Country:     countryFromContext(r.Context()),
+		SessionID:   playbackSessionID(r),
+		UserAgent:   r.UserAgent(),
 	}
 
 	if err := requestDTO.Validate(); err != nil {
 		h.logger.Warn("Request validation failed", "error", err)
-		httputils.RespondWithError(w, fault.New(
+		httputils.RespondWithError(w, r, fault.New(
 			err.Error(),
 			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
 		))
 		return
 	}
 
 	h.logger.Info("Received streaming request", "videoID", requestDTO.VideoID, "range_header", r.Header.Get("Range"))
 
-	output, err := h.getStreamInfoUseCase.Execute(r.Context(), requestDTO)
+	output, err := h.getStreamFileUseCase.Execute(r.Context(), requestDTO)
 	if err != nil {
-		httputils.RespondWithError(w, err)
+		httputils.RespondWithError(w, r, err)
 		return
 	}
 
 	file, fileStat, err := h.mediaService.GetStream(output.FilePath)
 	if err != nil {
-		httputils.RespondWithError(w, err)
+		httputils.RespondWithError(w, r, err)
 		return
 	}
 	defer file.Close()
 
-	http.ServeContent(w, r, filepath.Base(output.FilePath), fileStat.ModTime(), file)
+	if output.SessionID != "" {
+		w.Header().Set(playbackSessionHeader, output.SessionID)
+	}
+	var bitrate int
+	if output.Duration > 0 {
+		bitrate = int(fileStat.Size()) / output.Duration
+	}
+	throttled := h.shaper.Wrap(r.Context(), file, throttle.Stream{
+		ClientIP:  clientIP(r),
+		SessionID: output.SessionID,
+		Bitrate:   bitrate,
+	})
+	served := &servedRange{ResponseWriter: w}
+	http.ServeContent(served, r, filepath.Base(output.FilePath), fileStat.ModTime(), throttled)
+
+	// A suffix range is a player reading the index at the end of the file,
+	// not the viewer reaching it.
+	if output.SessionID == "" || strings.HasPrefix(r.Header.Get("Range"), "bytes=-") || !served.reachedEnd(fileStat.Size()) {
+		return
+	}
+	err = h.completeSessionUseCase.Execute(r.Context(), playback.CompleteSessionInputDTO{
+		AccountID: requestDTO.AccountID,
+		SessionID: output.SessionID,
+		VideoID:   requestDTO.VideoID,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to complete playback session", "sessionID", output.SessionID, "error", err)
+	}
+}
+
+func playbackSessionID(r *http.Request) string {
+	if sessionID := r.Header.Get(playbackSessionHeader); sessionID != "" {
+		return sessionID
+	}
+	return r.URL.Query().Get(playbackSessionQuery)
+}
+
+// servedRange records the status and the number of bytes of a response.
+type servedRange struct {
+	http.ResponseWriter
+	status  int
+	written int64
+}
+
+func (s *servedRange) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *servedRange) Write(p []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	n, err := s.ResponseWriter.Write(p)
+	s.written += int64(n)
+	return n, err
+}
+
+// reachedEnd tells whether the response delivered the last byte of a file
+// of the given size.
+func (s *servedRange) reachedEnd(size int64) bool {
+	switch s.status {
+	case http.StatusOK:
+		return s.written == size
+	case http.StatusPartialContent:
+		var start, end, total int64
+		if _, err := fmt.Sscanf(s.Header().Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil {
+			return false
+		}
+		return end == size-1 && s.written == end-start+1
+	}
+	return false
 }
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+	"github.com/hoyci/fakeflix/pkg/httputils"
+)
+
+type WatchlistHandler struct {
+	addToListUseCase      *watchlist.AddToListUseCase
+	removeFromListUseCase *watchlist.RemoveFromListUseCase
+	listMyListUseCase     *watchlist.ListMyListUseCase
+	logger                *log.Logger
+}
+
+func NewWatchlistHandler(
+	addToListUseCase *watchlist.AddToListUseCase,
+	removeFromListUseCase *watchlist.RemoveFromListUseCase,
+	listMyListUseCase *watchlist.ListMyListUseCase,
+	logger *log.Logger,
+) *WatchlistHandler {
+	return &WatchlistHandler{
+		addToListUseCase:      addToListUseCase,
+		removeFromListUseCase: removeFromListUseCase,
+		listMyListUseCase:     listMyListUseCase,
+		logger:                logger,
+	}
+}
+
+// AddToList puts a content on the list. The body is optional and only
+// needed to place the content at a given position.
+func (h *WatchlistHandler) AddToList(w http.ResponseWriter, r *http.Request) {
+	var requestDTO watchlist.AddToListInputDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil && !errors.Is(err, io.EOF) {
+		httputils.RespondWithError(w, r, fault.New(
+			"invalid request body",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		))
+		return
+	}
+	requestDTO.ProfileID = viewerFromContext(r.Context()).ProfileID
+	requestDTO.ContentID = chi.URLParam(r, "contentID")
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.addToListUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WatchlistHandler) RemoveFromList(w http.ResponseWriter, r *http.Request) {
+	requestDTO := watchlist.RemoveFromListInputDTO{
+		ProfileID: viewerFromContext(r.Context()).ProfileID,
+		ContentID: chi.URLParam(r, "contentID"),
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	if err := h.removeFromListUseCase.Execute(r.Context(), requestDTO); err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WatchlistHandler) ListMyList(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, err := pagination(r)
+	if err != nil {
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	requestDTO := watchlist.ListMyListInputDTO{
+		ProfileID: viewerFromContext(r.Context()).ProfileID,
+		Locales:   acceptedLocales(r),
+		Page:      page,
+		PageSize:  pageSize,
+	}
+
+	if err := requestDTO.Validate(); err != nil {
+		h.logger.Warn("Request validation failed", "error", err)
+		httputils.RespondWithError(w, r, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithFieldErrors(err),
+		))
+		return
+	}
+
+	output, err := h.listMyListUseCase.Execute(r.Context(), requestDTO)
+	if err != nil {
+		httputils.RespondWithError(w, r, err)
+		return
+	}
+
+	httputils.RespondWithJSON(w, http.StatusOK, output)
+}
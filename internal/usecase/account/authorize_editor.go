@@ -0,0 +1,41 @@
+package account
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/account"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type AuthorizeEditorInputDTO struct {
+	AccountID string
+}
+
+type AuthorizeEditorUseCase struct {
+	accountRepo account.Repository
+	logger      *log.Logger
+}
+
+func NewAuthorizeEditorUseCase(accountRepo account.Repository, logger *log.Logger) *AuthorizeEditorUseCase {
+	return &AuthorizeEditorUseCase{
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *AuthorizeEditorUseCase) Execute(ctx context.Context, input AuthorizeEditorInputDTO) error {
+	// The role is read on every call rather than carried in the token, so
+	// demoting an editor takes effect immediately.
+	accountEntity, err := uc.accountRepo.FindByID(ctx, input.AccountID)
+	if err != nil || !accountEntity.IsEditor() {
+		uc.logger.Warn("Rejected editor access", "accountID", input.AccountID)
+		return fault.New(
+			"editor role required",
+			fault.WithKind(fault.KindForbidden),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/account"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type LoginInputDTO struct {
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	ProfileID  string `json:"profile_id"`
+	DeviceID   string `json:"device_id"`
+	DeviceType string `json:"device_type"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"-"`
+}
+
+func (req LoginInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Email, validation.Required.Error("email is required")),
+		validation.Field(&req.Password, validation.Required.Error("password is required")),
+		validation.Field(&req.DeviceType,
+			validation.In(string(device.TVType), string(device.MobileType), string(device.TabletType), string(device.WebType), string(device.ConsoleType), string(device.OtherType)).
+				Error("device_type must be one of TV, MOBILE, TABLET, WEB, CONSOLE or OTHER"),
+		),
+		validation.Field(&req.DeviceName, validation.Length(0, 100)),
+	)
+}
+
+type TokenOutputDTO struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ProfileID        string `json:"profile_id,omitempty"`
+	ExpiresAt        string `json:"expires_at"`
+	DeviceID         string `json:"device_id,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	RefreshExpiresAt string `json:"refresh_expires_at,omitempty"`
+}
+
+type LoginUseCase struct {
+	accountRepo             account.Repository
+	profileRepo             profile.Repository
+	deviceRepo              device.Repository
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	tokenService            auth.TokenService
+	refreshTTL              time.Duration
+	logger                  *log.Logger
+}
+
+func NewLoginUseCase(
+	accountRepo account.Repository,
+	profileRepo profile.Repository,
+	deviceRepo device.Repository,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	tokenService auth.TokenService,
+	refreshTTL time.Duration,
+	logger *log.Logger,
+) *LoginUseCase {
+	return &LoginUseCase{
+		accountRepo:             accountRepo,
+		profileRepo:             profileRepo,
+		deviceRepo:              deviceRepo,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		tokenService:            tokenService,
+		refreshTTL:              refreshTTL,
+		logger:                  logger,
+	}
+}
+
+func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInputDTO) (*TokenOutputDTO, error) {
+	accountEntity, err := uc.accountRepo.FindByEmail(ctx, strings.ToLower(strings.TrimSpace(input.Email)))
+	if err != nil || !accountEntity.VerifyPassword(input.Password) {
+		uc.logger.Warn("Login attempt with invalid credentials")
+		return nil, fault.New(
+			"invalid email or password",
+			fault.WithKind(fault.KindUnauthenticated),
+		)
+	}
+
+	if input.ProfileID != "" {
+		if err := ensureProfileOwnership(ctx, uc.profileRepo, accountEntity.ID(), input.ProfileID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	deviceEntity, refreshToken, err := uc.signInDevice(ctx, accountEntity.ID(), input, now)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := issueToken(uc.tokenService, accountEntity.ID(), input.ProfileID, deviceEntity.ID())
+	if err != nil {
+		return nil, err
+	}
+	output.RefreshToken = refreshToken
+	output.RefreshExpiresAt = deviceEntity.RefreshExpiresAt().String()
+	return output, nil
+}
+
+func (uc *LoginUseCase) signInDevice(ctx context.Context, accountID string, input LoginInputDTO, now time.Time) (*device.Device, string, error) {
+	if input.DeviceID != "" {
+		deviceEntity, err := uc.deviceRepo.FindByID(ctx, input.DeviceID)
+		if err != nil && !errors.Is(err, device.ErrNotFound) {
+			return nil, "", fault.New(
+				"failed to load device",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+		if err == nil && deviceEntity.BelongsTo(accountID) && !deviceEntity.IsSignedOut() {
+			deviceEntity.Touch(input.UserAgent, now)
+			refreshToken, err := rotateRefreshToken(ctx, uc.deviceRepo, deviceEntity, now, uc.refreshTTL)
+			if err != nil {
+				return nil, "", err
+			}
+			return deviceEntity, refreshToken, nil
+		}
+	}
+
+	maxDevices := 0
+	entitlement, err := uc.checkEntitlementUseCase.Execute(ctx, subscription.CheckEntitlementInputDTO{AccountID: accountID})
+	var faultErr *fault.Error
+	switch {
+	case err == nil:
+		maxDevices = entitlement.MaxDevices
+	case !errors.As(err, &faultErr) || faultErr.Kind != fault.KindPaymentRequired:
+		return nil, "", err
+	}
+
+	deviceEntity, err := device.NewDevice(accountID, device.Type(input.DeviceType), input.DeviceName, input.UserAgent, now)
+	if err != nil {
+		return nil, "", fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+	refreshToken, err := deviceEntity.IssueRefreshToken(now, uc.refreshTTL)
+	if err != nil {
+		return nil, "", fault.New(
+			"failed to issue refresh token",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.deviceRepo.Register(ctx, deviceEntity, maxDevices); err != nil {
+		if errors.Is(err, device.ErrTooManyDevices) {
+			uc.logger.Warn("Rejected sign in above the device limit", "accountID", accountID, "maxDevices", maxDevices)
+			return nil, "", fault.New(
+				"maximum number of devices reached for your plan, sign out a device first",
+				fault.WithKind(fault.KindLimitExceeded),
+				fault.WithError(err),
+				fault.WithCode("too_many_devices"),
+			)
+		}
+		return nil, "", fault.New(
+			"failed to register device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Device registered", "accountID", accountID, "deviceID", deviceEntity.ID(), "type", deviceEntity.Type())
+	return deviceEntity, refreshToken, nil
+}
+
+func ensureProfileOwnership(ctx context.Context, profileRepo profile.Repository, accountID, profileID string) error {
+	profileEntity, err := profileRepo.FindByID(ctx, profileID)
+	if err != nil || !profileEntity.BelongsTo(accountID) {
+		return fault.New(
+			"profile not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
+
+func rotateRefreshToken(ctx context.Context, deviceRepo device.Repository, deviceEntity *device.Device, now time.Time, ttl time.Duration) (string, error) {
+	refreshToken, err := deviceEntity.IssueRefreshToken(now, ttl)
+	if err != nil {
+		return "", fault.New(
+			"failed to issue refresh token",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if err := deviceRepo.Save(ctx, deviceEntity); err != nil {
+		return "", fault.New(
+			"failed to save device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return refreshToken, nil
+}
+
+func issueToken(tokenService auth.TokenService, accountID, profileID, deviceID string) (*TokenOutputDTO, error) {
+	token, expiresAt, err := tokenService.Issue(accountID, profileID, deviceID)
+	if err != nil {
+		return nil, fault.New(
+			"failed to issue access token",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &TokenOutputDTO{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ProfileID:   profileID,
+		ExpiresAt:   expiresAt.String(),
+		DeviceID:    deviceID,
+	}, nil
+}
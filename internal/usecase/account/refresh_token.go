@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RefreshTokenInputDTO struct {
+	RefreshToken string `json:"refresh_token"`
+	ProfileID    string `json:"profile_id"`
+	UserAgent    string `json:"-"`
+}
+
+func (req RefreshTokenInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.RefreshToken, validation.Required.Error("refresh_token is required")),
+	)
+}
+
+type RefreshTokenUseCase struct {
+	deviceRepo   device.Repository
+	profileRepo  profile.Repository
+	tokenService auth.TokenService
+	refreshTTL   time.Duration
+	logger       *log.Logger
+}
+
+func NewRefreshTokenUseCase(deviceRepo device.Repository, profileRepo profile.Repository, tokenService auth.TokenService, refreshTTL time.Duration, logger *log.Logger) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		deviceRepo:   deviceRepo,
+		profileRepo:  profileRepo,
+		tokenService: tokenService,
+		refreshTTL:   refreshTTL,
+		logger:       logger,
+	}
+}
+
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input RefreshTokenInputDTO) (*TokenOutputDTO, error) {
+	now := time.Now()
+
+	deviceEntity, err := uc.findDevice(ctx, input.RefreshToken)
+	if err == nil {
+		err = deviceEntity.VerifyRefreshToken(input.RefreshToken, now)
+	}
+	if err != nil {
+		var faultErr *fault.Error
+		if errors.As(err, &faultErr) {
+			return nil, err
+		}
+		uc.logger.Warn("Rejected refresh token", "error", err)
+		return nil, fault.New(
+			"invalid or expired refresh token",
+			fault.WithKind(fault.KindUnauthenticated),
+			fault.WithError(err),
+			fault.WithCode("invalid_refresh_token"),
+		)
+	}
+
+	if input.ProfileID != "" {
+		if err := ensureProfileOwnership(ctx, uc.profileRepo, deviceEntity.AccountID(), input.ProfileID); err != nil {
+			return nil, err
+		}
+	}
+
+	deviceEntity.Touch(input.UserAgent, now)
+	refreshToken, err := rotateRefreshToken(ctx, uc.deviceRepo, deviceEntity, now, uc.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := issueToken(uc.tokenService, deviceEntity.AccountID(), input.ProfileID, deviceEntity.ID())
+	if err != nil {
+		return nil, err
+	}
+	output.RefreshToken = refreshToken
+	output.RefreshExpiresAt = deviceEntity.RefreshExpiresAt().String()
+	return output, nil
+}
+
+func (uc *RefreshTokenUseCase) findDevice(ctx context.Context, refreshToken string) (*device.Device, error) {
+	deviceID, err := device.DeviceIDFromRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceEntity, err := uc.deviceRepo.FindByID(ctx, deviceID)
+	if err != nil && !errors.Is(err, device.ErrNotFound) {
+		return nil, fault.New(
+			"failed to load device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return deviceEntity, err
+}
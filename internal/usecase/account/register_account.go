@@ -0,0 +1,78 @@
+package account
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/account"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RegisterAccountInputDTO struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (req RegisterAccountInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Email, validation.Required.Error("email is required"), validation.Length(3, 255)),
+		validation.Field(&req.Password, validation.Required.Error("password is required"), validation.Length(8, 72)),
+	)
+}
+
+type RegisterAccountOutputDTO struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+
+type RegisterAccountUseCase struct {
+	accountRepo account.Repository
+	logger      *log.Logger
+}
+
+func NewRegisterAccountUseCase(accountRepo account.Repository, logger *log.Logger) *RegisterAccountUseCase {
+	return &RegisterAccountUseCase{
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *RegisterAccountUseCase) Execute(ctx context.Context, input RegisterAccountInputDTO) (*RegisterAccountOutputDTO, error) {
+	uc.logger.Debug("Starting register account use case execution")
+
+	accountEntity, err := account.NewAccount(input.Email, input.Password)
+	if err != nil {
+		return nil, fault.New(
+			"failed to create account entity",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.accountRepo.Save(ctx, accountEntity); err != nil {
+		if errors.Is(err, account.ErrConflict) {
+			return nil, fault.New(
+				"email already in use",
+				fault.WithKind(fault.KindConflict),
+				fault.WithError(err),
+			)
+		}
+		return nil, fault.New(
+			"failed to save account",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Debug("Account saved successfully", "accountID", accountEntity.ID())
+
+	return &RegisterAccountOutputDTO{
+		ID:        accountEntity.ID(),
+		Email:     accountEntity.Email(),
+		CreatedAt: accountEntity.CreatedAt().String(),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package account
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+)
+
+type SwitchProfileInputDTO struct {
+	AccountID string
+	ProfileID string
+	DeviceID  string
+}
+
+func (req SwitchProfileInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+	)
+}
+
+type SwitchProfileUseCase struct {
+	profileRepo  profile.Repository
+	tokenService auth.TokenService
+	logger       *log.Logger
+}
+
+func NewSwitchProfileUseCase(profileRepo profile.Repository, tokenService auth.TokenService, logger *log.Logger) *SwitchProfileUseCase {
+	return &SwitchProfileUseCase{
+		profileRepo:  profileRepo,
+		tokenService: tokenService,
+		logger:       logger,
+	}
+}
+
+func (uc *SwitchProfileUseCase) Execute(ctx context.Context, input SwitchProfileInputDTO) (*TokenOutputDTO, error) {
+	uc.logger.Debug("Switching profile", "accountID", input.AccountID, "profileID", input.ProfileID)
+
+	if err := ensureProfileOwnership(ctx, uc.profileRepo, input.AccountID, input.ProfileID); err != nil {
+		return nil, err
+	}
+
+	return issueToken(uc.tokenService, input.AccountID, input.ProfileID, input.DeviceID)
+}
@@ -0,0 +1,46 @@
+package availability
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/availability"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ClearAvailabilityInputDTO struct {
+	ContentID string
+}
+
+func (req ClearAvailabilityInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+	)
+}
+
+type ClearAvailabilityUseCase struct {
+	availabilityRepo availability.Repository
+	logger           *log.Logger
+}
+
+func NewClearAvailabilityUseCase(availabilityRepo availability.Repository, logger *log.Logger) *ClearAvailabilityUseCase {
+	return &ClearAvailabilityUseCase{
+		availabilityRepo: availabilityRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *ClearAvailabilityUseCase) Execute(ctx context.Context, input ClearAvailabilityInputDTO) error {
+	err := uc.availabilityRepo.Delete(ctx, input.ContentID)
+	if err != nil && !errors.Is(err, availability.ErrNotFound) {
+		uc.logger.Error("Failed to clear availability rule", "contentID", input.ContentID, "error", err)
+		return fault.New(
+			"failed to clear availability rule",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
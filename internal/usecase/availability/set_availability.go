@@ -0,0 +1,86 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/availability"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetAvailabilityInputDTO struct {
+	ContentID        string     `json:"-"`
+	AllowedCountries []string   `json:"allowed_countries"`
+	DeniedCountries  []string   `json:"denied_countries"`
+	StartsAt         *time.Time `json:"starts_at"`
+	EndsAt           *time.Time `json:"ends_at"`
+}
+
+func (req SetAvailabilityInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.AllowedCountries, validation.Length(0, 250)),
+		validation.Field(&req.DeniedCountries, validation.Length(0, 250)),
+	)
+}
+
+type AvailabilityOutputDTO struct {
+	ContentID        string     `json:"content_id"`
+	AllowedCountries []string   `json:"allowed_countries"`
+	DeniedCountries  []string   `json:"denied_countries"`
+	StartsAt         *time.Time `json:"starts_at,omitempty"`
+	EndsAt           *time.Time `json:"ends_at,omitempty"`
+}
+
+type SetAvailabilityUseCase struct {
+	availabilityRepo availability.Repository
+	contentRepo      content.Repository
+	logger           *log.Logger
+}
+
+func NewSetAvailabilityUseCase(availabilityRepo availability.Repository, contentRepo content.Repository, logger *log.Logger) *SetAvailabilityUseCase {
+	return &SetAvailabilityUseCase{
+		availabilityRepo: availabilityRepo,
+		contentRepo:      contentRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *SetAvailabilityUseCase) Execute(ctx context.Context, input SetAvailabilityInputDTO) (*AvailabilityOutputDTO, error) {
+	if _, err := uc.contentRepo.FindByID(ctx, input.ContentID); err != nil {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	rule, err := availability.NewRule(input.ContentID, input.AllowedCountries, input.DeniedCountries, input.StartsAt, input.EndsAt)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.availabilityRepo.Save(ctx, rule); err != nil {
+		uc.logger.Error("Failed to save availability rule", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to save availability rule",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &AvailabilityOutputDTO{
+		ContentID:        rule.ContentID(),
+		AllowedCountries: rule.AllowedCountries(),
+		DeniedCountries:  rule.DeniedCountries(),
+		StartsAt:         rule.StartsAt(),
+		EndsAt:           rule.EndsAt(),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"github.com/hoyci/fakeflix/internal/domain/content"
+)
+
+type AgeRatingDTO struct {
+	System string `json:"system"`
+	Value  string `json:"value"`
+	Level  int    `json:"level"`
+}
+
+type ContentOutputDTO struct {
+	ID            string        `json:"id"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description"`
+	ContentType   string        `json:"content_type"`
+	ThumbnailURL  string        `json:"thumbnail_url,omitempty"`
+	AgeRating     *AgeRatingDTO `json:"age_rating,omitempty"`
+	MaturityLevel int           `json:"maturity_level"`
+	Score         *ScoreDTO     `json:"score,omitempty"`
+	MyRating      *MyRatingDTO  `json:"my_rating,omitempty"`
+	CreatedAt     string        `json:"created_at"`
+}
+
+func NewContentOutputDTO(contentEntity *content.Content) ContentOutputDTO {
+	output := ContentOutputDTO{
+		ID:            contentEntity.ID(),
+		Title:         contentEntity.Title(),
+		Description:   contentEntity.Description(),
+		ContentType:   string(contentEntity.ContentType()),
+		MaturityLevel: contentEntity.MaturityLevel(),
+		CreatedAt:     contentEntity.CreatedAt().String(),
+	}
+
+	if rating := contentEntity.AgeRating(); !rating.IsZero() {
+		output.AgeRating = &AgeRatingDTO{
+			System: string(rating.System()),
+			Value:  rating.Value(),
+			Level:  rating.Level(),
+		}
+	}
+
+	if mov, err := contentEntity.Movie(); err == nil && mov != nil && mov.Thumbnail() != nil {
+		output.ThumbnailURL = mov.Thumbnail().URL()
+	}
+	if show, err := contentEntity.TvShow(); err == nil && show != nil && show.Thumbnail() != nil {
+		output.ThumbnailURL = show.Thumbnail().URL()
+	}
+
+	return output
+}
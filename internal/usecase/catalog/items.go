@@ -0,0 +1,55 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+)
+
+func ItemsByIDs(
+	ctx context.Context,
+	contentRepo content.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	profileID string,
+	locales []string,
+	maxLevel *int,
+	contentIDs []string,
+	limit int,
+) ([]ContentOutputDTO, error) {
+	contents, err := contentRepo.FindByIDs(ctx, contentIDs)
+	if err != nil {
+		return nil, err
+	}
+	contentsByID := make(map[string]*content.Content, len(contents))
+	for _, contentEntity := range contents {
+		contentsByID[contentEntity.ID()] = contentEntity
+	}
+
+	items := make([]ContentOutputDTO, 0, min(len(contentIDs), limit))
+	for _, id := range contentIDs {
+		if len(items) == limit {
+			break
+		}
+		contentEntity, ok := contentsByID[id]
+		if !ok || !contentEntity.IsPublished() || (maxLevel != nil && contentEntity.MaturityLevel() > *maxLevel) {
+			continue
+		}
+		items = append(items, NewContentOutputDTO(contentEntity))
+	}
+
+	refs := make([]*ContentOutputDTO, len(items))
+	for i := range items {
+		refs[i] = &items[i]
+	}
+	if err := AttachRatings(ctx, ratingRepo, profileID, refs); err != nil {
+		return nil, err
+	}
+	if err := Localize(ctx, translationRepo, locales, refs); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,122 @@
+package catalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/taxonomy"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListContentsInputDTO struct {
+	ProfileID  string
+	ProfilePIN string
+	Country    string
+	Locales    []string
+	Genre      string
+	Page       int
+	PageSize   int
+}
+
+func (req ListContentsInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Page, validation.Min(1)),
+		validation.Field(&req.PageSize, validation.Min(1), validation.Max(100)),
+	)
+}
+
+type ListContentsOutputDTO struct {
+	Items    []ContentOutputDTO `json:"items"`
+	Page     int                `json:"page"`
+	PageSize int                `json:"page_size"`
+	Total    int                `json:"total"`
+}
+
+type ListContentsUseCase struct {
+	contentRepo     content.Repository
+	profileRepo     profile.Repository
+	ratingRepo      rating.Repository
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewListContentsUseCase(
+	contentRepo content.Repository,
+	profileRepo profile.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListContentsUseCase {
+	return &ListContentsUseCase{
+		contentRepo:     contentRepo,
+		profileRepo:     profileRepo,
+		ratingRepo:      ratingRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *ListContentsUseCase) Execute(ctx context.Context, input ListContentsInputDTO) (*ListContentsOutputDTO, error) {
+	uc.logger.Debug("Starting list contents use case execution", "profileID", input.ProfileID, "page", input.Page)
+
+	filter := content.ListFilter{
+		Genre:       taxonomy.Slug(input.Genre),
+		Status:      content.PublishedStatus,
+		Country:     input.Country,
+		AvailableAt: time.Now(),
+		Offset:      (input.Page - 1) * input.PageSize,
+		Limit:       input.PageSize,
+	}
+
+	maxLevel, err := MaxMaturityLevel(ctx, uc.profileRepo, input.ProfileID, input.ProfilePIN)
+	if err != nil {
+		return nil, err
+	}
+	filter.MaxMaturityLevel = maxLevel
+
+	contents, total, err := uc.contentRepo.List(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to list contents", "error", err)
+		return nil, fault.New(
+			"failed to list contents",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]ContentOutputDTO, len(contents))
+	refs := make([]*ContentOutputDTO, len(contents))
+	for i, contentEntity := range contents {
+		items[i] = NewContentOutputDTO(contentEntity)
+		refs[i] = &items[i]
+	}
+	if err := AttachRatings(ctx, uc.ratingRepo, input.ProfileID, refs); err != nil {
+		uc.logger.Error("Failed to load content ratings", "error", err)
+		return nil, fault.New(
+			"failed to list contents",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if err := Localize(ctx, uc.translationRepo, input.Locales, refs); err != nil {
+		uc.logger.Error("Failed to load content translations", "error", err)
+		return nil, fault.New(
+			"failed to list contents",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &ListContentsOutputDTO{
+		Items:    items,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    total,
+	}, nil
+}
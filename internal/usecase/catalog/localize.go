@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+)
+
+func Localize(ctx context.Context, translationRepo localization.Repository, locales []string, items []*ContentOutputDTO) error {
+	if len(locales) == 0 || len(items) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		contentIDs = append(contentIDs, item.ID)
+	}
+
+	translations, err := translationRepo.ListBySubjects(ctx, localization.ContentSubject, contentIDs)
+	if err != nil {
+		return err
+	}
+	bySubject := make(map[string][]*localization.Translation, len(items))
+	for _, translation := range translations {
+		bySubject[translation.SubjectID()] = append(bySubject[translation.SubjectID()], translation)
+	}
+
+	for _, item := range items {
+		translation := localization.Pick(locales, bySubject[item.ID])
+		if translation == nil {
+			continue
+		}
+		item.Title = translation.Title()
+		item.Description = translation.Description()
+		if thumbnail := translation.Thumbnail(); thumbnail != nil {
+			item.ThumbnailURL = thumbnail.URL()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package catalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+func MaxMaturityLevel(ctx context.Context, profileRepo profile.Repository, profileID, profilePIN string) (*int, error) {
+	if profileID == "" {
+		return nil, nil
+	}
+
+	profileEntity, err := profileRepo.FindByID(ctx, profileID)
+	if err != nil {
+		return nil, fault.New(
+			"profile not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	unlocked, err := CheckPIN(ctx, profileRepo, profileEntity, profilePIN)
+	if err != nil {
+		return nil, err
+	}
+	level := profileEntity.EffectiveMaturityLevel(unlocked)
+	return &level, nil
+}
+
+func CheckPIN(ctx context.Context, profileRepo profile.Repository, profileEntity *profile.Profile, pin string) (bool, error) {
+	now := time.Now()
+	if pin == "" || profileEntity.IsKids() || !profileEntity.HasPIN() {
+		return false, nil
+	}
+	if profileEntity.IsPINLockedAt(now) {
+		return false, fault.New(
+			"profile pin is locked after too many wrong attempts, try again later",
+			fault.WithKind(fault.KindLimitExceeded),
+			fault.WithCode("pin_locked"),
+		)
+	}
+
+	if !profileEntity.VerifyPIN(pin) {
+		if err := profileRepo.RecordFailedPIN(ctx, profileEntity.ID(), now); err != nil {
+			return false, fault.New(
+				"failed to record wrong profile pin",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+		return false, nil
+	}
+
+	if profileEntity.FailedPINs() > 0 {
+		if err := profileRepo.ResetFailedPINs(ctx, profileEntity.ID()); err != nil {
+			return false, fault.New(
+				"failed to reset wrong profile pins",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,66 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+)
+
+type ScoreDTO struct {
+	ThumbsUp      int     `json:"thumbs_up"`
+	ThumbsDown    int     `json:"thumbs_down"`
+	ThumbsUpRatio float64 `json:"thumbs_up_ratio"`
+	StarsCount    int     `json:"stars_count"`
+	AverageStars  float64 `json:"average_stars"`
+}
+
+type MyRatingDTO struct {
+	Thumb string `json:"thumb"`
+	Stars int    `json:"stars,omitempty"`
+}
+
+func NewScoreDTO(summary *rating.Summary) *ScoreDTO {
+	if summary == nil {
+		return &ScoreDTO{}
+	}
+	return &ScoreDTO{
+		ThumbsUp:      summary.ThumbsUp,
+		ThumbsDown:    summary.ThumbsDown,
+		ThumbsUpRatio: summary.ThumbsUpRatio(),
+		StarsCount:    summary.StarsCount,
+		AverageStars:  summary.AverageStars(),
+	}
+}
+
+func NewMyRatingDTO(ratingEntity *rating.Rating) *MyRatingDTO {
+	if ratingEntity == nil {
+		return nil
+	}
+	return &MyRatingDTO{
+		Thumb: string(ratingEntity.Thumb()),
+		Stars: ratingEntity.Stars(),
+	}
+}
+
+func AttachRatings(ctx context.Context, ratingRepo rating.Repository, profileID string, items []*ContentOutputDTO) error {
+	contentIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		contentIDs = append(contentIDs, item.ID)
+	}
+
+	summaries, err := ratingRepo.FindSummaries(ctx, contentIDs)
+	if err != nil {
+		return err
+	}
+	own, err := ratingRepo.FindByProfile(ctx, profileID, contentIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		item.Score = NewScoreDTO(summaries[item.ID])
+		item.MyRating = NewMyRatingDTO(own[item.ID])
+	}
+
+	return nil
+}
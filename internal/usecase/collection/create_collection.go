@@ -0,0 +1,80 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type CreateCollectionInputDTO struct {
+	Slug         string     `json:"slug"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	ContentIDs   []string   `json:"content_ids"`
+	PublishFrom  *time.Time `json:"publish_from"`
+	PublishUntil *time.Time `json:"publish_until"`
+}
+
+func (req CreateCollectionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Slug, validation.Required.Error("slug is required"), validation.Length(1, collection.MaxSlugLength)),
+		validation.Field(&req.Title, validation.Required.Error("title is required"), validation.Length(1, 255)),
+		validation.Field(&req.ContentIDs, validation.Length(0, collection.MaxContents)),
+	)
+}
+
+type CreateCollectionUseCase struct {
+	collectionRepo collection.Repository
+	contentRepo    content.Repository
+	logger         *log.Logger
+}
+
+func NewCreateCollectionUseCase(collectionRepo collection.Repository, contentRepo content.Repository, logger *log.Logger) *CreateCollectionUseCase {
+	return &CreateCollectionUseCase{
+		collectionRepo: collectionRepo,
+		contentRepo:    contentRepo,
+		logger:         logger,
+	}
+}
+
+func (uc *CreateCollectionUseCase) Execute(ctx context.Context, input CreateCollectionInputDTO) (*CollectionOutputDTO, error) {
+	uc.logger.Debug("Starting create collection use case execution", "slug", input.Slug)
+
+	collectionEntity, err := collection.NewCollection(input.Slug, input.Title, input.Description, input.PublishFrom, input.PublishUntil, input.ContentIDs)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := ensureContentsExist(ctx, uc.contentRepo, collectionEntity.ContentIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.collectionRepo.Save(ctx, collectionEntity); err != nil {
+		if errors.Is(err, collection.ErrConflict) {
+			return nil, fault.New(
+				"collection slug already in use",
+				fault.WithKind(fault.KindConflict),
+				fault.WithError(err),
+			)
+		}
+		uc.logger.Error("Failed to save collection", "slug", input.Slug, "error", err)
+		return nil, fault.New(
+			"failed to save collection",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newCollectionOutputDTO(collectionEntity, time.Now())
+	return &output, nil
+}
@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteCollectionInputDTO struct {
+	Slug string
+}
+
+func (req DeleteCollectionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Slug, validation.Required.Error("slug is required")),
+	)
+}
+
+type DeleteCollectionUseCase struct {
+	collectionRepo collection.Repository
+	logger         *log.Logger
+}
+
+func NewDeleteCollectionUseCase(collectionRepo collection.Repository, logger *log.Logger) *DeleteCollectionUseCase {
+	return &DeleteCollectionUseCase{
+		collectionRepo: collectionRepo,
+		logger:         logger,
+	}
+}
+
+func (uc *DeleteCollectionUseCase) Execute(ctx context.Context, input DeleteCollectionInputDTO) error {
+	collectionEntity, err := findCollection(ctx, uc.collectionRepo, input.Slug)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.collectionRepo.Delete(ctx, collectionEntity.ID()); err != nil {
+		uc.logger.Error("Failed to delete collection", "slug", input.Slug, "error", err)
+		return fault.New(
+			"failed to delete collection",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type CollectionOutputDTO struct {
+	ID           string     `json:"id"`
+	Slug         string     `json:"slug"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	ArtworkURL   string     `json:"artwork_url,omitempty"`
+	ContentIDs   []string   `json:"content_ids"`
+	PublishFrom  *time.Time `json:"publish_from,omitempty"`
+	PublishUntil *time.Time `json:"publish_until,omitempty"`
+	Published    bool       `json:"published"`
+	CreatedAt    string     `json:"created_at"`
+	UpdatedAt    string     `json:"updated_at"`
+}
+
+func newCollectionOutputDTO(collectionEntity *collection.Collection, now time.Time) CollectionOutputDTO {
+	output := CollectionOutputDTO{
+		ID:           collectionEntity.ID(),
+		Slug:         collectionEntity.Slug(),
+		Title:        collectionEntity.Title(),
+		Description:  collectionEntity.Description(),
+		ContentIDs:   collectionEntity.ContentIDs(),
+		PublishFrom:  collectionEntity.PublishFrom(),
+		PublishUntil: collectionEntity.PublishUntil(),
+		Published:    collectionEntity.IsPublishedAt(now),
+		CreatedAt:    collectionEntity.CreatedAt().String(),
+		UpdatedAt:    collectionEntity.UpdatedAt().String(),
+	}
+	if collectionEntity.Artwork() != nil {
+		output.ArtworkURL = collectionEntity.Artwork().URL()
+	}
+	return output
+}
+
+func ensureContentsExist(ctx context.Context, contentRepo content.Repository, contentIDs []string) error {
+	contents, err := contentRepo.FindByIDs(ctx, contentIDs)
+	if err != nil {
+		return fault.New(
+			"failed to load collection contents",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if len(contents) != len(contentIDs) {
+		return fault.New(
+			"collection references contents that do not exist",
+			fault.WithKind(fault.KindNotFound),
+		)
+	}
+	return nil
+}
+
+func findCollection(ctx context.Context, collectionRepo collection.Repository, slug string) (*collection.Collection, error) {
+	collectionEntity, err := collectionRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, collection.ErrNotFound) {
+			return nil, fault.New(
+				"collection not found",
+				fault.WithKind(fault.KindNotFound),
+				fault.WithError(err),
+			)
+		}
+		return nil, fault.New(
+			"failed to load collection",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return collectionEntity, nil
+}
@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetCollectionInputDTO struct {
+	Slug       string
+	ProfileID  string
+	ProfilePIN string
+	Locales    []string
+}
+
+func (req GetCollectionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Slug, validation.Required.Error("slug is required")),
+	)
+}
+
+type GetCollectionOutputDTO struct {
+	ID          string                     `json:"id"`
+	Slug        string                     `json:"slug"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	ArtworkURL  string                     `json:"artwork_url,omitempty"`
+	Items       []catalog.ContentOutputDTO `json:"items"`
+}
+
+type GetCollectionUseCase struct {
+	collectionRepo  collection.Repository
+	contentRepo     content.Repository
+	profileRepo     profile.Repository
+	ratingRepo      rating.Repository
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewGetCollectionUseCase(
+	collectionRepo collection.Repository,
+	contentRepo content.Repository,
+	profileRepo profile.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *GetCollectionUseCase {
+	return &GetCollectionUseCase{
+		collectionRepo:  collectionRepo,
+		contentRepo:     contentRepo,
+		profileRepo:     profileRepo,
+		ratingRepo:      ratingRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *GetCollectionUseCase) Execute(ctx context.Context, input GetCollectionInputDTO) (*GetCollectionOutputDTO, error) {
+	collectionEntity, err := findCollection(ctx, uc.collectionRepo, input.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if !collectionEntity.IsPublishedAt(time.Now()) {
+		return nil, fault.New(
+			"collection not found",
+			fault.WithKind(fault.KindNotFound),
+		)
+	}
+
+	maxLevel, err := catalog.MaxMaturityLevel(ctx, uc.profileRepo, input.ProfileID, input.ProfilePIN)
+	if err != nil {
+		return nil, err
+	}
+
+	contentIDs := collectionEntity.ContentIDs()
+	items, err := catalog.ItemsByIDs(ctx, uc.contentRepo, uc.ratingRepo, uc.translationRepo, input.ProfileID, input.Locales, maxLevel, contentIDs, len(contentIDs))
+	if err != nil {
+		uc.logger.Error("Failed to load collection contents", "slug", input.Slug, "error", err)
+		return nil, fault.New(
+			"failed to load collection contents",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := &GetCollectionOutputDTO{
+		ID:          collectionEntity.ID(),
+		Slug:        collectionEntity.Slug(),
+		Title:       collectionEntity.Title(),
+		Description: collectionEntity.Description(),
+		Items:       items,
+	}
+	if collectionEntity.Artwork() != nil {
+		output.ArtworkURL = collectionEntity.Artwork().URL()
+	}
+	return output, nil
+}
@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListCollectionsInputDTO struct {
+	Page     int
+	PageSize int
+}
+
+func (req ListCollectionsInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Page, validation.Min(1)),
+		validation.Field(&req.PageSize, validation.Min(1), validation.Max(100)),
+	)
+}
+
+type ListCollectionsOutputDTO struct {
+	Items    []CollectionOutputDTO `json:"items"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+	Total    int                   `json:"total"`
+}
+
+type ListCollectionsUseCase struct {
+	collectionRepo collection.Repository
+	logger         *log.Logger
+}
+
+func NewListCollectionsUseCase(collectionRepo collection.Repository, logger *log.Logger) *ListCollectionsUseCase {
+	return &ListCollectionsUseCase{
+		collectionRepo: collectionRepo,
+		logger:         logger,
+	}
+}
+
+func (uc *ListCollectionsUseCase) Execute(ctx context.Context, input ListCollectionsInputDTO) (*ListCollectionsOutputDTO, error) {
+	collections, total, err := uc.collectionRepo.List(ctx, (input.Page-1)*input.PageSize, input.PageSize)
+	if err != nil {
+		uc.logger.Error("Failed to list collections", "error", err)
+		return nil, fault.New(
+			"failed to list collections",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	now := time.Now()
+	items := make([]CollectionOutputDTO, 0, len(collections))
+	for _, collectionEntity := range collections {
+		items = append(items, newCollectionOutputDTO(collectionEntity, now))
+	}
+
+	return &ListCollectionsOutputDTO{
+		Items:    items,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package collection
+
+import (
+	"context"
+	"mime/multipart"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetCollectionArtworkInputDTO struct {
+	Slug    string
+	Artwork *multipart.FileHeader
+}
+
+func (req SetCollectionArtworkInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Slug, validation.Required.Error("slug is required")),
+		validation.Field(&req.Artwork, validation.Required.Error("artwork file is required")),
+	)
+}
+
+type SetCollectionArtworkUseCase struct {
+	collectionRepo collection.Repository
+	mediaService   media.MediaService
+	logger         *log.Logger
+}
+
+func NewSetCollectionArtworkUseCase(collectionRepo collection.Repository, mediaService media.MediaService, logger *log.Logger) *SetCollectionArtworkUseCase {
+	return &SetCollectionArtworkUseCase{
+		collectionRepo: collectionRepo,
+		mediaService:   mediaService,
+		logger:         logger,
+	}
+}
+
+func (uc *SetCollectionArtworkUseCase) Execute(ctx context.Context, input SetCollectionArtworkInputDTO) (*CollectionOutputDTO, error) {
+	collectionEntity, err := findCollection(ctx, uc.collectionRepo, input.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	artworkInfo, err := uc.mediaService.Store(input.Artwork, "upload/artworks")
+	if err != nil {
+		uc.logger.Error("Failed to store artwork", "filename", input.Artwork.Filename, "error", err)
+		return nil, fault.New(
+			"error while saving artwork",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	artworkEntity, err := thumbnail.NewThumbnail(artworkInfo.URL)
+	if err != nil {
+		return nil, fault.New(
+			"invalid input for artwork",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+	if err := collectionEntity.SetArtwork(artworkEntity); err != nil {
+		return nil, fault.New(
+			"failed to set collection artwork",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.collectionRepo.Save(ctx, collectionEntity); err != nil {
+		uc.logger.Error("Failed to save collection", "slug", input.Slug, "error", err)
+		return nil, fault.New(
+			"failed to save collection",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newCollectionOutputDTO(collectionEntity, time.Now())
+	return &output, nil
+}
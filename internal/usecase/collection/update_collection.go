@@ -0,0 +1,74 @@
+package collection
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/collection"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type UpdateCollectionInputDTO struct {
+	Slug         string     `json:"-"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	ContentIDs   []string   `json:"content_ids"`
+	PublishFrom  *time.Time `json:"publish_from"`
+	PublishUntil *time.Time `json:"publish_until"`
+}
+
+func (req UpdateCollectionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Slug, validation.Required.Error("slug is required")),
+		validation.Field(&req.Title, validation.Required.Error("title is required"), validation.Length(1, 255)),
+		validation.Field(&req.ContentIDs, validation.Length(0, collection.MaxContents)),
+	)
+}
+
+type UpdateCollectionUseCase struct {
+	collectionRepo collection.Repository
+	contentRepo    content.Repository
+	logger         *log.Logger
+}
+
+func NewUpdateCollectionUseCase(collectionRepo collection.Repository, contentRepo content.Repository, logger *log.Logger) *UpdateCollectionUseCase {
+	return &UpdateCollectionUseCase{
+		collectionRepo: collectionRepo,
+		contentRepo:    contentRepo,
+		logger:         logger,
+	}
+}
+
+func (uc *UpdateCollectionUseCase) Execute(ctx context.Context, input UpdateCollectionInputDTO) (*CollectionOutputDTO, error) {
+	collectionEntity, err := findCollection(ctx, uc.collectionRepo, input.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := collectionEntity.Update(input.Title, input.Description, input.PublishFrom, input.PublishUntil, input.ContentIDs); err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := ensureContentsExist(ctx, uc.contentRepo, collectionEntity.ContentIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.collectionRepo.Save(ctx, collectionEntity); err != nil {
+		uc.logger.Error("Failed to save collection", "slug", input.Slug, "error", err)
+		return nil, fault.New(
+			"failed to save collection",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newCollectionOutputDTO(collectionEntity, time.Now())
+	return &output, nil
+}
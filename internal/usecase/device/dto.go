@@ -0,0 +1,51 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeviceOutputDTO struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+func newDeviceOutputDTO(d *device.Device, currentDeviceID string) DeviceOutputDTO {
+	return DeviceOutputDTO{
+		ID:         d.ID(),
+		Type:       string(d.Type()),
+		Name:       d.Name(),
+		UserAgent:  d.UserAgent(),
+		CreatedAt:  d.CreatedAt(),
+		LastSeenAt: d.LastSeenAt(),
+		Current:    d.ID() == currentDeviceID,
+	}
+}
+
+func findDevice(ctx context.Context, deviceRepo device.Repository, accountID, deviceID string) (*device.Device, error) {
+	d, err := deviceRepo.FindByID(ctx, deviceID)
+	if errors.Is(err, device.ErrNotFound) || (err == nil && !d.BelongsTo(accountID)) {
+		return nil, fault.New(
+			"device not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return nil, fault.New(
+			"failed to load device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return d, nil
+}
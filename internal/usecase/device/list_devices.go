@@ -0,0 +1,55 @@
+package device
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListDevicesInputDTO struct {
+	AccountID string
+	DeviceID  string
+}
+
+func (req ListDevicesInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type ListDevicesOutputDTO struct {
+	Items []DeviceOutputDTO `json:"items"`
+}
+
+type ListDevicesUseCase struct {
+	deviceRepo device.Repository
+	logger     *log.Logger
+}
+
+func NewListDevicesUseCase(deviceRepo device.Repository, logger *log.Logger) *ListDevicesUseCase {
+	return &ListDevicesUseCase{
+		deviceRepo: deviceRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *ListDevicesUseCase) Execute(ctx context.Context, input ListDevicesInputDTO) (*ListDevicesOutputDTO, error) {
+	devices, err := uc.deviceRepo.ListActive(ctx, input.AccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list devices", "accountID", input.AccountID, "error", err)
+		return nil, fault.New(
+			"failed to list devices",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]DeviceOutputDTO, 0, len(devices))
+	for _, d := range devices {
+		items = append(items, newDeviceOutputDTO(d, input.DeviceID))
+	}
+	return &ListDevicesOutputDTO{Items: items}, nil
+}
@@ -0,0 +1,48 @@
+package device
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ResolveDeviceInputDTO struct {
+	AccountID string
+	DeviceID  string
+}
+
+type ResolveDeviceUseCase struct {
+	deviceRepo device.Repository
+	logger     *log.Logger
+}
+
+func NewResolveDeviceUseCase(deviceRepo device.Repository, logger *log.Logger) *ResolveDeviceUseCase {
+	return &ResolveDeviceUseCase{
+		deviceRepo: deviceRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *ResolveDeviceUseCase) Execute(ctx context.Context, input ResolveDeviceInputDTO) error {
+	d, err := uc.deviceRepo.FindByID(ctx, input.DeviceID)
+	if err != nil && !errors.Is(err, device.ErrNotFound) {
+		return fault.New(
+			"failed to load device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if err != nil || !d.BelongsTo(input.AccountID) || d.IsSignedOut() {
+		uc.logger.Warn("Rejected token of signed out device", "accountID", input.AccountID, "deviceID", input.DeviceID)
+		return fault.New(
+			"device has been signed out",
+			fault.WithKind(fault.KindUnauthenticated),
+			fault.WithError(err),
+			fault.WithCode("device_signed_out"),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SignOutDeviceInputDTO struct {
+	AccountID string
+	DeviceID  string
+}
+
+func (req SignOutDeviceInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.DeviceID, validation.Required.Error("deviceID is required")),
+	)
+}
+
+type SignOutDeviceUseCase struct {
+	deviceRepo  device.Repository
+	sessionRepo playback.SessionRepository
+	licenseRepo download.Repository
+	logger      *log.Logger
+}
+
+func NewSignOutDeviceUseCase(deviceRepo device.Repository, sessionRepo playback.SessionRepository, licenseRepo download.Repository, logger *log.Logger) *SignOutDeviceUseCase {
+	return &SignOutDeviceUseCase{
+		deviceRepo:  deviceRepo,
+		sessionRepo: sessionRepo,
+		licenseRepo: licenseRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *SignOutDeviceUseCase) Execute(ctx context.Context, input SignOutDeviceInputDTO) error {
+	d, err := findDevice(ctx, uc.deviceRepo, input.AccountID, input.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := d.SignOut(now); errors.Is(err, device.ErrSignedOut) {
+		return nil
+	}
+
+	if err := uc.deviceRepo.Save(ctx, d); err != nil {
+		uc.logger.Error("Failed to sign out device", "deviceID", input.DeviceID, "error", err)
+		return fault.New(
+			"failed to sign out device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	ended, err := uc.sessionRepo.EndByDevice(ctx, d.ID(), playback.SignedOutReason, now)
+	if err != nil {
+		uc.logger.Error("Failed to end playback sessions of device", "deviceID", input.DeviceID, "error", err)
+		return fault.New(
+			"failed to end playback sessions of device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	revoked, err := uc.licenseRepo.RevokeByDevice(ctx, d.ID(), download.DeviceSignedOutReason, now)
+	if err != nil {
+		uc.logger.Error("Failed to revoke download licenses of device", "deviceID", input.DeviceID, "error", err)
+		return fault.New(
+			"failed to revoke download licenses of device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Device signed out", "accountID", input.AccountID, "deviceID", input.DeviceID, "endedSessions", ended, "revokedDownloads", revoked)
+	return nil
+}
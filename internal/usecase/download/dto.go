@@ -0,0 +1,118 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type LicenseOutputDTO struct {
+	ID          string    `json:"id"`
+	VideoID     string    `json:"video_id"`
+	DeviceID    string    `json:"device_id"`
+	Height      int       `json:"height"`
+	IssuedAt    time.Time `json:"issued_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	DownloadURL string    `json:"download_url"`
+}
+
+func newLicenseOutputDTO(license *download.License, urlSigner auth.URLSigner) LicenseOutputDTO {
+	downloadURL := "/me/downloads/" + license.ID() + "/file"
+	if signed, _, err := urlSigner.Sign(downloadURL, auth.Claims{AccountID: license.AccountID(), ProfileID: license.ProfileID()}); err == nil {
+		downloadURL = signed
+	}
+	return LicenseOutputDTO{
+		ID:          license.ID(),
+		VideoID:     license.VideoID(),
+		DeviceID:    license.DeviceID(),
+		Height:      license.Height(),
+		IssuedAt:    license.IssuedAt(),
+		ExpiresAt:   license.ExpiresAt(),
+		DownloadURL: downloadURL,
+	}
+}
+
+func licenseExpiry(now time.Time, ttl time.Duration, entitlement *subscription.EntitlementOutputDTO) time.Time {
+	expiresAt := now.Add(ttl)
+	if entitlement.PeriodEnd.Before(expiresAt) {
+		return entitlement.PeriodEnd
+	}
+	return expiresAt
+}
+
+func findLicense(ctx context.Context, licenseRepo download.Repository, accountID, licenseID string) (*download.License, error) {
+	license, err := licenseRepo.FindByID(ctx, licenseID)
+	if errors.Is(err, download.ErrNotFound) || (err == nil && license.AccountID() != accountID) {
+		return nil, fault.New(
+			"download license not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return nil, fault.New(
+			"failed to load download license",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return license, nil
+}
+
+func reconcile(
+	ctx context.Context,
+	licenseRepo download.Repository,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	accountID string,
+	now time.Time,
+) (*subscription.EntitlementOutputDTO, []*download.License, error) {
+	entitlement, entitlementErr := checkEntitlementUseCase.Execute(ctx, subscription.CheckEntitlementInputDTO{AccountID: accountID})
+	var faultErr *fault.Error
+	if entitlementErr != nil && (!errors.As(entitlementErr, &faultErr) || faultErr.Kind != fault.KindPaymentRequired) {
+		return nil, nil, entitlementErr
+	}
+
+	active, err := licenseRepo.ListActive(ctx, accountID, now)
+	if err != nil {
+		return nil, nil, fault.New(
+			"failed to list download licenses",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	kept := make([]*download.License, 0, len(active))
+	for _, license := range active {
+		var reason download.RevokeReason
+		switch {
+		case entitlement == nil:
+			reason = download.SubscriptionEndedReason
+		case license.Height() > entitlement.MaxHeight || len(kept) >= entitlement.MaxDownloads:
+			reason = download.PlanChangedReason
+		default:
+			kept = append(kept, license)
+			continue
+		}
+
+		if err := license.Revoke(reason, now); err != nil {
+			continue
+		}
+		if err := licenseRepo.Save(ctx, license); err != nil {
+			return nil, nil, fault.New(
+				"failed to revoke download license",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+	}
+
+	if entitlementErr != nil {
+		return nil, nil, entitlementErr
+	}
+	return entitlement, kept, nil
+}
@@ -0,0 +1,104 @@
+package download
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const downloadsFolder = "upload/downloads"
+
+type GetDownloadFileInputDTO struct {
+	AccountID string
+	LicenseID string
+}
+
+func (req GetDownloadFileInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.LicenseID, validation.Required.Error("licenseID is required")),
+	)
+}
+
+type GetDownloadFileOutputDTO struct {
+	FilePath string
+}
+
+type GetDownloadFileUseCase struct {
+	licenseRepo             download.Repository
+	videoRepo               video.Repository
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	mediaService            media.MediaService
+	logger                  *log.Logger
+}
+
+func NewGetDownloadFileUseCase(
+	licenseRepo download.Repository,
+	videoRepo video.Repository,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	mediaService media.MediaService,
+	logger *log.Logger,
+) *GetDownloadFileUseCase {
+	return &GetDownloadFileUseCase{
+		licenseRepo:             licenseRepo,
+		videoRepo:               videoRepo,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		mediaService:            mediaService,
+		logger:                  logger,
+	}
+}
+
+func (uc *GetDownloadFileUseCase) Execute(ctx context.Context, input GetDownloadFileInputDTO) (*GetDownloadFileOutputDTO, error) {
+	_, active, err := reconcile(ctx, uc.licenseRepo, uc.checkEntitlementUseCase, input.AccountID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	license, err := findLicense(ctx, uc.licenseRepo, input.AccountID, input.LicenseID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(active, func(l *download.License) bool { return l.ID() == license.ID() }) {
+		return nil, fault.New(
+			"download license is no longer active",
+			fault.WithKind(fault.KindConflict),
+		)
+	}
+
+	videoEntity, err := uc.videoRepo.FindByID(ctx, license.VideoID())
+	if err != nil {
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	srcPath := strings.TrimPrefix(videoEntity.URL(), "/")
+	if license.Height() == 0 || license.Height() >= videoEntity.Height() {
+		return &GetDownloadFileOutputDTO{FilePath: srcPath}, nil
+	}
+
+	rendition, err := uc.mediaService.Rendition(srcPath, downloadsFolder, license.Height())
+	if err != nil {
+		uc.logger.Error("Failed to prepare download rendition", "licenseID", license.ID(), "videoID", license.VideoID(), "height", license.Height(), "error", err)
+		return nil, fault.New(
+			"failed to prepare download",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &GetDownloadFileOutputDTO{
+		FilePath: strings.TrimPrefix(rendition.URL, "/"),
+	}, nil
+}
@@ -0,0 +1,153 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	domainvideo "github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/internal/usecase/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type IssueLicenseInputDTO struct {
+	VideoID    string `json:"video_id"`
+	MaxHeight  int    `json:"max_height"`
+	AccountID  string `json:"-"`
+	ProfileID  string `json:"-"`
+	DeviceID   string `json:"-"`
+	ProfilePIN string `json:"-"`
+	Country    string `json:"-"`
+}
+
+func (req IssueLicenseInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("video_id is required")),
+		validation.Field(&req.MaxHeight, validation.Min(0)),
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.DeviceID, validation.Required.Error("deviceID is required")),
+	)
+}
+
+type IssueLicenseUseCase struct {
+	licenseRepo             download.Repository
+	videoRepo               domainvideo.Repository
+	getStreamInfoUseCase    *video.GetStreamInfoUseCase
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	urlSigner               auth.URLSigner
+	ttl                     time.Duration
+	logger                  *log.Logger
+}
+
+func NewIssueLicenseUseCase(
+	licenseRepo download.Repository,
+	videoRepo domainvideo.Repository,
+	getStreamInfoUseCase *video.GetStreamInfoUseCase,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	urlSigner auth.URLSigner,
+	ttl time.Duration,
+	logger *log.Logger,
+) *IssueLicenseUseCase {
+	return &IssueLicenseUseCase{
+		licenseRepo:             licenseRepo,
+		videoRepo:               videoRepo,
+		getStreamInfoUseCase:    getStreamInfoUseCase,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		urlSigner:               urlSigner,
+		ttl:                     ttl,
+		logger:                  logger,
+	}
+}
+
+func (uc *IssueLicenseUseCase) Execute(ctx context.Context, input IssueLicenseInputDTO) (*LicenseOutputDTO, error) {
+	now := time.Now()
+
+	// Reconciling first frees the slots of licenses the plan no longer
+	// covers before the new one is counted.
+	entitlement, _, err := reconcile(ctx, uc.licenseRepo, uc.checkEntitlementUseCase, input.AccountID, now)
+	if err != nil {
+		return nil, err
+	}
+	if entitlement.MaxDownloads == 0 {
+		return nil, fault.New(
+			"downloads are not included in your plan",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+
+	streamInfo, err := uc.getStreamInfoUseCase.Execute(ctx, video.GetStreamInfoInputDTO{
+		VideoID:    input.VideoID,
+		AccountID:  input.AccountID,
+		ProfileID:  input.ProfileID,
+		ProfilePIN: input.ProfilePIN,
+		Country:    input.Country,
+		Offline:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if streamInfo.Entitlement == nil {
+		return nil, fault.New(
+			"video cannot be downloaded",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+
+	videoEntity, err := uc.videoRepo.FindByID(ctx, input.VideoID)
+	if err != nil {
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	height := min(videoEntity.Height(), entitlement.MaxHeight)
+	if input.MaxHeight > 0 {
+		height = min(height, input.MaxHeight)
+	}
+
+	license, err := download.NewLicense(
+		input.AccountID,
+		input.ProfileID,
+		input.DeviceID,
+		input.VideoID,
+		height,
+		now,
+		licenseExpiry(now, uc.ttl, entitlement),
+	)
+	if err != nil {
+		return nil, fault.New(
+			"failed to create download license",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.licenseRepo.Issue(ctx, license, entitlement.MaxDownloads, now); err != nil {
+		if errors.Is(err, download.ErrTooManyDownloads) {
+			uc.logger.Warn("Rejected download above plan limit", "accountID", input.AccountID, "plan", entitlement.PlanCode, "maxDownloads", entitlement.MaxDownloads)
+			return nil, fault.New(
+				"maximum number of downloads reached for your plan",
+				fault.WithKind(fault.KindLimitExceeded),
+				fault.WithError(err),
+				fault.WithCode("too_many_downloads"),
+			)
+		}
+		uc.logger.Error("Failed to issue download license", "accountID", input.AccountID, "videoID", input.VideoID, "error", err)
+		return nil, fault.New(
+			"failed to issue download license",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Download license issued", "licenseID", license.ID(), "accountID", input.AccountID, "videoID", input.VideoID, "height", height)
+	output := newLicenseOutputDTO(license, uc.urlSigner)
+	return &output, nil
+}
@@ -0,0 +1,59 @@
+package download
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+)
+
+type ListLicensesInputDTO struct {
+	AccountID string
+}
+
+func (req ListLicensesInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type ListLicensesOutputDTO struct {
+	Items        []LicenseOutputDTO `json:"items"`
+	MaxDownloads int                `json:"max_downloads"`
+}
+
+type ListLicensesUseCase struct {
+	licenseRepo             download.Repository
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	urlSigner               auth.URLSigner
+	logger                  *log.Logger
+}
+
+func NewListLicensesUseCase(licenseRepo download.Repository, checkEntitlementUseCase *subscription.CheckEntitlementUseCase, urlSigner auth.URLSigner, logger *log.Logger) *ListLicensesUseCase {
+	return &ListLicensesUseCase{
+		licenseRepo:             licenseRepo,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		urlSigner:               urlSigner,
+		logger:                  logger,
+	}
+}
+
+func (uc *ListLicensesUseCase) Execute(ctx context.Context, input ListLicensesInputDTO) (*ListLicensesOutputDTO, error) {
+	entitlement, licenses, err := reconcile(ctx, uc.licenseRepo, uc.checkEntitlementUseCase, input.AccountID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]LicenseOutputDTO, 0, len(licenses))
+	for _, license := range licenses {
+		items = append(items, newLicenseOutputDTO(license, uc.urlSigner))
+	}
+	return &ListLicensesOutputDTO{
+		Items:        items,
+		MaxDownloads: entitlement.MaxDownloads,
+	}, nil
+}
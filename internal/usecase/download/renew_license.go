@@ -0,0 +1,113 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RenewLicenseInputDTO struct {
+	AccountID string
+	LicenseID string
+}
+
+func (req RenewLicenseInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.LicenseID, validation.Required.Error("licenseID is required")),
+	)
+}
+
+type RenewLicenseUseCase struct {
+	licenseRepo             download.Repository
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	urlSigner               auth.URLSigner
+	ttl                     time.Duration
+	logger                  *log.Logger
+}
+
+func NewRenewLicenseUseCase(
+	licenseRepo download.Repository,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	urlSigner auth.URLSigner,
+	ttl time.Duration,
+	logger *log.Logger,
+) *RenewLicenseUseCase {
+	return &RenewLicenseUseCase{
+		licenseRepo:             licenseRepo,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		urlSigner:               urlSigner,
+		ttl:                     ttl,
+		logger:                  logger,
+	}
+}
+
+func (uc *RenewLicenseUseCase) Execute(ctx context.Context, input RenewLicenseInputDTO) (*LicenseOutputDTO, error) {
+	now := time.Now()
+
+	entitlement, active, err := reconcile(ctx, uc.licenseRepo, uc.checkEntitlementUseCase, input.AccountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	license, err := findLicense(ctx, uc.licenseRepo, input.AccountID, input.LicenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Licenses that lapsed offline are not listed as active, but may be
+	// renewed while the plan still covers them and a slot is free.
+	isActive := slices.ContainsFunc(active, func(l *download.License) bool { return l.ID() == license.ID() })
+	if license.RevokedAt() == nil && !isActive {
+		if license.Height() > entitlement.MaxHeight {
+			license.Revoke(download.PlanChangedReason, now)
+			if err := uc.licenseRepo.Save(ctx, license); err != nil {
+				return nil, fault.New(
+					"failed to revoke download license",
+					fault.WithKind(fault.KindUnexpected),
+					fault.WithError(err),
+				)
+			}
+		} else if len(active) >= entitlement.MaxDownloads {
+			return nil, fault.New(
+				"maximum number of downloads reached for your plan",
+				fault.WithKind(fault.KindLimitExceeded),
+				fault.WithCode("too_many_downloads"),
+			)
+		}
+	}
+
+	if err := license.Renew(now, licenseExpiry(now, uc.ttl, entitlement)); err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, download.ErrLicenseRevoked) {
+			uc.logger.Info("Refused renewal of revoked download license", "licenseID", license.ID(), "reason", license.RevokeReason())
+			kind = fault.KindConflict
+		}
+		return nil, fault.New(
+			"download license cannot be renewed",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.licenseRepo.Save(ctx, license); err != nil {
+		uc.logger.Error("Failed to renew download license", "licenseID", license.ID(), "error", err)
+		return nil, fault.New(
+			"failed to renew download license",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Download license renewed", "licenseID", license.ID(), "expiresAt", license.ExpiresAt())
+	output := newLicenseOutputDTO(license, uc.urlSigner)
+	return &output, nil
+}
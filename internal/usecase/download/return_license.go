@@ -0,0 +1,59 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/download"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ReturnLicenseInputDTO struct {
+	AccountID string
+	LicenseID string
+}
+
+func (req ReturnLicenseInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.LicenseID, validation.Required.Error("licenseID is required")),
+	)
+}
+
+type ReturnLicenseUseCase struct {
+	licenseRepo download.Repository
+	logger      *log.Logger
+}
+
+func NewReturnLicenseUseCase(licenseRepo download.Repository, logger *log.Logger) *ReturnLicenseUseCase {
+	return &ReturnLicenseUseCase{
+		licenseRepo: licenseRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ReturnLicenseUseCase) Execute(ctx context.Context, input ReturnLicenseInputDTO) error {
+	license, err := findLicense(ctx, uc.licenseRepo, input.AccountID, input.LicenseID)
+	if err != nil {
+		return err
+	}
+
+	if err := license.Revoke(download.ReturnedReason, time.Now()); errors.Is(err, download.ErrLicenseRevoked) {
+		return nil
+	}
+
+	if err := uc.licenseRepo.Save(ctx, license); err != nil {
+		uc.logger.Error("Failed to return download license", "licenseID", input.LicenseID, "error", err)
+		return fault.New(
+			"failed to return download license",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Download license returned", "accountID", input.AccountID, "licenseID", input.LicenseID)
+	return nil
+}
@@ -0,0 +1,91 @@
+package extra
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type AddTrailerInputDTO struct {
+	ContentID string
+	Title     string
+	Video     *multipart.FileHeader
+}
+
+func (req AddTrailerInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Title, validation.Length(0, 255)),
+		validation.Field(&req.Video, validation.Required.Error("video file is required")),
+	)
+}
+
+type AddTrailerUseCase struct {
+	extraRepo    extra.Repository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewAddTrailerUseCase(extraRepo extra.Repository, mediaService media.MediaService, logger *log.Logger) *AddTrailerUseCase {
+	return &AddTrailerUseCase{
+		extraRepo:    extraRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *AddTrailerUseCase) Execute(ctx context.Context, input AddTrailerInputDTO) (*ExtraOutputDTO, error) {
+	videoInfo, err := uc.mediaService.Store(input.Video, "upload/videos")
+	if err != nil {
+		uc.logger.Error("Failed to store trailer video", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to store trailer video",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	videoEntity, err := video.NewVideo(videoInfo.URL, videoInfo.SizeInKb, videoInfo.Duration, videoInfo.Height)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	return saveExtra(ctx, uc.extraRepo, input.ContentID, extra.TrailerKind, input.Title, videoEntity)
+}
+
+func saveExtra(ctx context.Context, extraRepo extra.Repository, contentID string, kind extra.Kind, title string, videoEntity *video.Video) (*ExtraOutputDTO, error) {
+	extraEntity, err := extra.NewExtra(contentID, kind, title, videoEntity)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := extraRepo.Save(ctx, extraEntity); err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, extra.ErrContentNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"failed to save extra",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	output := newExtraOutputDTO(extraEntity)
+	return &output, nil
+}
@@ -0,0 +1,64 @@
+package extra
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteExtraInputDTO struct {
+	ContentID string
+	ExtraID   string
+}
+
+func (req DeleteExtraInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.ExtraID, validation.Required.Error("extraID is required")),
+	)
+}
+
+type DeleteExtraUseCase struct {
+	extraRepo extra.Repository
+	logger    *log.Logger
+}
+
+func NewDeleteExtraUseCase(extraRepo extra.Repository, logger *log.Logger) *DeleteExtraUseCase {
+	return &DeleteExtraUseCase{
+		extraRepo: extraRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *DeleteExtraUseCase) Execute(ctx context.Context, input DeleteExtraInputDTO) error {
+	extraEntity, err := uc.extraRepo.FindByID(ctx, input.ExtraID)
+	if errors.Is(err, extra.ErrNotFound) || (err == nil && extraEntity.ContentID() != input.ContentID) {
+		return fault.New(
+			"extra not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return fault.New(
+			"failed to load extra",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.extraRepo.Delete(ctx, input.ExtraID); err != nil {
+		return fault.New(
+			"failed to delete extra",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Extra deleted", "contentID", input.ContentID, "extraID", input.ExtraID)
+	return nil
+}
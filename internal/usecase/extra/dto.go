@@ -0,0 +1,31 @@
+package extra
+
+import (
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+)
+
+type ExtraOutputDTO struct {
+	ID        string    `json:"id"`
+	ContentID string    `json:"content_id"`
+	Kind      string    `json:"kind"`
+	Title     string    `json:"title"`
+	VideoID   string    `json:"video_id"`
+	Duration  int       `json:"duration"`
+	StreamURL string    `json:"stream_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newExtraOutputDTO(e *extra.Extra) ExtraOutputDTO {
+	return ExtraOutputDTO{
+		ID:        e.ID(),
+		ContentID: e.ContentID(),
+		Kind:      string(e.Kind()),
+		Title:     e.Title(),
+		VideoID:   e.Video().ID(),
+		Duration:  e.Video().Duration(),
+		StreamURL: "/videos/" + e.Video().ID() + "/stream",
+		CreatedAt: e.CreatedAt(),
+	}
+}
@@ -0,0 +1,137 @@
+package extra
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/episode"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GeneratePreviewInputDTO struct {
+	ContentID     string `json:"-"`
+	OffsetSeconds *int   `json:"offset_seconds"`
+	LengthSeconds int    `json:"length_seconds"`
+}
+
+func (req GeneratePreviewInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.OffsetSeconds, validation.Min(0)),
+		validation.Field(&req.LengthSeconds, validation.Min(1), validation.Max(extra.MaxPreviewSeconds)),
+	)
+}
+
+type GeneratePreviewUseCase struct {
+	contentRepo  content.Repository
+	extraRepo    extra.Repository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewGeneratePreviewUseCase(contentRepo content.Repository, extraRepo extra.Repository, mediaService media.MediaService, logger *log.Logger) *GeneratePreviewUseCase {
+	return &GeneratePreviewUseCase{
+		contentRepo:  contentRepo,
+		extraRepo:    extraRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *GeneratePreviewUseCase) Execute(ctx context.Context, input GeneratePreviewInputDTO) (*ExtraOutputDTO, error) {
+	contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, content.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	source := mainVideo(contentEntity)
+	if source == nil {
+		return nil, fault.New(
+			"content has no video to preview",
+			fault.WithKind(fault.KindConflict),
+		)
+	}
+	sourcePath := strings.TrimPrefix(source.URL(), "/")
+
+	length := input.LengthSeconds
+	if length == 0 {
+		length = extra.DefaultPreviewSeconds
+	}
+
+	var offset int
+	if input.OffsetSeconds != nil {
+		if *input.OffsetSeconds >= source.Duration() {
+			return nil, fault.New(
+				"offset_seconds is past the end of the video",
+				fault.WithKind(fault.KindValidation),
+			)
+		}
+		offset = *input.OffsetSeconds
+	} else {
+		// Detection is best effort: without scene changes the offset
+		// falls back to a fixed point in the video.
+		changes, err := uc.mediaService.SceneChanges(sourcePath)
+		if err != nil {
+			uc.logger.Warn("Could not detect scene changes", "contentID", input.ContentID, "error", err)
+		}
+		offset = extra.PreviewOffset(changes, source.Duration(), length)
+	}
+
+	clipInfo, err := uc.mediaService.CutClip(sourcePath, "upload/previews", offset, length)
+	if err != nil {
+		uc.logger.Error("Failed to cut preview", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to cut preview",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	videoEntity, err := video.NewVideo(clipInfo.URL, max(clipInfo.SizeInKb, 1), max(clipInfo.Duration, 1), clipInfo.Height)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Preview generated", "contentID", input.ContentID, "offset", offset, "length", length)
+	return saveExtra(ctx, uc.extraRepo, input.ContentID, extra.PreviewKind, "", videoEntity)
+}
+
+func mainVideo(contentEntity *content.Content) *video.Video {
+	if mov, err := contentEntity.Movie(); err == nil && mov != nil {
+		return mov.Video()
+	}
+	show, err := contentEntity.TvShow()
+	if err != nil || show == nil {
+		return nil
+	}
+
+	var first *episode.Episode
+	for _, ep := range show.Episodes() {
+		if first == nil || ep.Season() < first.Season() || (ep.Season() == first.Season() && ep.Number() < first.Number()) {
+			first = ep
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	return first.Video()
+}
@@ -0,0 +1,53 @@
+package extra
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListExtrasInputDTO struct {
+	ContentID string
+}
+
+func (req ListExtrasInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+	)
+}
+
+type ListExtrasOutputDTO struct {
+	Items []ExtraOutputDTO `json:"items"`
+}
+
+type ListExtrasUseCase struct {
+	extraRepo extra.Repository
+	logger    *log.Logger
+}
+
+func NewListExtrasUseCase(extraRepo extra.Repository, logger *log.Logger) *ListExtrasUseCase {
+	return &ListExtrasUseCase{
+		extraRepo: extraRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *ListExtrasUseCase) Execute(ctx context.Context, input ListExtrasInputDTO) (*ListExtrasOutputDTO, error) {
+	extras, err := uc.extraRepo.ListByContentID(ctx, input.ContentID)
+	if err != nil {
+		return nil, fault.New(
+			"failed to list extras",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]ExtraOutputDTO, 0, len(extras))
+	for _, e := range extras {
+		items = append(items, newExtraOutputDTO(e))
+	}
+	return &ListExtrasOutputDTO{Items: items}, nil
+}
@@ -0,0 +1,89 @@
+package home
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/home"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetHomeInputDTO struct {
+	ProfileID  string
+	ProfilePIN string
+	Country    string
+	Locales    []string
+}
+
+func (req GetHomeInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+	)
+}
+
+type GetHomeOutputDTO struct {
+	Rails []RailOutputDTO `json:"rails"`
+}
+
+type GetHomeUseCase struct {
+	homeRepo home.Repository
+	sources  *railSources
+	logger   *log.Logger
+}
+
+func NewGetHomeUseCase(
+	homeRepo home.Repository,
+	listContinueWatchingUseCase *progress.ListContinueWatchingUseCase,
+	listTrendingUseCase *trending.ListTrendingUseCase,
+	listMyListUseCase *watchlist.ListMyListUseCase,
+	listContentsUseCase *catalog.ListContentsUseCase,
+	logger *log.Logger,
+) *GetHomeUseCase {
+	return &GetHomeUseCase{
+		homeRepo: homeRepo,
+		sources: &railSources{
+			listContinueWatchingUseCase: listContinueWatchingUseCase,
+			listTrendingUseCase:         listTrendingUseCase,
+			listMyListUseCase:           listMyListUseCase,
+			listContentsUseCase:         listContentsUseCase,
+		},
+		logger: logger,
+	}
+}
+
+func (uc *GetHomeUseCase) Execute(ctx context.Context, input GetHomeInputDTO) (*GetHomeOutputDTO, error) {
+	rails, err := uc.homeRepo.ListEnabled(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list home rails", "error", err)
+		return nil, fault.New(
+			"failed to load home",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	v := viewer{
+		profileID:  input.ProfileID,
+		profilePIN: input.ProfilePIN,
+		country:    input.Country,
+		locales:    input.Locales,
+	}
+	output := &GetHomeOutputDTO{Rails: make([]RailOutputDTO, 0, len(rails))}
+	for _, rail := range rails {
+		page, err := uc.sources.page(ctx, rail, v, 0)
+		if err != nil {
+			uc.logger.Warn("Skipping home rail that failed to load", "railID", rail.ID(), "kind", rail.Kind(), "error", err)
+			continue
+		}
+		if len(page.Items) > 0 {
+			output.Rails = append(output.Rails, *page)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,89 @@
+package home
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/home"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetRailPageInputDTO struct {
+	RailID     string
+	Token      string
+	ProfileID  string
+	ProfilePIN string
+	Country    string
+	Locales    []string
+}
+
+func (req GetRailPageInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.RailID, validation.Required.Error("railID is required")),
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+	)
+}
+
+type GetRailPageUseCase struct {
+	homeRepo home.Repository
+	sources  *railSources
+	logger   *log.Logger
+}
+
+func NewGetRailPageUseCase(
+	homeRepo home.Repository,
+	listContinueWatchingUseCase *progress.ListContinueWatchingUseCase,
+	listTrendingUseCase *trending.ListTrendingUseCase,
+	listMyListUseCase *watchlist.ListMyListUseCase,
+	listContentsUseCase *catalog.ListContentsUseCase,
+	logger *log.Logger,
+) *GetRailPageUseCase {
+	return &GetRailPageUseCase{
+		homeRepo: homeRepo,
+		sources: &railSources{
+			listContinueWatchingUseCase: listContinueWatchingUseCase,
+			listTrendingUseCase:         listTrendingUseCase,
+			listMyListUseCase:           listMyListUseCase,
+			listContentsUseCase:         listContentsUseCase,
+		},
+		logger: logger,
+	}
+}
+
+func (uc *GetRailPageUseCase) Execute(ctx context.Context, input GetRailPageInputDTO) (*RailOutputDTO, error) {
+	offset, err := decodeToken(input.Token)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	rail, err := uc.homeRepo.FindByID(ctx, input.RailID)
+	if err != nil || !rail.IsEnabled() {
+		return nil, fault.New(
+			"rail not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	output, err := uc.sources.page(ctx, rail, viewer{
+		profileID:  input.ProfileID,
+		profilePIN: input.ProfilePIN,
+		country:    input.Country,
+		locales:    input.Locales,
+	}, offset)
+	if err != nil {
+		uc.logger.Error("Failed to load home rail", "railID", input.RailID, "error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,180 @@
+package home
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hoyci/fakeflix/internal/domain/home"
+	domaintrending "github.com/hoyci/fakeflix/internal/domain/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/watchlist"
+)
+
+const maxWindow = 100
+
+type ResumeDTO struct {
+	VideoID         string                     `json:"video_id"`
+	Episode         *progress.EpisodeOutputDTO `json:"episode,omitempty"`
+	PositionSeconds int                        `json:"position_seconds"`
+	DurationSeconds int                        `json:"duration_seconds"`
+	NextEpisode     bool                       `json:"next_episode"`
+}
+
+type RailItemDTO struct {
+	Content catalog.ContentOutputDTO `json:"content"`
+	Rank    int                      `json:"rank,omitempty"`
+	Resume  *ResumeDTO               `json:"resume,omitempty"`
+}
+
+type RailOutputDTO struct {
+	ID        string        `json:"id"`
+	Kind      string        `json:"kind"`
+	Title     string        `json:"title"`
+	Items     []RailItemDTO `json:"items"`
+	NextToken string        `json:"next_token,omitempty"`
+}
+
+type viewer struct {
+	profileID  string
+	profilePIN string
+	country    string
+	locales    []string
+}
+
+type railSources struct {
+	listContinueWatchingUseCase *progress.ListContinueWatchingUseCase
+	listTrendingUseCase         *trending.ListTrendingUseCase
+	listMyListUseCase           *watchlist.ListMyListUseCase
+	listContentsUseCase         *catalog.ListContentsUseCase
+}
+
+func (s *railSources) page(ctx context.Context, rail *home.Rail, v viewer, offset int) (*RailOutputDTO, error) {
+	limit := rail.PageSize()
+
+	var items []RailItemDTO
+	var hasMore bool
+	var err error
+
+	switch rail.Kind() {
+	case home.ContinueWatchingRail:
+		items, hasMore, err = s.continueWatching(ctx, v, offset, limit)
+	case home.TrendingRail:
+		items, hasMore, err = s.trending(ctx, v, offset, limit)
+	case home.MyListRail:
+		items, hasMore, err = s.myList(ctx, v, offset, limit)
+	case home.NewReleasesRail, home.GenreRail:
+		items, hasMore, err = s.contents(ctx, v, rail.Genre(), offset, limit)
+	default:
+		err = fmt.Errorf("unsupported rail kind %q", rail.Kind())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RailOutputDTO{
+		ID:    rail.ID(),
+		Kind:  string(rail.Kind()),
+		Title: rail.Title(),
+		Items: items,
+	}
+	if hasMore {
+		output.NextToken = encodeToken(offset + limit)
+	}
+	return output, nil
+}
+
+func (s *railSources) continueWatching(ctx context.Context, v viewer, offset, limit int) ([]RailItemDTO, bool, error) {
+	output, err := s.listContinueWatchingUseCase.Execute(ctx, progress.ListContinueWatchingInputDTO{
+		ProfileID: v.profileID,
+		Locales:   v.locales,
+		Limit:     min(offset+limit+1, maxWindow),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	window, hasMore := slice(output.Items, offset, limit)
+	items := make([]RailItemDTO, 0, len(window))
+	for _, item := range window {
+		items = append(items, RailItemDTO{
+			Content: item.Content,
+			Resume: &ResumeDTO{
+				VideoID:         item.VideoID,
+				Episode:         item.Episode,
+				PositionSeconds: item.PositionSeconds,
+				DurationSeconds: item.DurationSeconds,
+				NextEpisode:     item.NextEpisode,
+			},
+		})
+	}
+	return items, hasMore, nil
+}
+
+func (s *railSources) trending(ctx context.Context, v viewer, offset, limit int) ([]RailItemDTO, bool, error) {
+	output, err := s.listTrendingUseCase.Execute(ctx, trending.ListTrendingInputDTO{
+		Window:     string(domaintrending.Day),
+		ProfileID:  v.profileID,
+		ProfilePIN: v.profilePIN,
+		Locales:    v.locales,
+		Limit:      min(offset+limit+1, maxWindow),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	window, hasMore := slice(output.Items, offset, limit)
+	items := make([]RailItemDTO, 0, len(window))
+	for _, item := range window {
+		items = append(items, RailItemDTO{Content: item.Content, Rank: item.Rank})
+	}
+	return items, hasMore, nil
+}
+
+func (s *railSources) myList(ctx context.Context, v viewer, offset, limit int) ([]RailItemDTO, bool, error) {
+	output, err := s.listMyListUseCase.Execute(ctx, watchlist.ListMyListInputDTO{
+		ProfileID: v.profileID,
+		Locales:   v.locales,
+		Page:      offset/limit + 1,
+		PageSize:  limit,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	items := make([]RailItemDTO, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, RailItemDTO{Content: item.Content})
+	}
+	return items, offset+limit < output.Total, nil
+}
+
+func (s *railSources) contents(ctx context.Context, v viewer, genre string, offset, limit int) ([]RailItemDTO, bool, error) {
+	output, err := s.listContentsUseCase.Execute(ctx, catalog.ListContentsInputDTO{
+		ProfileID:  v.profileID,
+		ProfilePIN: v.profilePIN,
+		Country:    v.country,
+		Locales:    v.locales,
+		Genre:      genre,
+		Page:       offset/limit + 1,
+		PageSize:   limit,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	items := make([]RailItemDTO, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, RailItemDTO{Content: item})
+	}
+	return items, offset+limit < output.Total, nil
+}
+
+func slice[T any](all []T, offset, limit int) ([]T, bool) {
+	if offset >= len(all) {
+		return nil, false
+	}
+	end := min(offset+limit, len(all))
+	return all[offset:end], len(all) > end
+}
@@ -0,0 +1,31 @@
+package home
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const tokenPrefix = "offset:"
+
+var errInvalidToken = errors.New("invalid page token")
+
+func encodeToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(tokenPrefix + strconv.Itoa(offset)))
+}
+
+func decodeToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errInvalidToken
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), tokenPrefix))
+	if err != nil || offset < 0 || !strings.HasPrefix(string(raw), tokenPrefix) {
+		return 0, errInvalidToken
+	}
+	return offset, nil
+}
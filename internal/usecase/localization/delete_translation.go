@@ -0,0 +1,54 @@
+package localization
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteTranslationInputDTO struct {
+	SubjectKind string
+	SubjectID   string
+	Locale      string
+}
+
+func (req DeleteTranslationInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SubjectKind, validation.Required, validation.In(subjectKinds()...)),
+		validation.Field(&req.SubjectID, validation.Required.Error("subject id is required")),
+		validation.Field(&req.Locale, validation.Required.Error("locale is required")),
+	)
+}
+
+type DeleteTranslationUseCase struct {
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewDeleteTranslationUseCase(translationRepo localization.Repository, logger *log.Logger) *DeleteTranslationUseCase {
+	return &DeleteTranslationUseCase{
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *DeleteTranslationUseCase) Execute(ctx context.Context, input DeleteTranslationInputDTO) error {
+	translation, err := findTranslation(ctx, uc.translationRepo, input.SubjectKind, input.SubjectID, input.Locale)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.translationRepo.Delete(ctx, translation.SubjectKind(), translation.SubjectID(), translation.Locale()); err != nil {
+		uc.logger.Error("Failed to delete translation", "subjectID", input.SubjectID, "locale", input.Locale, "error", err)
+		return fault.New(
+			"failed to delete translation",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package localization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type TranslationOutputDTO struct {
+	SubjectKind  string `json:"subject_kind"`
+	SubjectID    string `json:"subject_id"`
+	Locale       string `json:"locale"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+func newTranslationOutputDTO(translation *localization.Translation) TranslationOutputDTO {
+	output := TranslationOutputDTO{
+		SubjectKind: string(translation.SubjectKind()),
+		SubjectID:   translation.SubjectID(),
+		Locale:      translation.Locale(),
+		Title:       translation.Title(),
+		Description: translation.Description(),
+		UpdatedAt:   translation.UpdatedAt().String(),
+	}
+	if translation.Thumbnail() != nil {
+		output.ThumbnailURL = translation.Thumbnail().URL()
+	}
+	return output
+}
+
+func findTranslation(ctx context.Context, translationRepo localization.Repository, subjectKind, subjectID, locale string) (*localization.Translation, error) {
+	normalized, err := localization.NormalizeLocale(locale)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	translation, err := translationRepo.Find(ctx, localization.SubjectKind(subjectKind), subjectID, normalized)
+	if err != nil {
+		if errors.Is(err, localization.ErrNotFound) {
+			return nil, fault.New(
+				"translation not found",
+				fault.WithKind(fault.KindNotFound),
+				fault.WithError(err),
+			)
+		}
+		return nil, fault.New(
+			"failed to load translation",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return translation, nil
+}
+
+func subjectKinds() []any {
+	return []any{string(localization.ContentSubject), string(localization.EpisodeSubject)}
+}
@@ -0,0 +1,57 @@
+package localization
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListTranslationsInputDTO struct {
+	SubjectKind string
+	SubjectID   string
+}
+
+func (req ListTranslationsInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SubjectKind, validation.Required, validation.In(subjectKinds()...)),
+		validation.Field(&req.SubjectID, validation.Required.Error("subject id is required")),
+	)
+}
+
+type ListTranslationsOutputDTO struct {
+	Items []TranslationOutputDTO `json:"items"`
+}
+
+type ListTranslationsUseCase struct {
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewListTranslationsUseCase(translationRepo localization.Repository, logger *log.Logger) *ListTranslationsUseCase {
+	return &ListTranslationsUseCase{
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *ListTranslationsUseCase) Execute(ctx context.Context, input ListTranslationsInputDTO) (*ListTranslationsOutputDTO, error) {
+	translations, err := uc.translationRepo.ListBySubjects(ctx, localization.SubjectKind(input.SubjectKind), []string{input.SubjectID})
+	if err != nil {
+		uc.logger.Error("Failed to list translations", "subjectID", input.SubjectID, "error", err)
+		return nil, fault.New(
+			"failed to list translations",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]TranslationOutputDTO, 0, len(translations))
+	for _, translation := range translations {
+		items = append(items, newTranslationOutputDTO(translation))
+	}
+
+	return &ListTranslationsOutputDTO{Items: items}, nil
+}
@@ -0,0 +1,79 @@
+package localization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetTranslationInputDTO struct {
+	SubjectKind string `json:"-"`
+	SubjectID   string `json:"-"`
+	Locale      string `json:"-"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func (req SetTranslationInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SubjectKind, validation.Required, validation.In(subjectKinds()...)),
+		validation.Field(&req.SubjectID, validation.Required.Error("subject id is required")),
+		validation.Field(&req.Locale, validation.Required.Error("locale is required")),
+		validation.Field(&req.Title, validation.Required.Error("title is required"), validation.Length(1, 255)),
+		validation.Field(&req.Description, validation.Length(0, 5000)),
+	)
+}
+
+type SetTranslationUseCase struct {
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewSetTranslationUseCase(translationRepo localization.Repository, logger *log.Logger) *SetTranslationUseCase {
+	return &SetTranslationUseCase{
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *SetTranslationUseCase) Execute(ctx context.Context, input SetTranslationInputDTO) (*TranslationOutputDTO, error) {
+	translation, err := findTranslation(ctx, uc.translationRepo, input.SubjectKind, input.SubjectID, input.Locale)
+	switch {
+	case err == nil:
+		err = translation.Rewrite(input.Title, input.Description)
+	case errors.Is(err, localization.ErrNotFound):
+		translation, err = localization.NewTranslation(localization.SubjectKind(input.SubjectKind), input.SubjectID, input.Locale, input.Title, input.Description)
+	default:
+		return nil, err
+	}
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.translationRepo.Save(ctx, translation); err != nil {
+		if errors.Is(err, localization.ErrSubjectNotFound) {
+			return nil, fault.New(
+				"subject not found",
+				fault.WithKind(fault.KindNotFound),
+				fault.WithError(err),
+			)
+		}
+		uc.logger.Error("Failed to save translation", "subjectID", input.SubjectID, "locale", input.Locale, "error", err)
+		return nil, fault.New(
+			"failed to save translation",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newTranslationOutputDTO(translation)
+	return &output, nil
+}
@@ -0,0 +1,88 @@
+package localization
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetTranslationThumbnailInputDTO struct {
+	SubjectKind string
+	SubjectID   string
+	Locale      string
+	Thumbnail   *multipart.FileHeader
+}
+
+func (req SetTranslationThumbnailInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SubjectKind, validation.Required, validation.In(subjectKinds()...)),
+		validation.Field(&req.SubjectID, validation.Required.Error("subject id is required")),
+		validation.Field(&req.Locale, validation.Required.Error("locale is required")),
+		validation.Field(&req.Thumbnail, validation.Required.Error("thumbnail file is required")),
+	)
+}
+
+type SetTranslationThumbnailUseCase struct {
+	translationRepo localization.Repository
+	mediaService    media.MediaService
+	logger          *log.Logger
+}
+
+func NewSetTranslationThumbnailUseCase(translationRepo localization.Repository, mediaService media.MediaService, logger *log.Logger) *SetTranslationThumbnailUseCase {
+	return &SetTranslationThumbnailUseCase{
+		translationRepo: translationRepo,
+		mediaService:    mediaService,
+		logger:          logger,
+	}
+}
+
+func (uc *SetTranslationThumbnailUseCase) Execute(ctx context.Context, input SetTranslationThumbnailInputDTO) (*TranslationOutputDTO, error) {
+	translation, err := findTranslation(ctx, uc.translationRepo, input.SubjectKind, input.SubjectID, input.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailInfo, err := uc.mediaService.Store(input.Thumbnail, "upload/thumbs")
+	if err != nil {
+		uc.logger.Error("Failed to store thumbnail", "filename", input.Thumbnail.Filename, "error", err)
+		return nil, fault.New(
+			"error while saving thumbnail",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	thumbnailEntity, err := thumbnail.NewThumbnail(thumbnailInfo.URL)
+	if err != nil {
+		return nil, fault.New(
+			"invalid input for thumbnail",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+	if err := translation.SetThumbnail(thumbnailEntity); err != nil {
+		return nil, fault.New(
+			"failed to set translation thumbnail",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.translationRepo.Save(ctx, translation); err != nil {
+		uc.logger.Error("Failed to save translation", "subjectID", input.SubjectID, "locale", input.Locale, "error", err)
+		return nil, fault.New(
+			"failed to save translation",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newTranslationOutputDTO(translation)
+	return &output, nil
+}
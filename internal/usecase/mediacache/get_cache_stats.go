@@ -0,0 +1,39 @@
+package mediacache
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+)
+
+type GetCacheStatsOutputDTO struct {
+	media.CacheStats
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+type GetCacheStatsUseCase struct {
+	cache  media.Cache
+	logger *log.Logger
+}
+
+func NewGetCacheStatsUseCase(cache media.Cache, logger *log.Logger) *GetCacheStatsUseCase {
+	return &GetCacheStatsUseCase{
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+func (uc *GetCacheStatsUseCase) Execute(ctx context.Context) (*GetCacheStatsOutputDTO, error) {
+	stats := uc.cache.Stats()
+
+	var hitRatio float64
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		hitRatio = float64(stats.Hits) / float64(lookups)
+	}
+
+	return &GetCacheStatsOutputDTO{
+		CacheStats: stats,
+		HitRatio:   hitRatio,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package mediacache
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+)
+
+type PurgeCacheInputDTO struct {
+	Prefix string
+}
+
+type PurgeCacheOutputDTO struct {
+	Purged int `json:"purged"`
+}
+
+type PurgeCacheUseCase struct {
+	cache  media.Cache
+	logger *log.Logger
+}
+
+func NewPurgeCacheUseCase(cache media.Cache, logger *log.Logger) *PurgeCacheUseCase {
+	return &PurgeCacheUseCase{
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+func (uc *PurgeCacheUseCase) Execute(ctx context.Context, input PurgeCacheInputDTO) (*PurgeCacheOutputDTO, error) {
+	return &PurgeCacheOutputDTO{
+		Purged: uc.cache.Purge(input.Prefix),
+	}, nil
+}
@@ -16,16 +16,20 @@ import (
 )
 
 type CreateMovieInputDTO struct {
-	Title       string
-	Description string
-	Video       *multipart.FileHeader
-	Thumbnail   *multipart.FileHeader
+	Title        string
+	Description  string
+	RatingSystem string
+	Rating       string
+	Video        *multipart.FileHeader
+	Thumbnail    *multipart.FileHeader
 }
 
 func (req CreateMovieInputDTO) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(&req.Title, validation.Required.Error("title is required"), validation.Length(1, 255)),
 		validation.Field(&req.Description, validation.Required.Error("description is required")),
+		validation.Field(&req.RatingSystem, validation.When(req.Rating != "", validation.Required.Error("rating_system is required when rating is set"))),
+		validation.Field(&req.Rating, validation.When(req.RatingSystem != "", validation.Required.Error("rating is required when rating_system is set"))),
 		validation.Field(&req.Video, validation.Required.Error("video file is required")),
 	)
 }
@@ -34,6 +38,7 @@ type CreateMovieOutputDTO struct {
 	ID          string `json:"id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Status      string `json:"status"`
 	CreatedAt   string `json:"created_at"`
 }
 
@@ -77,7 +82,7 @@ func (uc *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInpu
 		}
 	}
 
-	videoEntity, err := video.NewVideo(videoInfo.URL, videoInfo.SizeInKb, videoInfo.Duration)
+	videoEntity, err := video.NewVideo(videoInfo.URL, videoInfo.SizeInKb, videoInfo.Duration, videoInfo.Height)
 	if err != nil {
 		return nil, fault.New(
 			"invalid input for video",
@@ -121,6 +126,24 @@ func (uc *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInpu
 		)
 	}
 
+	if input.RatingSystem != "" {
+		rating, err := content.NewAgeRating(content.RatingSystem(input.RatingSystem), input.Rating)
+		if err != nil {
+			return nil, fault.New(
+				"invalid age rating",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+		if err := contentEntity.ChangeAgeRating(rating); err != nil {
+			return nil, fault.New(
+				"failed to set content age rating",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+	}
+
 	err = uc.contentRepo.Save(ctx, contentEntity)
 	if err != nil {
 		uc.logger.Error("Failed to save content aggregate", "contentID", contentEntity.ID(), "error", err)
@@ -137,6 +160,7 @@ func (uc *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInpu
 		ID:          contentEntity.ID(),
 		Title:       contentEntity.Title(),
 		Description: contentEntity.Description(),
+		Status:      string(contentEntity.Status()),
 		CreatedAt:   contentEntity.CreatedAt().String(),
 	}, nil
 }
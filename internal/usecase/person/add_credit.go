@@ -0,0 +1,137 @@
+package person
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/person"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type AddCreditInputDTO struct {
+	PersonID  string `json:"-"`
+	ContentID string `json:"content_id"`
+	EpisodeID string `json:"episode_id"`
+	Role      string `json:"role"`
+	Character string `json:"character"`
+}
+
+func (req AddCreditInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.PersonID, validation.Required.Error("personID is required")),
+		validation.Field(&req.ContentID, validation.Required.Error("content_id is required")),
+		validation.Field(&req.Role,
+			validation.Required.Error("role is required"),
+			validation.In(string(person.ActorRole), string(person.DirectorRole), string(person.WriterRole)).
+				Error("role must be one of ACTOR, DIRECTOR or WRITER"),
+		),
+		validation.Field(&req.Character,
+			validation.When(req.Role == string(person.ActorRole), validation.Required.Error("character is required for actors")),
+			validation.Length(0, 255),
+		),
+	)
+}
+
+type AddCreditOutputDTO struct {
+	ID        string `json:"id"`
+	PersonID  string `json:"person_id"`
+	ContentID string `json:"content_id"`
+	EpisodeID string `json:"episode_id,omitempty"`
+	Role      string `json:"role"`
+	Character string `json:"character,omitempty"`
+}
+
+type AddCreditUseCase struct {
+	personRepo  person.Repository
+	contentRepo content.Repository
+	logger      *log.Logger
+}
+
+func NewAddCreditUseCase(personRepo person.Repository, contentRepo content.Repository, logger *log.Logger) *AddCreditUseCase {
+	return &AddCreditUseCase{
+		personRepo:  personRepo,
+		contentRepo: contentRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *AddCreditUseCase) Execute(ctx context.Context, input AddCreditInputDTO) (*AddCreditOutputDTO, error) {
+	uc.logger.Debug("Starting add credit use case execution", "personID", input.PersonID, "contentID", input.ContentID)
+
+	if _, err := uc.personRepo.FindByID(ctx, input.PersonID); err != nil {
+		return nil, fault.New(
+			"person not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID)
+	if err != nil {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	if input.EpisodeID != "" {
+		if err := ensureEpisodeBelongsToContent(contentEntity, input.EpisodeID); err != nil {
+			return nil, fault.New(
+				err.Error(),
+				fault.WithKind(fault.KindValidation),
+			)
+		}
+	}
+
+	credit, err := person.NewCredit(input.PersonID, input.ContentID, input.EpisodeID, person.Role(input.Role), input.Character)
+	if err != nil {
+		return nil, fault.New(
+			"failed to create credit entity",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.personRepo.SaveCredit(ctx, credit); err != nil {
+		if errors.Is(err, person.ErrConflict) {
+			return nil, fault.New(
+				"credit already exists",
+				fault.WithKind(fault.KindConflict),
+				fault.WithError(err),
+			)
+		}
+		uc.logger.Error("Failed to save credit", "personID", input.PersonID, "error", err)
+		return nil, fault.New(
+			"failed to save credit",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &AddCreditOutputDTO{
+		ID:        credit.ID(),
+		PersonID:  credit.PersonID(),
+		ContentID: credit.ContentID(),
+		EpisodeID: credit.EpisodeID(),
+		Role:      string(credit.Role()),
+		Character: credit.Character(),
+	}, nil
+}
+
+func ensureEpisodeBelongsToContent(contentEntity *content.Content, episodeID string) error {
+	show, err := contentEntity.TvShow()
+	if err != nil || show == nil {
+		return errors.New("episode credits are only allowed for tv shows")
+	}
+	for _, ep := range show.Episodes() {
+		if ep.ID() == episodeID {
+			return nil
+		}
+	}
+	return errors.New("episode does not belong to the content")
+}
@@ -0,0 +1,112 @@
+package person
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/person"
+	"github.com/hoyci/fakeflix/internal/domain/thumbnail"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type CreatePersonInputDTO struct {
+	Name  string
+	Bio   string
+	Photo *multipart.FileHeader
+}
+
+func (req CreatePersonInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Name, validation.Required.Error("name is required"), validation.Length(1, 255)),
+	)
+}
+
+type CreatePersonOutputDTO struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Bio       string `json:"bio"`
+	PhotoURL  string `json:"photo_url,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type CreatePersonUseCase struct {
+	personRepo   person.Repository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewCreatePersonUseCase(personRepo person.Repository, mediaService media.MediaService, logger *log.Logger) *CreatePersonUseCase {
+	return &CreatePersonUseCase{
+		personRepo:   personRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *CreatePersonUseCase) Execute(ctx context.Context, input CreatePersonInputDTO) (*CreatePersonOutputDTO, error) {
+	uc.logger.Debug("Starting create person use case execution", "name", input.Name)
+
+	personEntity, err := person.NewPerson(input.Name, input.Bio)
+	if err != nil {
+		return nil, fault.New(
+			"failed to create person entity",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if input.Photo != nil {
+		photoInfo, err := uc.mediaService.Store(input.Photo, "upload/photos")
+		if err != nil {
+			uc.logger.Error("Failed to store photo", "filename", input.Photo.Filename, "error", err)
+			return nil, fault.New(
+				"error while saving photo",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+
+		photoEntity, err := thumbnail.NewThumbnail(photoInfo.URL)
+		if err != nil {
+			return nil, fault.New(
+				"invalid input for photo",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+		if err := personEntity.AddPhoto(photoEntity); err != nil {
+			return nil, fault.New(
+				"failed to add photo to person",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+	}
+
+	if err := uc.personRepo.Save(ctx, personEntity); err != nil {
+		uc.logger.Error("Failed to save person", "personID", personEntity.ID(), "error", err)
+		return nil, fault.New(
+			"failed to save person",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Debug("Person saved successfully", "personID", personEntity.ID())
+
+	output := &CreatePersonOutputDTO{
+		ID:        personEntity.ID(),
+		Name:      personEntity.Name(),
+		Bio:       personEntity.Bio(),
+		CreatedAt: personEntity.CreatedAt().String(),
+	}
+	if personEntity.Photo() != nil {
+		output.PhotoURL = personEntity.Photo().URL()
+	}
+
+	return output, nil
+}
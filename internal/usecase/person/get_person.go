@@ -0,0 +1,124 @@
+package person
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/person"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetPersonInputDTO struct {
+	PersonID string
+}
+
+func (req GetPersonInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.PersonID, validation.Required.Error("personID is required")),
+	)
+}
+
+type EpisodeCreditDTO struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Season int    `json:"season"`
+	Number int    `json:"number"`
+}
+
+type FilmographyItemDTO struct {
+	ContentID   string             `json:"content_id"`
+	Title       string             `json:"title"`
+	ContentType string             `json:"content_type"`
+	Role        string             `json:"role"`
+	Character   string             `json:"character,omitempty"`
+	Episodes    []EpisodeCreditDTO `json:"episodes,omitempty"`
+}
+
+type GetPersonOutputDTO struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Bio         string               `json:"bio"`
+	PhotoURL    string               `json:"photo_url,omitempty"`
+	Filmography []FilmographyItemDTO `json:"filmography"`
+}
+
+type GetPersonUseCase struct {
+	personRepo person.Repository
+	logger     *log.Logger
+}
+
+func NewGetPersonUseCase(personRepo person.Repository, logger *log.Logger) *GetPersonUseCase {
+	return &GetPersonUseCase{
+		personRepo: personRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *GetPersonUseCase) Execute(ctx context.Context, input GetPersonInputDTO) (*GetPersonOutputDTO, error) {
+	uc.logger.Debug("Starting get person use case execution", "personID", input.PersonID)
+
+	personEntity, err := uc.personRepo.FindByID(ctx, input.PersonID)
+	if err != nil {
+		uc.logger.Error("Failed to find person by personID", "personID", input.PersonID, "error", err)
+		return nil, fault.New(
+			"person not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	entries, err := uc.personRepo.FindFilmography(ctx, input.PersonID)
+	if err != nil {
+		uc.logger.Error("Failed to load filmography", "personID", input.PersonID, "error", err)
+		return nil, fault.New(
+			"failed to load filmography",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := &GetPersonOutputDTO{
+		ID:          personEntity.ID(),
+		Name:        personEntity.Name(),
+		Bio:         personEntity.Bio(),
+		Filmography: groupFilmography(entries),
+	}
+	if personEntity.Photo() != nil {
+		output.PhotoURL = personEntity.Photo().URL()
+	}
+
+	return output, nil
+}
+
+func groupFilmography(entries []*person.FilmographyEntry) []FilmographyItemDTO {
+	items := make([]FilmographyItemDTO, 0, len(entries))
+	index := make(map[string]int)
+
+	for _, entry := range entries {
+		key := entry.ContentID + "|" + string(entry.Role) + "|" + entry.Character
+		pos, ok := index[key]
+		if !ok {
+			items = append(items, FilmographyItemDTO{
+				ContentID:   entry.ContentID,
+				Title:       entry.ContentTitle,
+				ContentType: entry.ContentType,
+				Role:        string(entry.Role),
+				Character:   entry.Character,
+			})
+			pos = len(items) - 1
+			index[key] = pos
+		}
+
+		if entry.EpisodeID != "" {
+			items[pos].Episodes = append(items[pos].Episodes, EpisodeCreditDTO{
+				ID:     entry.EpisodeID,
+				Title:  entry.EpisodeTitle,
+				Season: entry.Season,
+				Number: entry.Number,
+			})
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,68 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+)
+
+type CompleteSessionInputDTO struct {
+	AccountID string
+	SessionID string
+	VideoID   string
+}
+
+func (req CompleteSessionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.SessionID, validation.Required.Error("sessionID is required")),
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+	)
+}
+
+type CompleteSessionUseCase struct {
+	sessionRepo                playback.SessionRepository
+	recordPlaybackEventUseCase *RecordPlaybackEventUseCase
+	timeout                    time.Duration
+	logger                     *log.Logger
+}
+
+func NewCompleteSessionUseCase(
+	sessionRepo playback.SessionRepository,
+	recordPlaybackEventUseCase *RecordPlaybackEventUseCase,
+	timeout time.Duration,
+	logger *log.Logger,
+) *CompleteSessionUseCase {
+	return &CompleteSessionUseCase{
+		sessionRepo:                sessionRepo,
+		recordPlaybackEventUseCase: recordPlaybackEventUseCase,
+		timeout:                    timeout,
+		logger:                     logger,
+	}
+}
+
+func (uc *CompleteSessionUseCase) Execute(ctx context.Context, input CompleteSessionInputDTO) error {
+	session, err := findSession(ctx, uc.sessionRepo, input.AccountID, input.SessionID)
+	if err != nil {
+		return err
+	}
+
+	err = session.Complete(input.VideoID, time.Now(), uc.timeout)
+	if errors.Is(err, playback.ErrAlreadyCompleted) {
+		return nil
+	}
+	if err := keepAlive(ctx, uc.sessionRepo, session, err); err != nil {
+		return err
+	}
+
+	uc.logger.Debug("Playback session completed", "sessionID", input.SessionID, "videoID", input.VideoID)
+	return uc.recordPlaybackEventUseCase.Execute(ctx, RecordPlaybackEventInputDTO{
+		VideoID:   session.VideoID(),
+		ProfileID: session.ProfileID(),
+		Type:      playback.CompleteEvent,
+	})
+}
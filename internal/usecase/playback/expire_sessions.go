@@ -0,0 +1,36 @@
+package playback
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+)
+
+type ExpireSessionsUseCase struct {
+	sessionRepo playback.SessionRepository
+	timeout     time.Duration
+	logger      *log.Logger
+}
+
+func NewExpireSessionsUseCase(sessionRepo playback.SessionRepository, timeout time.Duration, logger *log.Logger) *ExpireSessionsUseCase {
+	return &ExpireSessionsUseCase{
+		sessionRepo: sessionRepo,
+		timeout:     timeout,
+		logger:      logger,
+	}
+}
+
+func (uc *ExpireSessionsUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	expired, err := uc.sessionRepo.EndStale(ctx, now.Add(-uc.timeout), now)
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		uc.logger.Info("Expired playback sessions", "count", expired)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package playback
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+)
+
+type HeartbeatSessionInputDTO struct {
+	AccountID string `json:"-"`
+	SessionID string `json:"-"`
+	VideoID   string `json:"video_id"`
+}
+
+func (req HeartbeatSessionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.SessionID, validation.Required.Error("sessionID is required")),
+	)
+}
+
+type HeartbeatSessionUseCase struct {
+	sessionRepo playback.SessionRepository
+	timeout     time.Duration
+	logger      *log.Logger
+}
+
+func NewHeartbeatSessionUseCase(sessionRepo playback.SessionRepository, timeout time.Duration, logger *log.Logger) *HeartbeatSessionUseCase {
+	return &HeartbeatSessionUseCase{
+		sessionRepo: sessionRepo,
+		timeout:     timeout,
+		logger:      logger,
+	}
+}
+
+func (uc *HeartbeatSessionUseCase) Execute(ctx context.Context, input HeartbeatSessionInputDTO) error {
+	session, err := findSession(ctx, uc.sessionRepo, input.AccountID, input.SessionID)
+	if err != nil {
+		return err
+	}
+	return keepAlive(ctx, uc.sessionRepo, session, session.Heartbeat(input.VideoID, time.Now(), uc.timeout))
+}
@@ -0,0 +1,57 @@
+package playback
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListSessionsInputDTO struct {
+	AccountID string
+}
+
+func (req ListSessionsInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type ListSessionsOutputDTO struct {
+	Items []SessionOutputDTO `json:"items"`
+}
+
+type ListSessionsUseCase struct {
+	sessionRepo playback.SessionRepository
+	timeout     time.Duration
+	logger      *log.Logger
+}
+
+func NewListSessionsUseCase(sessionRepo playback.SessionRepository, timeout time.Duration, logger *log.Logger) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		sessionRepo: sessionRepo,
+		timeout:     timeout,
+		logger:      logger,
+	}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, input ListSessionsInputDTO) (*ListSessionsOutputDTO, error) {
+	sessions, err := uc.sessionRepo.ListActive(ctx, input.AccountID, time.Now().Add(-uc.timeout))
+	if err != nil {
+		uc.logger.Error("Failed to list playback sessions", "accountID", input.AccountID, "error", err)
+		return nil, fault.New(
+			"failed to list playback sessions",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]SessionOutputDTO, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, newSessionOutputDTO(session))
+	}
+	return &ListSessionsOutputDTO{Items: items}, nil
+}
@@ -0,0 +1,199 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/device"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type OpenSessionInputDTO struct {
+	SessionID  string
+	AccountID  string
+	ProfileID  string
+	DeviceID   string
+	VideoID    string
+	UserAgent  string
+	MaxStreams int
+	MaxDevices int
+}
+
+func (req OpenSessionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.MaxStreams, validation.Min(1)),
+	)
+}
+
+type OpenSessionUseCase struct {
+	sessionRepo                playback.SessionRepository
+	deviceRepo                 device.Repository
+	recordPlaybackEventUseCase *RecordPlaybackEventUseCase
+	timeout                    time.Duration
+	logger                     *log.Logger
+}
+
+func NewOpenSessionUseCase(
+	sessionRepo playback.SessionRepository,
+	deviceRepo device.Repository,
+	recordPlaybackEventUseCase *RecordPlaybackEventUseCase,
+	timeout time.Duration,
+	logger *log.Logger,
+) *OpenSessionUseCase {
+	return &OpenSessionUseCase{
+		sessionRepo:                sessionRepo,
+		deviceRepo:                 deviceRepo,
+		recordPlaybackEventUseCase: recordPlaybackEventUseCase,
+		timeout:                    timeout,
+		logger:                     logger,
+	}
+}
+
+func (uc *OpenSessionUseCase) Execute(ctx context.Context, input OpenSessionInputDTO) (*SessionOutputDTO, error) {
+	now := time.Now()
+
+	var session *playback.Session
+	if input.SessionID != "" {
+		found, err := findSession(ctx, uc.sessionRepo, input.AccountID, input.SessionID)
+		if err != nil {
+			return nil, err
+		}
+		if found.DeviceID() != input.DeviceID {
+			uc.logger.Warn("Rejected resume from another device", "sessionID", input.SessionID, "deviceID", input.DeviceID)
+			return nil, fault.New(
+				"playback session belongs to another device",
+				fault.WithKind(fault.KindForbidden),
+			)
+		}
+		session = found
+	}
+
+	if input.DeviceID != "" {
+		if err := uc.checkDevice(ctx, input, now); err != nil {
+			return nil, err
+		}
+	}
+
+	// Players send one request per byte range, so a device streaming the
+	// video already keeps its session instead of taking another stream.
+	if session == nil && input.DeviceID != "" {
+		var err error
+		if session, err = uc.findActive(ctx, input, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if session != nil {
+		videoChanged := session.VideoID() != input.VideoID
+		if err := keepAlive(ctx, uc.sessionRepo, session, session.Resume(input.VideoID, now, uc.timeout)); err != nil {
+			return nil, err
+		}
+		if videoChanged {
+			uc.recordStart(ctx, session)
+		}
+		output := newSessionOutputDTO(session)
+		return &output, nil
+	}
+
+	session, err := playback.NewSession(input.AccountID, input.ProfileID, input.DeviceID, input.VideoID, input.UserAgent, now)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.sessionRepo.Start(ctx, session, input.MaxStreams, now.Add(-uc.timeout)); err != nil {
+		if errors.Is(err, playback.ErrTooManySessions) {
+			uc.logger.Warn("Rejected stream over the concurrent limit", "accountID", input.AccountID, "maxStreams", input.MaxStreams)
+			return nil, fault.New(
+				"maximum concurrent streams reached for your plan",
+				fault.WithKind(fault.KindLimitExceeded),
+				fault.WithError(err),
+				fault.WithCode("too_many_streams"),
+			)
+		}
+		uc.logger.Error("Failed to start playback session", "accountID", input.AccountID, "error", err)
+		return nil, fault.New(
+			"failed to start playback session",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	uc.recordStart(ctx, session)
+
+	output := newSessionOutputDTO(session)
+	return &output, nil
+}
+
+func (uc *OpenSessionUseCase) recordStart(ctx context.Context, session *playback.Session) {
+	// Recording is best effort and must not refuse the stream.
+	_ = uc.recordPlaybackEventUseCase.Execute(ctx, RecordPlaybackEventInputDTO{
+		VideoID:   session.VideoID(),
+		ProfileID: session.ProfileID(),
+		Type:      playback.StartEvent,
+	})
+}
+
+func (uc *OpenSessionUseCase) checkDevice(ctx context.Context, input OpenSessionInputDTO, now time.Time) error {
+	devices, err := uc.deviceRepo.ListActive(ctx, input.AccountID)
+	if err != nil {
+		return fault.New(
+			"failed to list devices",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	rank := slices.IndexFunc(devices, func(d *device.Device) bool { return d.ID() == input.DeviceID })
+	if rank < 0 {
+		return fault.New(
+			"device has been signed out",
+			fault.WithKind(fault.KindUnauthenticated),
+			fault.WithCode("device_signed_out"),
+		)
+	}
+	if input.MaxDevices > 0 && rank >= input.MaxDevices {
+		uc.logger.Warn("Rejected stream from device over the plan limit", "accountID", input.AccountID, "deviceID", input.DeviceID, "maxDevices", input.MaxDevices)
+		return fault.New(
+			"maximum number of devices reached for your plan, sign out a device first",
+			fault.WithKind(fault.KindLimitExceeded),
+			fault.WithCode("too_many_devices"),
+		)
+	}
+
+	devices[rank].Touch(input.UserAgent, now)
+	if err := uc.deviceRepo.Save(ctx, devices[rank]); err != nil {
+		return fault.New(
+			"failed to save device",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
+
+func (uc *OpenSessionUseCase) findActive(ctx context.Context, input OpenSessionInputDTO, now time.Time) (*playback.Session, error) {
+	sessions, err := uc.sessionRepo.ListActive(ctx, input.AccountID, now.Add(-uc.timeout))
+	if err != nil {
+		return nil, fault.New(
+			"failed to list playback sessions",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	for _, session := range sessions {
+		if session.DeviceID() == input.DeviceID && session.ProfileID() == input.ProfileID && session.VideoID() == input.VideoID {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
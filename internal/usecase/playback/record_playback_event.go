@@ -0,0 +1,49 @@
+package playback
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RecordPlaybackEventInputDTO struct {
+	VideoID   string
+	ProfileID string
+	Type      playback.EventType
+}
+
+type RecordPlaybackEventUseCase struct {
+	playbackRepo playback.Repository
+	logger       *log.Logger
+}
+
+func NewRecordPlaybackEventUseCase(playbackRepo playback.Repository, logger *log.Logger) *RecordPlaybackEventUseCase {
+	return &RecordPlaybackEventUseCase{
+		playbackRepo: playbackRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *RecordPlaybackEventUseCase) Execute(ctx context.Context, input RecordPlaybackEventInputDTO) error {
+	event, err := playback.NewEvent(input.VideoID, input.ProfileID, input.Type)
+	if err != nil {
+		return fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.playbackRepo.Append(ctx, event); err != nil {
+		uc.logger.Error("Failed to append playback event", "videoID", input.VideoID, "type", input.Type, "error", err)
+		return fault.New(
+			"failed to record playback event",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
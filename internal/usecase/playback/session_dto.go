@@ -0,0 +1,77 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SessionOutputDTO struct {
+	ID         string    `json:"id"`
+	ProfileID  string    `json:"profile_id,omitempty"`
+	DeviceID   string    `json:"device_id,omitempty"`
+	VideoID    string    `json:"video_id"`
+	UserAgent  string    `json:"user_agent"`
+	StartedAt  time.Time `json:"started_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+func newSessionOutputDTO(session *playback.Session) SessionOutputDTO {
+	return SessionOutputDTO{
+		ID:         session.ID(),
+		ProfileID:  session.ProfileID(),
+		DeviceID:   session.DeviceID(),
+		VideoID:    session.VideoID(),
+		UserAgent:  session.UserAgent(),
+		StartedAt:  session.StartedAt(),
+		LastSeenAt: session.LastSeenAt(),
+	}
+}
+
+func findSession(ctx context.Context, sessionRepo playback.SessionRepository, accountID, sessionID string) (*playback.Session, error) {
+	session, err := sessionRepo.FindByID(ctx, sessionID)
+	if errors.Is(err, playback.ErrSessionNotFound) || (err == nil && session.AccountID() != accountID) {
+		return nil, fault.New(
+			"playback session not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return nil, fault.New(
+			"failed to load playback session",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return session, nil
+}
+
+func keepAlive(ctx context.Context, sessionRepo playback.SessionRepository, session *playback.Session, err error) error {
+	if errors.Is(err, playback.ErrVideoChanged) {
+		return fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindConflict),
+			fault.WithError(err),
+			fault.WithCode("video_changed"),
+		)
+	}
+	if err != nil {
+		return fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindConflict),
+			fault.WithError(err),
+		)
+	}
+	if err := sessionRepo.Save(ctx, session); err != nil {
+		return fault.New(
+			"failed to save playback session",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package playback
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type TerminateSessionInputDTO struct {
+	AccountID string
+	SessionID string
+}
+
+func (req TerminateSessionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.SessionID, validation.Required.Error("sessionID is required")),
+	)
+}
+
+type TerminateSessionUseCase struct {
+	sessionRepo playback.SessionRepository
+	logger      *log.Logger
+}
+
+func NewTerminateSessionUseCase(sessionRepo playback.SessionRepository, logger *log.Logger) *TerminateSessionUseCase {
+	return &TerminateSessionUseCase{
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *TerminateSessionUseCase) Execute(ctx context.Context, input TerminateSessionInputDTO) error {
+	session, err := findSession(ctx, uc.sessionRepo, input.AccountID, input.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := session.End(playback.TerminatedReason, time.Now()); errors.Is(err, playback.ErrSessionEnded) {
+		return nil
+	}
+
+	if err := uc.sessionRepo.Save(ctx, session); err != nil {
+		uc.logger.Error("Failed to terminate playback session", "sessionID", input.SessionID, "error", err)
+		return fault.New(
+			"failed to terminate playback session",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Playback session terminated", "accountID", input.AccountID, "sessionID", input.SessionID)
+	return nil
+}
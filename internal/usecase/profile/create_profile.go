@@ -0,0 +1,110 @@
+package profile
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+var pinPattern = regexp.MustCompile(`^[0-9]{4}$`)
+
+type CreateProfileInputDTO struct {
+	AccountID        string `json:"-"`
+	Name             string `json:"name"`
+	AvatarURL        string `json:"avatar_url"`
+	Language         string `json:"language"`
+	MaxMaturityLevel *int   `json:"max_maturity_level"`
+	Kids             bool   `json:"kids"`
+	PIN              string `json:"pin"`
+}
+
+func (req CreateProfileInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.Name, validation.Required.Error("name is required"), validation.Length(1, 255)),
+		validation.Field(&req.AvatarURL, validation.Length(0, 2048)),
+		validation.Field(&req.Language, validation.Length(0, 35)),
+		validation.Field(&req.MaxMaturityLevel, validation.Min(0), validation.Max(content.MaxMaturityLevel)),
+		validation.Field(&req.PIN, validation.Match(pinPattern).Error("pin must have 4 digits")),
+	)
+}
+
+type CreateProfileUseCase struct {
+	profileRepo profile.Repository
+	logger      *log.Logger
+}
+
+func NewCreateProfileUseCase(profileRepo profile.Repository, logger *log.Logger) *CreateProfileUseCase {
+	return &CreateProfileUseCase{
+		profileRepo: profileRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *CreateProfileUseCase) Execute(ctx context.Context, input CreateProfileInputDTO) (*ProfileOutputDTO, error) {
+	uc.logger.Debug("Starting create profile use case execution", "accountID", input.AccountID, "name", input.Name)
+
+	existing, err := uc.profileRepo.ListByAccountID(ctx, input.AccountID)
+	if err != nil {
+		return nil, fault.New(
+			"failed to load account profiles",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if len(existing) >= profile.MaxProfilesPerAccount {
+		return nil, fault.New(
+			"account already has the maximum number of profiles",
+			fault.WithKind(fault.KindConflict),
+		)
+	}
+
+	language := input.Language
+	if language == "" {
+		language = "pt-BR"
+	}
+
+	maxLevel := content.MaxMaturityLevel
+	if input.Kids {
+		maxLevel = profile.KidsMaxMaturityLevel
+	}
+	if input.MaxMaturityLevel != nil {
+		maxLevel = *input.MaxMaturityLevel
+	}
+
+	profileEntity, err := profile.NewProfile(input.AccountID, input.Name, input.AvatarURL, language, maxLevel, input.Kids)
+	if err != nil {
+		return nil, fault.New(
+			"failed to create profile entity",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if input.PIN != "" {
+		if err := profileEntity.SetPIN(input.PIN); err != nil {
+			return nil, fault.New(
+				"invalid profile pin",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+	}
+
+	if err := uc.profileRepo.Save(ctx, profileEntity); err != nil {
+		return nil, fault.New(
+			"failed to save profile",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	output := newProfileOutputDTO(profileEntity)
+	return &output, nil
+}
@@ -0,0 +1,56 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteProfileInputDTO struct {
+	AccountID string
+	ProfileID string
+}
+
+func (req DeleteProfileInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+	)
+}
+
+type DeleteProfileUseCase struct {
+	profileRepo profile.Repository
+	logger      *log.Logger
+}
+
+func NewDeleteProfileUseCase(profileRepo profile.Repository, logger *log.Logger) *DeleteProfileUseCase {
+	return &DeleteProfileUseCase{
+		profileRepo: profileRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *DeleteProfileUseCase) Execute(ctx context.Context, input DeleteProfileInputDTO) error {
+	profileEntity, err := uc.profileRepo.FindByID(ctx, input.ProfileID)
+	if err != nil || !profileEntity.BelongsTo(input.AccountID) {
+		return fault.New(
+			"profile not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.profileRepo.Delete(ctx, input.ProfileID); err != nil {
+		uc.logger.Error("Failed to delete profile", "profileID", input.ProfileID, "error", err)
+		return fault.New(
+			"failed to delete profile",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
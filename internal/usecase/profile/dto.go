@@ -0,0 +1,29 @@
+package profile
+
+import (
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+)
+
+type ProfileOutputDTO struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	AvatarURL        string `json:"avatar_url,omitempty"`
+	Language         string `json:"language"`
+	MaxMaturityLevel int    `json:"max_maturity_level"`
+	Kids             bool   `json:"kids"`
+	HasPIN           bool   `json:"has_pin"`
+	CreatedAt        string `json:"created_at"`
+}
+
+func newProfileOutputDTO(profileEntity *profile.Profile) ProfileOutputDTO {
+	return ProfileOutputDTO{
+		ID:               profileEntity.ID(),
+		Name:             profileEntity.Name(),
+		AvatarURL:        profileEntity.AvatarURL(),
+		Language:         profileEntity.Language(),
+		MaxMaturityLevel: profileEntity.MaxMaturityLevel(),
+		Kids:             profileEntity.IsKids(),
+		HasPIN:           profileEntity.HasPIN(),
+		CreatedAt:        profileEntity.CreatedAt().String(),
+	}
+}
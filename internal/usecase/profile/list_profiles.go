@@ -0,0 +1,48 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListProfilesInputDTO struct {
+	AccountID string
+}
+
+type ListProfilesOutputDTO struct {
+	Items []ProfileOutputDTO `json:"items"`
+}
+
+type ListProfilesUseCase struct {
+	profileRepo profile.Repository
+	logger      *log.Logger
+}
+
+func NewListProfilesUseCase(profileRepo profile.Repository, logger *log.Logger) *ListProfilesUseCase {
+	return &ListProfilesUseCase{
+		profileRepo: profileRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ListProfilesUseCase) Execute(ctx context.Context, input ListProfilesInputDTO) (*ListProfilesOutputDTO, error) {
+	profiles, err := uc.profileRepo.ListByAccountID(ctx, input.AccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list profiles", "accountID", input.AccountID, "error", err)
+		return nil, fault.New(
+			"failed to list profiles",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]ProfileOutputDTO, 0, len(profiles))
+	for _, profileEntity := range profiles {
+		items = append(items, newProfileOutputDTO(profileEntity))
+	}
+
+	return &ListProfilesOutputDTO{Items: items}, nil
+}
@@ -0,0 +1,41 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ResolveProfileInputDTO struct {
+	AccountID string
+	ProfileID string
+}
+
+type ResolveProfileUseCase struct {
+	profileRepo profile.Repository
+	logger      *log.Logger
+}
+
+func NewResolveProfileUseCase(profileRepo profile.Repository, logger *log.Logger) *ResolveProfileUseCase {
+	return &ResolveProfileUseCase{
+		profileRepo: profileRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ResolveProfileUseCase) Execute(ctx context.Context, input ResolveProfileInputDTO) (*ProfileOutputDTO, error) {
+	profileEntity, err := uc.profileRepo.FindByID(ctx, input.ProfileID)
+	if err != nil || !profileEntity.BelongsTo(input.AccountID) {
+		uc.logger.Warn("Rejected profile selection", "accountID", input.AccountID, "profileID", input.ProfileID)
+		return nil, fault.New(
+			"profile does not belong to the account",
+			fault.WithKind(fault.KindForbidden),
+			fault.WithError(err),
+		)
+	}
+
+	output := newProfileOutputDTO(profileEntity)
+	return &output, nil
+}
@@ -0,0 +1,190 @@
+package progress
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/episode"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/progress"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListContinueWatchingInputDTO struct {
+	ProfileID string
+	Locales   []string
+	Limit     int
+}
+
+func (req ListContinueWatchingInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.Limit, validation.Min(1), validation.Max(100)),
+	)
+}
+
+type EpisodeOutputDTO struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Season int    `json:"season"`
+	Number int    `json:"number"`
+}
+
+type ContinueWatchingItemDTO struct {
+	Content         catalog.ContentOutputDTO `json:"content"`
+	VideoID         string                   `json:"video_id"`
+	Episode         *EpisodeOutputDTO        `json:"episode,omitempty"`
+	PositionSeconds int                      `json:"position_seconds"`
+	DurationSeconds int                      `json:"duration_seconds"`
+	NextEpisode     bool                     `json:"next_episode"`
+}
+
+type ListContinueWatchingOutputDTO struct {
+	Items []ContinueWatchingItemDTO `json:"items"`
+}
+
+type ListContinueWatchingUseCase struct {
+	progressRepo    progress.Repository
+	contentRepo     content.Repository
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewListContinueWatchingUseCase(
+	progressRepo progress.Repository,
+	contentRepo content.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListContinueWatchingUseCase {
+	return &ListContinueWatchingUseCase{
+		progressRepo:    progressRepo,
+		contentRepo:     contentRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *ListContinueWatchingUseCase) Execute(ctx context.Context, input ListContinueWatchingInputDTO) (*ListContinueWatchingOutputDTO, error) {
+	latest, err := uc.progressRepo.ListLatestByContent(ctx, input.ProfileID, input.Limit)
+	if err != nil {
+		uc.logger.Error("Failed to list watch progress", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list continue watching",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]ContinueWatchingItemDTO, 0, len(latest))
+	for _, entry := range latest {
+		contentEntity, err := uc.contentRepo.FindByID(ctx, entry.ContentID())
+		if err != nil || !contentEntity.IsPublished() {
+			uc.logger.Warn("Skipping progress of unavailable content", "contentID", entry.ContentID(), "error", err)
+			continue
+		}
+
+		item, ok := continueWatchingItem(contentEntity, entry)
+		if ok {
+			items = append(items, item)
+		}
+	}
+
+	if err := uc.localize(ctx, input.Locales, items); err != nil {
+		uc.logger.Error("Failed to load translations", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list continue watching",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &ListContinueWatchingOutputDTO{Items: items}, nil
+}
+
+func (uc *ListContinueWatchingUseCase) localize(ctx context.Context, locales []string, items []ContinueWatchingItemDTO) error {
+	if len(locales) == 0 {
+		return nil
+	}
+
+	refs := make([]*catalog.ContentOutputDTO, len(items))
+	episodeIDs := make([]string, 0, len(items))
+	for i := range items {
+		refs[i] = &items[i].Content
+		if items[i].Episode != nil {
+			episodeIDs = append(episodeIDs, items[i].Episode.ID)
+		}
+	}
+	if err := catalog.Localize(ctx, uc.translationRepo, locales, refs); err != nil {
+		return err
+	}
+
+	translations, err := uc.translationRepo.ListBySubjects(ctx, localization.EpisodeSubject, episodeIDs)
+	if err != nil {
+		return err
+	}
+	bySubject := make(map[string][]*localization.Translation, len(episodeIDs))
+	for _, translation := range translations {
+		bySubject[translation.SubjectID()] = append(bySubject[translation.SubjectID()], translation)
+	}
+	for _, item := range items {
+		if item.Episode == nil {
+			continue
+		}
+		if translation := localization.Pick(locales, bySubject[item.Episode.ID]); translation != nil {
+			item.Episode.Title = translation.Title()
+		}
+	}
+	return nil
+}
+
+func continueWatchingItem(contentEntity *content.Content, entry *progress.Progress) (ContinueWatchingItemDTO, bool) {
+	item := ContinueWatchingItemDTO{
+		Content:         catalog.NewContentOutputDTO(contentEntity),
+		VideoID:         entry.VideoID(),
+		PositionSeconds: entry.PositionSeconds(),
+		DurationSeconds: entry.DurationSeconds(),
+	}
+
+	if entry.EpisodeID() == "" {
+		return item, !entry.IsCompleted()
+	}
+
+	show, err := contentEntity.TvShow()
+	if err != nil || show == nil {
+		return item, false
+	}
+
+	if !entry.IsCompleted() {
+		for _, ep := range show.Episodes() {
+			if ep.ID() == entry.EpisodeID() {
+				item.Episode = newEpisodeOutputDTO(ep)
+				return item, true
+			}
+		}
+		return item, false
+	}
+
+	next := show.NextEpisode(entry.EpisodeID())
+	if next == nil {
+		return item, false
+	}
+
+	item.VideoID = next.Video().ID()
+	item.Episode = newEpisodeOutputDTO(next)
+	item.PositionSeconds = 0
+	item.DurationSeconds = next.Video().Duration()
+	item.NextEpisode = true
+	return item, true
+}
+
+func newEpisodeOutputDTO(ep *episode.Episode) *EpisodeOutputDTO {
+	return &EpisodeOutputDTO{
+		ID:     ep.ID(),
+		Title:  ep.Title(),
+		Season: ep.Season(),
+		Number: ep.Number(),
+	}
+}
@@ -0,0 +1,89 @@
+package progress
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/progress"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RecordProgressInputDTO struct {
+	ProfileID       string     `json:"-"`
+	VideoID         string     `json:"-"`
+	PositionSeconds *int       `json:"position_seconds"`
+	RecordedAt      *time.Time `json:"recorded_at"`
+}
+
+func (req RecordProgressInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.PositionSeconds, validation.NotNil.Error("position_seconds is required"), validation.Min(0)),
+	)
+}
+
+type RecordProgressOutputDTO struct {
+	VideoID         string `json:"video_id"`
+	PositionSeconds int    `json:"position_seconds"`
+	DurationSeconds int    `json:"duration_seconds"`
+	Completed       bool   `json:"completed"`
+}
+
+type RecordProgressUseCase struct {
+	videoRepo    video.Repository
+	progressRepo progress.Repository
+	logger       *log.Logger
+}
+
+func NewRecordProgressUseCase(videoRepo video.Repository, progressRepo progress.Repository, logger *log.Logger) *RecordProgressUseCase {
+	return &RecordProgressUseCase{
+		videoRepo:    videoRepo,
+		progressRepo: progressRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *RecordProgressUseCase) Execute(ctx context.Context, input RecordProgressInputDTO) (*RecordProgressOutputDTO, error) {
+	videoEntity, err := uc.videoRepo.FindByID(ctx, input.VideoID)
+	if err != nil {
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	recordedAt := time.Now().UTC()
+	if input.RecordedAt != nil && input.RecordedAt.Before(recordedAt) {
+		recordedAt = *input.RecordedAt
+	}
+
+	progressEntity, err := progress.NewProgress(input.ProfileID, videoEntity, *input.PositionSeconds, recordedAt)
+	if err != nil {
+		return nil, fault.New(
+			"invalid playback progress",
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.progressRepo.Save(ctx, progressEntity); err != nil {
+		return nil, fault.New(
+			"failed to save playback progress",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &RecordProgressOutputDTO{
+		VideoID:         progressEntity.VideoID(),
+		PositionSeconds: progressEntity.PositionSeconds(),
+		DurationSeconds: progressEntity.DurationSeconds(),
+		Completed:       progressEntity.IsCompleted(),
+	}, nil
+}
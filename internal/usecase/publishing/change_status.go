@@ -0,0 +1,87 @@
+package publishing
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ChangeStatusInputDTO struct {
+	ContentID string     `json:"-"`
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+func (req ChangeStatusInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Status,
+			validation.Required.Error("status is required"),
+			validation.In(
+				string(content.DraftStatus),
+				string(content.InReviewStatus),
+				string(content.ScheduledStatus),
+				string(content.PublishedStatus),
+				string(content.UnpublishedStatus),
+			).Error("status is invalid"),
+		),
+	)
+}
+
+type StatusOutputDTO struct {
+	ContentID string     `json:"content_id"`
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+}
+
+type ChangeStatusUseCase struct {
+	contentRepo content.Repository
+	logger      *log.Logger
+}
+
+func NewChangeStatusUseCase(contentRepo content.Repository, logger *log.Logger) *ChangeStatusUseCase {
+	return &ChangeStatusUseCase{
+		contentRepo: contentRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ChangeStatusUseCase) Execute(ctx context.Context, input ChangeStatusInputDTO) (*StatusOutputDTO, error) {
+	contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID)
+	if err != nil {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	previous := contentEntity.Status()
+	if err := contentEntity.Transition(content.Status(input.Status), input.PublishAt, time.Now()); err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindConflict),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.contentRepo.UpdatePublication(ctx, contentEntity); err != nil {
+		uc.logger.Error("Failed to update content status", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to update content status",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Content status changed", "contentID", input.ContentID, "from", previous, "to", contentEntity.Status())
+	return &StatusOutputDTO{
+		ContentID: contentEntity.ID(),
+		Status:    string(contentEntity.Status()),
+		PublishAt: contentEntity.PublishAt(),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package publishing
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+)
+
+const publishBatchSize = 100
+
+type PublishDueUseCase struct {
+	contentRepo content.Repository
+	logger      *log.Logger
+}
+
+func NewPublishDueUseCase(contentRepo content.Repository, logger *log.Logger) *PublishDueUseCase {
+	return &PublishDueUseCase{
+		contentRepo: contentRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *PublishDueUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	due, err := uc.contentRepo.FindDue(ctx, now, publishBatchSize)
+	if err != nil {
+		return err
+	}
+
+	published := 0
+	for _, contentEntity := range due {
+		if err := contentEntity.Transition(content.PublishedStatus, nil, now); err != nil {
+			uc.logger.Warn("Skipping scheduled content", "contentID", contentEntity.ID(), "error", err)
+			continue
+		}
+		if err := uc.contentRepo.UpdatePublication(ctx, contentEntity); err != nil {
+			return err
+		}
+		published++
+	}
+
+	if published > 0 {
+		uc.logger.Info("Published scheduled contents", "count", published)
+	}
+	return nil
+}
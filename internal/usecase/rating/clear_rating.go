@@ -0,0 +1,47 @@
+package rating
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ClearRatingInputDTO struct {
+	ProfileID string
+	ContentID string
+}
+
+func (req ClearRatingInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+	)
+}
+
+type ClearRatingUseCase struct {
+	ratingRepo rating.Repository
+	logger     *log.Logger
+}
+
+func NewClearRatingUseCase(ratingRepo rating.Repository, logger *log.Logger) *ClearRatingUseCase {
+	return &ClearRatingUseCase{
+		ratingRepo: ratingRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *ClearRatingUseCase) Execute(ctx context.Context, input ClearRatingInputDTO) error {
+	if err := uc.ratingRepo.Delete(ctx, input.ProfileID, input.ContentID); err != nil {
+		uc.logger.Error("Failed to clear rating", "profileID", input.ProfileID, "contentID", input.ContentID, "error", err)
+		return fault.New(
+			"failed to clear rating",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
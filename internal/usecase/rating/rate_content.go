@@ -0,0 +1,103 @@
+package rating
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RateContentInputDTO struct {
+	ProfileID string
+	ContentID string
+	Thumb     string `json:"thumb"`
+	Stars     int    `json:"stars"`
+}
+
+func (req RateContentInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Thumb,
+			validation.Required.Error("thumb is required"),
+			validation.In(string(rating.ThumbUp), string(rating.ThumbDown)).Error("thumb must be UP or DOWN"),
+		),
+		validation.Field(&req.Stars, validation.Min(rating.MinStars), validation.Max(rating.MaxStars)),
+	)
+}
+
+type RateContentOutputDTO struct {
+	ContentID string               `json:"content_id"`
+	MyRating  *catalog.MyRatingDTO `json:"my_rating"`
+	Score     *catalog.ScoreDTO    `json:"score"`
+}
+
+type RateContentUseCase struct {
+	ratingRepo  rating.Repository
+	contentRepo content.Repository
+	logger      *log.Logger
+}
+
+func NewRateContentUseCase(ratingRepo rating.Repository, contentRepo content.Repository, logger *log.Logger) *RateContentUseCase {
+	return &RateContentUseCase{
+		ratingRepo:  ratingRepo,
+		contentRepo: contentRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *RateContentUseCase) Execute(ctx context.Context, input RateContentInputDTO) (*RateContentOutputDTO, error) {
+	if _, err := uc.contentRepo.FindByID(ctx, input.ContentID); err != nil {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	ratingEntity, err := rating.NewRating(input.ProfileID, input.ContentID, rating.Thumb(input.Thumb), input.Stars)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.ratingRepo.Save(ctx, ratingEntity); err != nil {
+		if errors.Is(err, rating.ErrConflict) {
+			return nil, fault.New(
+				"rating was changed concurrently, try again",
+				fault.WithKind(fault.KindConflict),
+				fault.WithError(err),
+			)
+		}
+		uc.logger.Error("Failed to save rating", "profileID", input.ProfileID, "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to rate content",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	summaries, err := uc.ratingRepo.FindSummaries(ctx, []string{input.ContentID})
+	if err != nil {
+		uc.logger.Error("Failed to load rating summary", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to rate content",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &RateContentOutputDTO{
+		ContentID: input.ContentID,
+		MyRating:  catalog.NewMyRatingDTO(ratingEntity),
+		Score:     catalog.NewScoreDTO(summaries[input.ContentID]),
+	}, nil
+}
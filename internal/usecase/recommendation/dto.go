@@ -0,0 +1,46 @@
+package recommendation
+
+import (
+	"context"
+
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/recommendation"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+)
+
+type ScoredContentDTO struct {
+	Content catalog.ContentOutputDTO `json:"content"`
+	Score   float64                  `json:"score"`
+}
+
+func scoredContents(
+	ctx context.Context,
+	contentRepo content.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	profileID string,
+	locales []string,
+	maxLevel *int,
+	scored []recommendation.Scored,
+	limit int,
+) ([]ScoredContentDTO, error) {
+	contentIDs := make([]string, 0, len(scored))
+	scores := make(map[string]float64, len(scored))
+	for _, s := range scored {
+		contentIDs = append(contentIDs, s.ContentID)
+		scores[s.ContentID] = s.Score
+	}
+
+	contents, err := catalog.ItemsByIDs(ctx, contentRepo, ratingRepo, translationRepo, profileID, locales, maxLevel, contentIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ScoredContentDTO, 0, len(contents))
+	for _, item := range contents {
+		items = append(items, ScoredContentDTO{Content: item, Score: scores[item.ID]})
+	}
+	return items, nil
+}
@@ -0,0 +1,113 @@
+package recommendation
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/recommendation"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListRecommendationsInputDTO struct {
+	ProfileID  string
+	ProfilePIN string
+	Locales    []string
+	Limit      int
+}
+
+func (req ListRecommendationsInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.Limit, validation.Min(1), validation.Max(recommendation.MaxPerProfile)),
+	)
+}
+
+type ListRecommendationsOutputDTO struct {
+	Items        []ScoredContentDTO `json:"items"`
+	Personalized bool               `json:"personalized"`
+}
+
+type ListRecommendationsUseCase struct {
+	recommendationRepo recommendation.Repository
+	contentRepo        content.Repository
+	profileRepo        profile.Repository
+	ratingRepo         rating.Repository
+	translationRepo    localization.Repository
+	logger             *log.Logger
+}
+
+func NewListRecommendationsUseCase(
+	recommendationRepo recommendation.Repository,
+	contentRepo content.Repository,
+	profileRepo profile.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListRecommendationsUseCase {
+	return &ListRecommendationsUseCase{
+		recommendationRepo: recommendationRepo,
+		contentRepo:        contentRepo,
+		profileRepo:        profileRepo,
+		ratingRepo:         ratingRepo,
+		translationRepo:    translationRepo,
+		logger:             logger,
+	}
+}
+
+func (uc *ListRecommendationsUseCase) Execute(ctx context.Context, input ListRecommendationsInputDTO) (*ListRecommendationsOutputDTO, error) {
+	profileEntity, err := uc.profileRepo.FindByID(ctx, input.ProfileID)
+	if err != nil {
+		return nil, fault.New(
+			"profile not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	unlocked, err := catalog.CheckPIN(ctx, uc.profileRepo, profileEntity, input.ProfilePIN)
+	if err != nil {
+		return nil, err
+	}
+	maxLevel := profileEntity.EffectiveMaturityLevel(unlocked)
+
+	// The cache ignores maturity, so read all of it and filter here.
+	scored, err := uc.recommendationRepo.ListForProfile(ctx, input.ProfileID, recommendation.MaxPerProfile)
+	if err != nil {
+		return nil, uc.unexpected(input.ProfileID, err)
+	}
+
+	personalized := len(scored) > 0
+	if !personalized {
+		newest, _, err := uc.contentRepo.List(ctx, content.ListFilter{MaxMaturityLevel: &maxLevel, Status: content.PublishedStatus, Limit: input.Limit})
+		if err != nil {
+			return nil, uc.unexpected(input.ProfileID, err)
+		}
+		for _, contentEntity := range newest {
+			scored = append(scored, recommendation.Scored{ContentID: contentEntity.ID()})
+		}
+	}
+
+	items, err := scoredContents(ctx, uc.contentRepo, uc.ratingRepo, uc.translationRepo, input.ProfileID, input.Locales, &maxLevel, scored, input.Limit)
+	if err != nil {
+		return nil, uc.unexpected(input.ProfileID, err)
+	}
+
+	return &ListRecommendationsOutputDTO{
+		Items:        items,
+		Personalized: personalized,
+	}, nil
+}
+
+func (uc *ListRecommendationsUseCase) unexpected(profileID string, err error) error {
+	uc.logger.Error("Failed to list recommendations", "profileID", profileID, "error", err)
+	return fault.New(
+		"failed to list recommendations",
+		fault.WithKind(fault.KindUnexpected),
+		fault.WithError(err),
+	)
+}
@@ -0,0 +1,97 @@
+package recommendation
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/recommendation"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListSimilarInputDTO struct {
+	ContentID  string
+	ProfileID  string
+	ProfilePIN string
+	Locales    []string
+	Limit      int
+}
+
+func (req ListSimilarInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Limit, validation.Min(1), validation.Max(recommendation.MaxSimilarPerContent)),
+	)
+}
+
+type ListSimilarOutputDTO struct {
+	Items []ScoredContentDTO `json:"items"`
+}
+
+type ListSimilarUseCase struct {
+	recommendationRepo recommendation.Repository
+	contentRepo        content.Repository
+	profileRepo        profile.Repository
+	ratingRepo         rating.Repository
+	translationRepo    localization.Repository
+	logger             *log.Logger
+}
+
+func NewListSimilarUseCase(
+	recommendationRepo recommendation.Repository,
+	contentRepo content.Repository,
+	profileRepo profile.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListSimilarUseCase {
+	return &ListSimilarUseCase{
+		recommendationRepo: recommendationRepo,
+		contentRepo:        contentRepo,
+		profileRepo:        profileRepo,
+		ratingRepo:         ratingRepo,
+		translationRepo:    translationRepo,
+		logger:             logger,
+	}
+}
+
+func (uc *ListSimilarUseCase) Execute(ctx context.Context, input ListSimilarInputDTO) (*ListSimilarOutputDTO, error) {
+	if contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID); err != nil || !contentEntity.IsPublished() {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	maxLevel, err := catalog.MaxMaturityLevel(ctx, uc.profileRepo, input.ProfileID, input.ProfilePIN)
+	if err != nil {
+		return nil, err
+	}
+
+	scored, err := uc.recommendationRepo.ListSimilar(ctx, input.ContentID, recommendation.MaxSimilarPerContent)
+	if err != nil {
+		return nil, uc.unexpected(input.ContentID, err)
+	}
+
+	items, err := scoredContents(ctx, uc.contentRepo, uc.ratingRepo, uc.translationRepo, input.ProfileID, input.Locales, maxLevel, scored, input.Limit)
+	if err != nil {
+		return nil, uc.unexpected(input.ContentID, err)
+	}
+
+	return &ListSimilarOutputDTO{Items: items}, nil
+}
+
+func (uc *ListSimilarUseCase) unexpected(contentID string, err error) error {
+	uc.logger.Error("Failed to list similar contents", "contentID", contentID, "error", err)
+	return fault.New(
+		"failed to list similar contents",
+		fault.WithKind(fault.KindUnexpected),
+		fault.WithError(err),
+	)
+}
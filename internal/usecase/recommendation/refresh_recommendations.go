@@ -0,0 +1,80 @@
+package recommendation
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/recommendation"
+)
+
+type RefreshRecommendationsUseCase struct {
+	recommendationRepo recommendation.Repository
+	logger             *log.Logger
+}
+
+func NewRefreshRecommendationsUseCase(recommendationRepo recommendation.Repository, logger *log.Logger) *RefreshRecommendationsUseCase {
+	return &RefreshRecommendationsUseCase{
+		recommendationRepo: recommendationRepo,
+		logger:             logger,
+	}
+}
+
+func (uc *RefreshRecommendationsUseCase) Execute(ctx context.Context) error {
+	features, err := uc.recommendationRepo.LoadFeatures(ctx)
+	if err != nil {
+		return err
+	}
+	signals, err := uc.recommendationRepo.LoadSignals(ctx)
+	if err != nil {
+		return err
+	}
+
+	engine := recommendation.NewEngine(features)
+
+	similarities := make([]recommendation.Similarity, 0)
+	for _, f := range features {
+		for i, scored := range engine.Similar(f.ContentID, recommendation.MaxSimilarPerContent) {
+			similarities = append(similarities, recommendation.Similarity{
+				ContentID:        f.ContentID,
+				SimilarContentID: scored.ContentID,
+				Score:            scored.Score,
+				Rank:             i + 1,
+			})
+		}
+	}
+
+	signalsByProfile := make(map[string][]recommendation.Signal)
+	for _, signal := range signals {
+		signalsByProfile[signal.ProfileID] = append(signalsByProfile[signal.ProfileID], signal)
+	}
+
+	computedAt := time.Now().UTC()
+	recommendations := make([]recommendation.Recommendation, 0)
+	for profileID, profileSignals := range signalsByProfile {
+		for i, scored := range engine.Recommend(profileSignals, recommendation.MaxPerProfile) {
+			recommendations = append(recommendations, recommendation.Recommendation{
+				ProfileID:  profileID,
+				ContentID:  scored.ContentID,
+				Score:      scored.Score,
+				Rank:       i + 1,
+				ComputedAt: computedAt,
+			})
+		}
+	}
+
+	if err := uc.recommendationRepo.ReplaceSimilarities(ctx, similarities); err != nil {
+		return err
+	}
+	if err := uc.recommendationRepo.ReplaceRecommendations(ctx, recommendations); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Recommendations refreshed",
+		"contents", len(features),
+		"profiles", len(signalsByProfile),
+		"similarities", len(similarities),
+		"recommendations", len(recommendations),
+	)
+	return nil
+}
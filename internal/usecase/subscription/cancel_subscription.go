@@ -0,0 +1,60 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type CancelSubscriptionInputDTO struct {
+	AccountID string
+}
+
+func (req CancelSubscriptionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type CancelSubscriptionUseCase struct {
+	subscriptionRepo subscription.Repository
+	logger           *log.Logger
+}
+
+func NewCancelSubscriptionUseCase(subscriptionRepo subscription.Repository, logger *log.Logger) *CancelSubscriptionUseCase {
+	return &CancelSubscriptionUseCase{
+		subscriptionRepo: subscriptionRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *CancelSubscriptionUseCase) Execute(ctx context.Context, input CancelSubscriptionInputDTO) (*SubscriptionOutputDTO, error) {
+	subscriptionEntity, plan, err := findSubscription(ctx, uc.subscriptionRepo, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := subscriptionEntity.Cancel(now); err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindConflict),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.subscriptionRepo.Save(ctx, subscriptionEntity); err != nil {
+		uc.logger.Error("Failed to save subscription", "accountID", input.AccountID, "error", err)
+		return nil, fault.New(
+			"failed to cancel subscription",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return newSubscriptionOutputDTO(subscriptionEntity, plan, now), nil
+}
@@ -0,0 +1,69 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type CheckEntitlementInputDTO struct {
+	AccountID string
+}
+
+type EntitlementOutputDTO struct {
+	PlanCode     string
+	MaxStreams   int
+	MaxHeight    int
+	MaxDownloads int
+	MaxDevices   int
+	PeriodEnd    time.Time
+}
+
+type CheckEntitlementUseCase struct {
+	subscriptionRepo subscription.Repository
+	logger           *log.Logger
+}
+
+func NewCheckEntitlementUseCase(subscriptionRepo subscription.Repository, logger *log.Logger) *CheckEntitlementUseCase {
+	return &CheckEntitlementUseCase{
+		subscriptionRepo: subscriptionRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *CheckEntitlementUseCase) Execute(ctx context.Context, input CheckEntitlementInputDTO) (*EntitlementOutputDTO, error) {
+	subscriptionEntity, plan, err := findSubscription(ctx, uc.subscriptionRepo, input.AccountID)
+	if errors.Is(err, subscription.ErrNotFound) {
+		return nil, fault.New(
+			"an active subscription is required",
+			fault.WithKind(fault.KindPaymentRequired),
+			fault.WithError(err),
+			fault.WithCode("subscription_required"),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !subscriptionEntity.IsEntitledAt(time.Now()) {
+		uc.logger.Warn("Rejected playback of expired subscription", "accountID", input.AccountID, "periodEnd", subscriptionEntity.CurrentPeriodEnd())
+		return nil, fault.New(
+			"subscription has expired",
+			fault.WithKind(fault.KindPaymentRequired),
+			fault.WithCode("subscription_expired"),
+		)
+	}
+
+	return &EntitlementOutputDTO{
+		PlanCode:     plan.Code(),
+		MaxStreams:   plan.MaxStreams(),
+		MaxHeight:    plan.MaxHeight(),
+		MaxDownloads: plan.MaxDownloads(),
+		MaxDevices:   plan.MaxDevices(),
+		PeriodEnd:    subscriptionEntity.CurrentPeriodEnd(),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type PlanOutputDTO struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	MaxStreams   int    `json:"max_streams"`
+	MaxHeight    int    `json:"max_height"`
+	MaxDownloads int    `json:"max_downloads"`
+	MaxDevices   int    `json:"max_devices"`
+	PriceCents   int    `json:"price_cents"`
+}
+
+func newPlanOutputDTO(plan *subscription.Plan) PlanOutputDTO {
+	return PlanOutputDTO{
+		Code:         plan.Code(),
+		Name:         plan.Name(),
+		MaxStreams:   plan.MaxStreams(),
+		MaxHeight:    plan.MaxHeight(),
+		MaxDownloads: plan.MaxDownloads(),
+		MaxDevices:   plan.MaxDevices(),
+		PriceCents:   plan.PriceCents(),
+	}
+}
+
+type SubscriptionOutputDTO struct {
+	ID                 string        `json:"id"`
+	Plan               PlanOutputDTO `json:"plan"`
+	Status             string        `json:"status"`
+	Entitled           bool          `json:"entitled"`
+	CurrentPeriodStart time.Time     `json:"current_period_start"`
+	CurrentPeriodEnd   time.Time     `json:"current_period_end"`
+}
+
+func newSubscriptionOutputDTO(subscriptionEntity *subscription.Subscription, plan *subscription.Plan, now time.Time) *SubscriptionOutputDTO {
+	return &SubscriptionOutputDTO{
+		ID:                 subscriptionEntity.ID(),
+		Plan:               newPlanOutputDTO(plan),
+		Status:             string(subscriptionEntity.Status()),
+		Entitled:           subscriptionEntity.IsEntitledAt(now),
+		CurrentPeriodStart: subscriptionEntity.CurrentPeriodStart(),
+		CurrentPeriodEnd:   subscriptionEntity.CurrentPeriodEnd(),
+	}
+}
+
+func findSubscription(ctx context.Context, subscriptionRepo subscription.Repository, accountID string) (*subscription.Subscription, *subscription.Plan, error) {
+	subscriptionEntity, err := subscriptionRepo.FindByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, subscription.ErrNotFound) {
+			return nil, nil, fault.New(
+				"subscription not found",
+				fault.WithKind(fault.KindNotFound),
+				fault.WithError(err),
+			)
+		}
+		return nil, nil, fault.New(
+			"failed to load subscription",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	plan, err := subscriptionRepo.FindPlan(ctx, subscriptionEntity.PlanCode())
+	if err != nil {
+		return nil, nil, fault.New(
+			"failed to load subscription plan",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return subscriptionEntity, plan, nil
+}
@@ -0,0 +1,40 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+)
+
+type GetSubscriptionInputDTO struct {
+	AccountID string
+}
+
+func (req GetSubscriptionInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type GetSubscriptionUseCase struct {
+	subscriptionRepo subscription.Repository
+	logger           *log.Logger
+}
+
+func NewGetSubscriptionUseCase(subscriptionRepo subscription.Repository, logger *log.Logger) *GetSubscriptionUseCase {
+	return &GetSubscriptionUseCase{
+		subscriptionRepo: subscriptionRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *GetSubscriptionUseCase) Execute(ctx context.Context, input GetSubscriptionInputDTO) (*SubscriptionOutputDTO, error) {
+	subscriptionEntity, plan, err := findSubscription(ctx, uc.subscriptionRepo, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return newSubscriptionOutputDTO(subscriptionEntity, plan, time.Now()), nil
+}
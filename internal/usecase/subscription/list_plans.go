@@ -0,0 +1,43 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListPlansOutputDTO struct {
+	Items []PlanOutputDTO `json:"items"`
+}
+
+type ListPlansUseCase struct {
+	subscriptionRepo subscription.Repository
+	logger           *log.Logger
+}
+
+func NewListPlansUseCase(subscriptionRepo subscription.Repository, logger *log.Logger) *ListPlansUseCase {
+	return &ListPlansUseCase{
+		subscriptionRepo: subscriptionRepo,
+		logger:           logger,
+	}
+}
+
+func (uc *ListPlansUseCase) Execute(ctx context.Context) (*ListPlansOutputDTO, error) {
+	plans, err := uc.subscriptionRepo.ListPlans(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list plans", "error", err)
+		return nil, fault.New(
+			"failed to list plans",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	items := make([]PlanOutputDTO, 0, len(plans))
+	for _, plan := range plans {
+		items = append(items, newPlanOutputDTO(plan))
+	}
+	return &ListPlansOutputDTO{Items: items}, nil
+}
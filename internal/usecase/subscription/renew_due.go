@@ -0,0 +1,70 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/internal/infra/payment"
+)
+
+const renewBatchSize = 100
+
+type RenewDueUseCase struct {
+	subscriptionRepo subscription.Repository
+	paymentProvider  payment.Provider
+	logger           *log.Logger
+}
+
+func NewRenewDueUseCase(subscriptionRepo subscription.Repository, paymentProvider payment.Provider, logger *log.Logger) *RenewDueUseCase {
+	return &RenewDueUseCase{
+		subscriptionRepo: subscriptionRepo,
+		paymentProvider:  paymentProvider,
+		logger:           logger,
+	}
+}
+
+func (uc *RenewDueUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	due, err := uc.subscriptionRepo.FindDue(ctx, now, renewBatchSize)
+	if err != nil {
+		return err
+	}
+
+	renewed, expired := 0, 0
+	for _, subscriptionEntity := range due {
+		if uc.renew(ctx, subscriptionEntity, now) {
+			renewed++
+		} else {
+			subscriptionEntity.Expire(now)
+			expired++
+		}
+		if err := uc.subscriptionRepo.Save(ctx, subscriptionEntity); err != nil {
+			return err
+		}
+	}
+
+	if renewed > 0 || expired > 0 {
+		uc.logger.Info("Processed due subscriptions", "renewed", renewed, "expired", expired)
+	}
+	return nil
+}
+
+func (uc *RenewDueUseCase) renew(ctx context.Context, subscriptionEntity *subscription.Subscription, now time.Time) bool {
+	if subscriptionEntity.Status() != subscription.ActiveStatus {
+		return false
+	}
+
+	plan, err := uc.subscriptionRepo.FindPlan(ctx, subscriptionEntity.PlanCode())
+	if err != nil {
+		uc.logger.Error("Failed to load plan for renewal", "subscriptionID", subscriptionEntity.ID(), "error", err)
+		return false
+	}
+	reference, err := uc.paymentProvider.Charge(ctx, subscriptionEntity.AccountID(), plan.PriceCents(), plan.Name()+" plan renewal")
+	if err != nil {
+		uc.logger.Warn("Subscription renewal payment failed", "subscriptionID", subscriptionEntity.ID(), "error", err)
+		return false
+	}
+	return subscriptionEntity.Renew(reference, now) == nil
+}
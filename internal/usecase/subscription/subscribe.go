@@ -0,0 +1,115 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/subscription"
+	"github.com/hoyci/fakeflix/internal/infra/payment"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SubscribeInputDTO struct {
+	AccountID string `json:"-"`
+	Plan      string `json:"plan"`
+}
+
+func (req SubscribeInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.Plan, validation.Required.Error("plan is required")),
+	)
+}
+
+type SubscribeUseCase struct {
+	subscriptionRepo subscription.Repository
+	paymentProvider  payment.Provider
+	logger           *log.Logger
+}
+
+func NewSubscribeUseCase(subscriptionRepo subscription.Repository, paymentProvider payment.Provider, logger *log.Logger) *SubscribeUseCase {
+	return &SubscribeUseCase{
+		subscriptionRepo: subscriptionRepo,
+		paymentProvider:  paymentProvider,
+		logger:           logger,
+	}
+}
+
+func (uc *SubscribeUseCase) Execute(ctx context.Context, input SubscribeInputDTO) (*SubscriptionOutputDTO, error) {
+	plan, err := uc.subscriptionRepo.FindPlan(ctx, input.Plan)
+	if err != nil {
+		if errors.Is(err, subscription.ErrPlanNotFound) {
+			return nil, fault.New(
+				"unknown plan",
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+		return nil, fault.New(
+			"failed to load plan",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	now := time.Now()
+	current, err := uc.subscriptionRepo.FindByAccountID(ctx, input.AccountID)
+	if err != nil && !errors.Is(err, subscription.ErrNotFound) {
+		return nil, fault.New(
+			"failed to load subscription",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if current != nil && current.Status() == subscription.ActiveStatus && current.PlanCode() == plan.Code() && current.IsEntitledAt(now) {
+		return nil, fault.New(
+			"account is already subscribed to this plan",
+			fault.WithKind(fault.KindConflict),
+		)
+	}
+
+	reference, err := uc.paymentProvider.Charge(ctx, input.AccountID, plan.PriceCents(), plan.Name()+" plan")
+	if err != nil {
+		uc.logger.Warn("Subscription payment failed", "accountID", input.AccountID, "plan", plan.Code(), "error", err)
+		if errors.Is(err, payment.ErrDeclined) {
+			return nil, fault.New(
+				"payment declined",
+				fault.WithKind(fault.KindPaymentRequired),
+				fault.WithError(err),
+				fault.WithCode("payment_declined"),
+			)
+		}
+		return nil, fault.New(
+			"failed to charge subscription",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if current == nil {
+		current, err = subscription.NewSubscription(input.AccountID, plan, reference, now)
+	} else {
+		err = current.Restart(plan, reference, now)
+	}
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.subscriptionRepo.Save(ctx, current); err != nil {
+		uc.logger.Error("Failed to save subscription", "accountID", input.AccountID, "reference", reference, "error", err)
+		return nil, fault.New(
+			"failed to save subscription",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return newSubscriptionOutputDTO(current, plan, now), nil
+}
@@ -0,0 +1,79 @@
+package taxonomy
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/taxonomy"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetTaxonomyInputDTO struct {
+	ContentID string
+	Genres    []string `json:"genres"`
+	Tags      []string `json:"tags"`
+}
+
+func (req SetTaxonomyInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Genres, validation.Length(0, taxonomy.MaxGenres)),
+		validation.Field(&req.Tags, validation.Length(0, taxonomy.MaxTags)),
+	)
+}
+
+type TaxonomyOutputDTO struct {
+	ContentID string   `json:"content_id"`
+	Genres    []string `json:"genres"`
+	Tags      []string `json:"tags"`
+}
+
+type SetTaxonomyUseCase struct {
+	taxonomyRepo taxonomy.Repository
+	contentRepo  content.Repository
+	logger       *log.Logger
+}
+
+func NewSetTaxonomyUseCase(taxonomyRepo taxonomy.Repository, contentRepo content.Repository, logger *log.Logger) *SetTaxonomyUseCase {
+	return &SetTaxonomyUseCase{
+		taxonomyRepo: taxonomyRepo,
+		contentRepo:  contentRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *SetTaxonomyUseCase) Execute(ctx context.Context, input SetTaxonomyInputDTO) (*TaxonomyOutputDTO, error) {
+	if _, err := uc.contentRepo.FindByID(ctx, input.ContentID); err != nil {
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	taxonomyEntity, err := taxonomy.NewTaxonomy(input.ContentID, input.Genres, input.Tags)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.taxonomyRepo.Replace(ctx, taxonomyEntity); err != nil {
+		uc.logger.Error("Failed to save content taxonomy", "contentID", input.ContentID, "error", err)
+		return nil, fault.New(
+			"failed to save genres and tags",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &TaxonomyOutputDTO{
+		ContentID: taxonomyEntity.ContentID(),
+		Genres:    taxonomyEntity.Genres(),
+		Tags:      taxonomyEntity.Tags(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package trending
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/trending"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListTrendingInputDTO struct {
+	Window     string
+	ProfileID  string
+	ProfilePIN string
+	Locales    []string
+	Limit      int
+}
+
+func (req ListTrendingInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Window,
+			validation.Required.Error("window is required"),
+			validation.In(string(trending.Day), string(trending.Week)).Error("window must be 24h or 7d"),
+		),
+		validation.Field(&req.Limit, validation.Min(1), validation.Max(trending.MaxRanked)),
+	)
+}
+
+type TrendingItemDTO struct {
+	Rank    int                      `json:"rank"`
+	Score   float64                  `json:"score"`
+	Content catalog.ContentOutputDTO `json:"content"`
+}
+
+type ListTrendingOutputDTO struct {
+	Title  string            `json:"title,omitempty"`
+	Window string            `json:"window"`
+	Items  []TrendingItemDTO `json:"items"`
+}
+
+type ListTrendingUseCase struct {
+	trendingRepo    trending.Repository
+	contentRepo     content.Repository
+	profileRepo     profile.Repository
+	ratingRepo      rating.Repository
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewListTrendingUseCase(
+	trendingRepo trending.Repository,
+	contentRepo content.Repository,
+	profileRepo profile.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListTrendingUseCase {
+	return &ListTrendingUseCase{
+		trendingRepo:    trendingRepo,
+		contentRepo:     contentRepo,
+		profileRepo:     profileRepo,
+		ratingRepo:      ratingRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *ListTrendingUseCase) Execute(ctx context.Context, input ListTrendingInputDTO) (*ListTrendingOutputDTO, error) {
+	maxLevel, err := catalog.MaxMaturityLevel(ctx, uc.profileRepo, input.ProfileID, input.ProfilePIN)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the whole ranking so restricted profiles still get a full page
+	// after filtering.
+	scores, err := uc.trendingRepo.List(ctx, trending.Window(input.Window), trending.MaxRanked)
+	if err != nil {
+		return nil, uc.unexpected(input.Window, err)
+	}
+
+	contentIDs := make([]string, 0, len(scores))
+	scoresByID := make(map[string]float64, len(scores))
+	for _, score := range scores {
+		contentIDs = append(contentIDs, score.ContentID)
+		scoresByID[score.ContentID] = score.Score
+	}
+
+	contents, err := catalog.ItemsByIDs(ctx, uc.contentRepo, uc.ratingRepo, uc.translationRepo, input.ProfileID, input.Locales, maxLevel, contentIDs, input.Limit)
+	if err != nil {
+		return nil, uc.unexpected(input.Window, err)
+	}
+
+	items := make([]TrendingItemDTO, 0, len(contents))
+	for i, item := range contents {
+		items = append(items, TrendingItemDTO{
+			Rank:    i + 1,
+			Score:   scoresByID[item.ID],
+			Content: item,
+		})
+	}
+
+	return &ListTrendingOutputDTO{
+		Window: input.Window,
+		Items:  items,
+	}, nil
+}
+
+func (uc *ListTrendingUseCase) unexpected(window string, err error) error {
+	uc.logger.Error("Failed to list trending contents", "window", window, "error", err)
+	return fault.New(
+		"failed to list trending contents",
+		fault.WithKind(fault.KindUnexpected),
+		fault.WithError(err),
+	)
+}
@@ -0,0 +1,33 @@
+package trending
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/trending"
+)
+
+type RollupTrendingUseCase struct {
+	trendingRepo trending.Repository
+	logger       *log.Logger
+}
+
+func NewRollupTrendingUseCase(trendingRepo trending.Repository, logger *log.Logger) *RollupTrendingUseCase {
+	return &RollupTrendingUseCase{
+		trendingRepo: trendingRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *RollupTrendingUseCase) Execute(ctx context.Context) error {
+	now := time.Now().UTC()
+	for _, window := range trending.Windows {
+		ranked, err := uc.trendingRepo.Rollup(ctx, window, now)
+		if err != nil {
+			return err
+		}
+		uc.logger.Debug("Trending window rolled up", "window", window, "ranked", ranked)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"path/filepath"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const assetsFolder = "upload/assets"
+
+type AddAssetInputDTO struct {
+	VideoID  string
+	Kind     string
+	Language string
+	Label    string
+	File     *multipart.FileHeader
+}
+
+func (req AddAssetInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.Kind,
+			validation.Required.Error("kind is required"),
+			validation.In(string(video.SubtitleAsset), string(video.AudioAsset), string(video.TrickPlayAsset), string(video.DASHAsset)).
+				Error("kind must be one of SUBTITLE, AUDIO, TRICKPLAY or DASH"),
+		),
+		validation.Field(&req.Label, validation.Length(0, 100)),
+		validation.Field(&req.File, validation.Required.Error("file is required")),
+	)
+}
+
+type AddAssetUseCase struct {
+	videoRepo    video.Repository
+	assetRepo    video.AssetRepository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewAddAssetUseCase(videoRepo video.Repository, assetRepo video.AssetRepository, mediaService media.MediaService, logger *log.Logger) *AddAssetUseCase {
+	return &AddAssetUseCase{
+		videoRepo:    videoRepo,
+		assetRepo:    assetRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *AddAssetUseCase) Execute(ctx context.Context, input AddAssetInputDTO) (*AssetOutputDTO, error) {
+	if _, err := uc.videoRepo.FindByID(ctx, input.VideoID); err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, video.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	language := input.Language
+	if language != "" {
+		normalized, err := localization.NormalizeLocale(language)
+		if err != nil {
+			return nil, fault.New(
+				err.Error(),
+				fault.WithKind(fault.KindValidation),
+				fault.WithError(err),
+			)
+		}
+		language = normalized
+	}
+
+	folder := filepath.Join(assetsFolder, input.VideoID)
+	asset, err := video.NewAsset(input.VideoID, video.AssetKind(input.Kind), language, input.Label, "/"+filepath.Join(folder, input.File.Filename))
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if _, err := uc.mediaService.Store(input.File, folder); err != nil {
+		uc.logger.Error("Failed to store video asset", "videoID", input.VideoID, "error", err)
+		return nil, fault.New(
+			"failed to store video asset",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.assetRepo.Save(ctx, asset); err != nil {
+		return nil, fault.New(
+			"failed to save video asset",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Video asset added", "videoID", input.VideoID, "assetID", asset.ID(), "kind", asset.Kind())
+	return newAssetOutputDTO(asset), nil
+}
@@ -0,0 +1,33 @@
+package video
+
+import (
+	"time"
+
+	"github.com/hoyci/fakeflix/internal/domain/video"
+)
+
+type AssetOutputDTO struct {
+	ID        string    `json:"id"`
+	VideoID   string    `json:"video_id"`
+	Kind      string    `json:"kind"`
+	Language  string    `json:"language,omitempty"`
+	Label     string    `json:"label,omitempty"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newAssetOutputDTO(a *video.Asset) *AssetOutputDTO {
+	return &AssetOutputDTO{
+		ID:        a.ID(),
+		VideoID:   a.VideoID(),
+		Kind:      string(a.Kind()),
+		Language:  a.Language(),
+		Label:     a.Label(),
+		URL:       assetURL(a),
+		CreatedAt: a.CreatedAt(),
+	}
+}
+
+func assetURL(a *video.Asset) string {
+	return "/videos/" + a.VideoID() + "/assets/" + a.ID()
+}
@@ -0,0 +1,64 @@
+package video
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteAssetInputDTO struct {
+	VideoID string
+	AssetID string
+}
+
+func (req DeleteAssetInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.AssetID, validation.Required.Error("assetID is required")),
+	)
+}
+
+type DeleteAssetUseCase struct {
+	assetRepo video.AssetRepository
+	logger    *log.Logger
+}
+
+func NewDeleteAssetUseCase(assetRepo video.AssetRepository, logger *log.Logger) *DeleteAssetUseCase {
+	return &DeleteAssetUseCase{
+		assetRepo: assetRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *DeleteAssetUseCase) Execute(ctx context.Context, input DeleteAssetInputDTO) error {
+	asset, err := uc.assetRepo.FindByID(ctx, input.AssetID)
+	if errors.Is(err, video.ErrAssetNotFound) || (err == nil && asset.VideoID() != input.VideoID) {
+		return fault.New(
+			"video asset not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return fault.New(
+			"failed to load video asset",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.assetRepo.Delete(ctx, input.AssetID); err != nil {
+		return fault.New(
+			"failed to delete video asset",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Video asset deleted", "videoID", input.VideoID, "assetID", input.AssetID)
+	return nil
+}
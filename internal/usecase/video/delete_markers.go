@@ -0,0 +1,54 @@
+package video
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type DeleteMarkersInputDTO struct {
+	VideoID string
+}
+
+func (req DeleteMarkersInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+	)
+}
+
+type DeleteMarkersUseCase struct {
+	markerRepo video.MarkerRepository
+	logger     *log.Logger
+}
+
+func NewDeleteMarkersUseCase(markerRepo video.MarkerRepository, logger *log.Logger) *DeleteMarkersUseCase {
+	return &DeleteMarkersUseCase{
+		markerRepo: markerRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *DeleteMarkersUseCase) Execute(ctx context.Context, input DeleteMarkersInputDTO) error {
+	err := uc.markerRepo.Delete(ctx, input.VideoID)
+	if errors.Is(err, video.ErrMarkersNotFound) {
+		return fault.New(
+			"video markers not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return fault.New(
+			"failed to delete video markers",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Video markers deleted", "videoID", input.VideoID)
+	return nil
+}
@@ -0,0 +1,182 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/episode"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const (
+	introScanSeconds = 300
+	// minIntroSeconds keeps short shared stingers from passing as intros.
+	minIntroSeconds = 10
+)
+
+type DetectIntrosInputDTO struct {
+	ContentID string
+	Season    int
+}
+
+func (req DetectIntrosInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Season, validation.Required.Error("season is required"), validation.Min(1)),
+	)
+}
+
+type DetectIntrosOutputDTO struct {
+	Items   []*MarkersOutputDTO `json:"items"`
+	Skipped []string            `json:"skipped"`
+}
+
+type DetectIntrosUseCase struct {
+	contentRepo  content.Repository
+	markerRepo   video.MarkerRepository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewDetectIntrosUseCase(contentRepo content.Repository, markerRepo video.MarkerRepository, mediaService media.MediaService, logger *log.Logger) *DetectIntrosUseCase {
+	return &DetectIntrosUseCase{
+		contentRepo:  contentRepo,
+		markerRepo:   markerRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *DetectIntrosUseCase) Execute(ctx context.Context, input DetectIntrosInputDTO) (*DetectIntrosOutputDTO, error) {
+	episodes, err := uc.seasonEpisodes(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprints := make([]video.Fingerprint, len(episodes))
+	for i, ep := range episodes {
+		frames, frameSeconds, err := uc.mediaService.AudioFingerprint(strings.TrimPrefix(ep.Video().URL(), "/"), introScanSeconds)
+		if err != nil {
+			uc.logger.Error("Failed to fingerprint episode audio", "contentID", input.ContentID, "episodeID", ep.ID(), "error", err)
+			return nil, fault.New(
+				"failed to fingerprint episode audio",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+		fingerprints[i] = video.Fingerprint{Frames: frames, FrameSeconds: frameSeconds}
+	}
+
+	videoIDs := make([]string, len(episodes))
+	for i, ep := range episodes {
+		videoIDs[i] = ep.Video().ID()
+	}
+	existing, err := uc.markerRepo.ListByVideoIDs(ctx, videoIDs)
+	if err != nil {
+		return nil, fault.New(
+			"failed to load video markers",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	markersByVideo := make(map[string]*video.Markers, len(existing))
+	for _, m := range existing {
+		markersByVideo[m.VideoID()] = m
+	}
+
+	output := &DetectIntrosOutputDTO{Items: []*MarkersOutputDTO{}, Skipped: []string{}}
+	for i, ep := range episodes {
+		videoEntity := ep.Video()
+		previous := markersByVideo[videoEntity.ID()]
+		if previous != nil && previous.Source() == video.ManualSource {
+			output.Skipped = append(output.Skipped, videoEntity.ID())
+			continue
+		}
+
+		neighbour := i + 1
+		if neighbour == len(episodes) {
+			neighbour = i - 1
+		}
+		intro, _, ok := video.SharedSpan(fingerprints[i], fingerprints[neighbour], minIntroSeconds)
+		if !ok {
+			uc.logger.Info("No intro found", "contentID", input.ContentID, "episodeID", ep.ID())
+			output.Skipped = append(output.Skipped, videoEntity.ID())
+			continue
+		}
+		if videoEntity.Duration() > 0 {
+			intro.End = min(intro.End, videoEntity.Duration())
+		}
+
+		var recap *video.Span
+		var creditsStart *int
+		if previous != nil {
+			recap, creditsStart = previous.Recap(), previous.CreditsStart()
+		}
+		markers, err := video.NewMarkers(videoEntity.ID(), videoEntity.Duration(), &intro, recap, creditsStart, video.DetectedSource)
+		if err != nil {
+			uc.logger.Warn("Discarded detected intro", "contentID", input.ContentID, "episodeID", ep.ID(), "error", err)
+			output.Skipped = append(output.Skipped, videoEntity.ID())
+			continue
+		}
+		if err := uc.markerRepo.Save(ctx, markers); err != nil {
+			return nil, fault.New(
+				"failed to save video markers",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+		output.Items = append(output.Items, newMarkersOutputDTO(markers))
+	}
+
+	uc.logger.Info("Intros detected", "contentID", input.ContentID, "season", input.Season, "found", len(output.Items), "skipped", len(output.Skipped))
+	return output, nil
+}
+
+func (uc *DetectIntrosUseCase) seasonEpisodes(ctx context.Context, input DetectIntrosInputDTO) ([]*episode.Episode, error) {
+	contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, content.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	show, err := contentEntity.TvShow()
+	if err != nil || show == nil {
+		return nil, fault.New(
+			"intros can only be detected for tv shows",
+			fault.WithKind(fault.KindConflict),
+			fault.WithError(err),
+		)
+	}
+
+	var episodes []*episode.Episode
+	for _, ep := range show.Episodes() {
+		if ep.Season() == input.Season && ep.Video() != nil {
+			episodes = append(episodes, ep)
+		}
+	}
+	if len(episodes) < 2 {
+		return nil, fault.New(
+			"season needs at least two episodes to detect intros",
+			fault.WithKind(fault.KindConflict),
+		)
+	}
+
+	slices.SortFunc(episodes, func(a, b *episode.Episode) int {
+		return a.Number() - b.Number()
+	})
+	return episodes, nil
+}
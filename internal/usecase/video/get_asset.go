@@ -0,0 +1,74 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetAssetInputDTO struct {
+	GetStreamInfoInputDTO
+	AssetID string
+}
+
+func (req GetAssetInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.AssetID, validation.Required.Error("assetID is required")),
+	)
+}
+
+type GetAssetOutputDTO struct {
+	FilePath string
+	Kind     string
+}
+
+type GetAssetUseCase struct {
+	assetRepo            video.AssetRepository
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	logger               *log.Logger
+}
+
+func NewGetAssetUseCase(assetRepo video.AssetRepository, getStreamInfoUseCase *GetStreamInfoUseCase, logger *log.Logger) *GetAssetUseCase {
+	return &GetAssetUseCase{
+		assetRepo:            assetRepo,
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		logger:               logger,
+	}
+}
+
+func (uc *GetAssetUseCase) Execute(ctx context.Context, input GetAssetInputDTO) (*GetAssetOutputDTO, error) {
+	// Assets are checked like downloads: fetching one takes up none of the
+	// account's streams and is not bound to a resolution.
+	streamInput := input.GetStreamInfoInputDTO
+	streamInput.Offline = true
+	if _, err := uc.getStreamInfoUseCase.Execute(ctx, streamInput); err != nil {
+		return nil, err
+	}
+
+	asset, err := uc.assetRepo.FindByID(ctx, input.AssetID)
+	if errors.Is(err, video.ErrAssetNotFound) || (err == nil && asset.VideoID() != input.VideoID) {
+		return nil, fault.New(
+			"video asset not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return nil, fault.New(
+			"failed to load video asset",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &GetAssetOutputDTO{
+		FilePath: strings.TrimPrefix(asset.URL(), "/"),
+		Kind:     string(asset.Kind()),
+	}, nil
+}
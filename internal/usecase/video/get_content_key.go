@@ -0,0 +1,126 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetContentKeyInputDTO struct {
+	GetStreamInfoInputDTO
+}
+
+func (req GetContentKeyInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+	)
+}
+
+type GetContentKeyOutputDTO struct {
+	Key []byte
+}
+
+type GetContentKeyUseCase struct {
+	packageRepo          video.PackageRepository
+	sessionRepo          playback.SessionRepository
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	timeout              time.Duration
+	logger               *log.Logger
+}
+
+func NewGetContentKeyUseCase(
+	packageRepo video.PackageRepository,
+	sessionRepo playback.SessionRepository,
+	getStreamInfoUseCase *GetStreamInfoUseCase,
+	timeout time.Duration,
+	logger *log.Logger,
+) *GetContentKeyUseCase {
+	return &GetContentKeyUseCase{
+		packageRepo:          packageRepo,
+		sessionRepo:          sessionRepo,
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		timeout:              timeout,
+		logger:               logger,
+	}
+}
+
+func (uc *GetContentKeyUseCase) Execute(ctx context.Context, input GetContentKeyInputDTO) (*GetContentKeyOutputDTO, error) {
+	if input.SessionID == "" {
+		if err := checkExtra(ctx, uc.getStreamInfoUseCase, input.GetStreamInfoInputDTO); err != nil {
+			uc.logger.Warn("Refused content key without a session", "videoID", input.VideoID, "accountID", input.AccountID)
+			return nil, err
+		}
+	} else {
+		if err := checkSession(ctx, uc.sessionRepo, uc.timeout, input.GetStreamInfoInputDTO); err != nil {
+			uc.logger.Warn("Refused content key", "videoID", input.VideoID, "accountID", input.AccountID, "sessionID", input.SessionID)
+			return nil, err
+		}
+
+		// The session is on this video, so resuming it only keeps it alive.
+		streamInput := input.GetStreamInfoInputDTO
+		streamInput.FixedResolution = true
+		if _, err := uc.getStreamInfoUseCase.Execute(ctx, streamInput); err != nil {
+			return nil, err
+		}
+	}
+
+	hlsPackage, err := findPackage(ctx, uc.packageRepo, input.VideoID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Debug("Content key delivered", "videoID", input.VideoID, "sessionID", input.SessionID)
+	return &GetContentKeyOutputDTO{Key: hlsPackage.Key()}, nil
+}
+
+func checkSession(ctx context.Context, sessionRepo playback.SessionRepository, timeout time.Duration, input GetStreamInfoInputDTO) error {
+	if input.SessionID == "" {
+		return fault.New(
+			"an active playback session is required",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+
+	session, err := sessionRepo.FindByID(ctx, input.SessionID)
+	if err != nil && !errors.Is(err, playback.ErrSessionNotFound) {
+		return fault.New(
+			"failed to load playback session",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if session == nil ||
+		session.AccountID() != input.AccountID ||
+		session.VideoID() != input.VideoID ||
+		!session.IsActiveAt(time.Now(), timeout) {
+		return fault.New(
+			"playback session is not active for this video",
+			fault.WithKind(fault.KindForbidden),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
+
+func checkExtra(ctx context.Context, getStreamInfoUseCase *GetStreamInfoUseCase, input GetStreamInfoInputDTO) error {
+	input.Offline = true
+	streamInfo, err := getStreamInfoUseCase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+	if streamInfo.Entitlement != nil {
+		return fault.New(
+			"an active playback session is required",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package video
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type GetMarkersInputDTO struct {
+	VideoID string
+}
+
+func (req GetMarkersInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+	)
+}
+
+type GetMarkersUseCase struct {
+	markerRepo video.MarkerRepository
+	logger     *log.Logger
+}
+
+func NewGetMarkersUseCase(markerRepo video.MarkerRepository, logger *log.Logger) *GetMarkersUseCase {
+	return &GetMarkersUseCase{
+		markerRepo: markerRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *GetMarkersUseCase) Execute(ctx context.Context, input GetMarkersInputDTO) (*MarkersOutputDTO, error) {
+	markers, err := uc.markerRepo.FindByVideoID(ctx, input.VideoID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, video.ErrMarkersNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"video markers not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	return newMarkersOutputDTO(markers), nil
+}
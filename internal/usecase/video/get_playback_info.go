@@ -0,0 +1,347 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/episode"
+	"github.com/hoyci/fakeflix/internal/domain/progress"
+	"github.com/hoyci/fakeflix/internal/domain/tvshow"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const (
+	ProgressiveManifest = "PROGRESSIVE"
+	HLSManifest         = "HLS"
+	DASHManifest        = "DASH"
+)
+
+type GetPlaybackInfoInputDTO struct {
+	ContentID   string
+	EpisodeID   string
+	AccountID   string
+	ProfileID   string
+	DeviceID    string
+	ProfilePIN  string
+	PINUnlocked bool
+	Country     string
+	SessionID   string
+	UserAgent   string
+}
+
+func (req GetPlaybackInfoInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+	)
+}
+
+type ManifestDTO struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	Encrypted bool   `json:"encrypted"`
+}
+
+type TrackDTO struct {
+	ID       string `json:"id"`
+	Language string `json:"language,omitempty"`
+	Label    string `json:"label,omitempty"`
+	URL      string `json:"url"`
+}
+
+type PlaybackEpisodeDTO struct {
+	ID          string `json:"id"`
+	VideoID     string `json:"video_id"`
+	Title       string `json:"title"`
+	Season      int    `json:"season"`
+	Number      int    `json:"number"`
+	PlaybackURL string `json:"playback_url"`
+}
+
+type GetPlaybackInfoOutputDTO struct {
+	ContentID      string              `json:"content_id"`
+	VideoID        string              `json:"video_id"`
+	Episode        *PlaybackEpisodeDTO `json:"episode,omitempty"`
+	Duration       int                 `json:"duration"`
+	SessionID      string              `json:"session_id,omitempty"`
+	Manifests      []ManifestDTO       `json:"manifests"`
+	Subtitles      []TrackDTO          `json:"subtitles"`
+	AudioTracks    []TrackDTO          `json:"audio_tracks"`
+	TrickPlay      *TrackDTO           `json:"trick_play,omitempty"`
+	Markers        *MarkersOutputDTO   `json:"markers,omitempty"`
+	ResumePosition int                 `json:"resume_position"`
+	NextEpisode    *PlaybackEpisodeDTO `json:"next_episode,omitempty"`
+	ExpiresAt      time.Time           `json:"expires_at"`
+}
+
+type GetPlaybackInfoUseCase struct {
+	contentRepo          content.Repository
+	progressRepo         progress.Repository
+	packageRepo          video.PackageRepository
+	assetRepo            video.AssetRepository
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	urlSigner            auth.URLSigner
+	logger               *log.Logger
+}
+
+func NewGetPlaybackInfoUseCase(
+	contentRepo content.Repository,
+	progressRepo progress.Repository,
+	packageRepo video.PackageRepository,
+	assetRepo video.AssetRepository,
+	getStreamInfoUseCase *GetStreamInfoUseCase,
+	urlSigner auth.URLSigner,
+	logger *log.Logger,
+) *GetPlaybackInfoUseCase {
+	return &GetPlaybackInfoUseCase{
+		contentRepo:          contentRepo,
+		progressRepo:         progressRepo,
+		packageRepo:          packageRepo,
+		assetRepo:            assetRepo,
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		urlSigner:            urlSigner,
+		logger:               logger,
+	}
+}
+
+func (uc *GetPlaybackInfoUseCase) Execute(ctx context.Context, input GetPlaybackInfoInputDTO) (*GetPlaybackInfoOutputDTO, error) {
+	contentEntity, err := uc.contentRepo.FindByID(ctx, input.ContentID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, content.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"content not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	target, err := uc.resolveTarget(ctx, input, contentEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	streamInfo, err := uc.getStreamInfoUseCase.Execute(ctx, GetStreamInfoInputDTO{
+		VideoID:     target.video.ID(),
+		AccountID:   input.AccountID,
+		ProfileID:   input.ProfileID,
+		DeviceID:    input.DeviceID,
+		ProfilePIN:  input.ProfilePIN,
+		PINUnlocked: input.PINUnlocked,
+		Country:     input.Country,
+		SessionID:   input.SessionID,
+		UserAgent:   input.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetPlaybackInfoOutputDTO{
+		ContentID:      contentEntity.ID(),
+		VideoID:        target.video.ID(),
+		Duration:       streamInfo.Duration,
+		SessionID:      streamInfo.SessionID,
+		Manifests:      []ManifestDTO{},
+		Subtitles:      []TrackDTO{},
+		AudioTracks:    []TrackDTO{},
+		Markers:        streamInfo.Markers,
+		ResumePosition: target.resumePosition,
+	}
+	if target.episode != nil {
+		output.Episode = newPlaybackEpisodeDTO(contentEntity.ID(), target.episode)
+		if next := target.show.NextEpisode(target.episode.ID()); next != nil {
+			output.NextEpisode = newPlaybackEpisodeDTO(contentEntity.ID(), next)
+		}
+	}
+
+	// Media URLs carry the session so every request the player makes counts
+	// against the same stream, and the device and PIN unlock so they stop
+	// working once the device is signed out and need no PIN header.
+	sign := func(path string) string {
+		if streamInfo.SessionID != "" {
+			path += "?" + url.Values{playbackSessionParam: {streamInfo.SessionID}}.Encode()
+		}
+		signed, expiresAt, err := uc.urlSigner.Sign(path, auth.Claims{
+			AccountID:   input.AccountID,
+			ProfileID:   input.ProfileID,
+			DeviceID:    input.DeviceID,
+			PINUnlocked: streamInfo.PINUnlocked,
+		})
+		if err != nil {
+			uc.logger.Error("Failed to sign playback url", "path", path, "error", err)
+			return path
+		}
+		output.ExpiresAt = expiresAt
+		return signed
+	}
+
+	videoID := target.video.ID()
+	output.Manifests = append(output.Manifests, ManifestDTO{Type: ProgressiveManifest, URL: sign("/videos/" + videoID + "/stream")})
+
+	// HLS packages only come in the resolution of their video, so videos
+	// above the plan are only offered as a scaled down progressive stream.
+	if streamInfo.MaxHeight == 0 {
+		if _, err := uc.packageRepo.FindByVideoID(ctx, videoID); err == nil {
+			output.Manifests = append(output.Manifests, ManifestDTO{Type: HLSManifest, URL: sign("/videos/" + videoID + "/hls/index.m3u8"), Encrypted: true})
+		} else if !errors.Is(err, video.ErrPackageNotFound) {
+			uc.logger.Warn("Failed to load video package", "videoID", videoID, "error", err)
+		}
+	}
+
+	assets, err := uc.assetRepo.ListByVideoID(ctx, videoID)
+	if err != nil {
+		return nil, fault.New(
+			"failed to load video assets",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	for _, asset := range assets {
+		track := TrackDTO{ID: asset.ID(), Language: asset.Language(), Label: asset.Label(), URL: sign(assetURL(asset))}
+		switch asset.Kind() {
+		case video.SubtitleAsset:
+			output.Subtitles = append(output.Subtitles, track)
+		case video.AudioAsset:
+			output.AudioTracks = append(output.AudioTracks, track)
+		case video.TrickPlayAsset:
+			output.TrickPlay = &track
+		case video.DASHAsset:
+			output.Manifests = append(output.Manifests, ManifestDTO{Type: DASHManifest, URL: track.URL})
+		}
+	}
+
+	uc.logger.Debug("Playback info built", "contentID", input.ContentID, "videoID", videoID, "manifests", len(output.Manifests))
+	return output, nil
+}
+
+type playbackTarget struct {
+	video          *video.Video
+	show           *tvshow.TvShow
+	episode        *episode.Episode
+	resumePosition int
+}
+
+func (uc *GetPlaybackInfoUseCase) resolveTarget(ctx context.Context, input GetPlaybackInfoInputDTO, contentEntity *content.Content) (*playbackTarget, error) {
+	if mov, err := contentEntity.Movie(); err == nil {
+		if mov == nil || mov.Video() == nil {
+			return nil, fault.New(
+				"content has no video to play",
+				fault.WithKind(fault.KindNotFound),
+			)
+		}
+		if input.EpisodeID != "" {
+			return nil, fault.New(
+				"movies have no episodes",
+				fault.WithKind(fault.KindNotFound),
+			)
+		}
+		target := &playbackTarget{video: mov.Video()}
+		target.resumePosition = uc.resumePosition(ctx, input.ProfileID, mov.Video().ID())
+		return target, nil
+	}
+
+	show, err := contentEntity.TvShow()
+	if err != nil || show == nil || len(show.Episodes()) == 0 {
+		return nil, fault.New(
+			"content has no video to play",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	target := &playbackTarget{show: show}
+	if input.EpisodeID != "" {
+		for _, ep := range show.Episodes() {
+			if ep.ID() == input.EpisodeID {
+				target.episode = ep
+			}
+		}
+		if target.episode == nil {
+			return nil, fault.New(
+				"episode not found",
+				fault.WithKind(fault.KindNotFound),
+			)
+		}
+		target.video = target.episode.Video()
+		target.resumePosition = uc.resumePosition(ctx, input.ProfileID, target.video.ID())
+		return target, nil
+	}
+
+	if latest := uc.latestProgress(ctx, input.ProfileID, contentEntity.ID()); latest != nil && latest.EpisodeID() != "" {
+		for _, ep := range show.Episodes() {
+			if ep.ID() != latest.EpisodeID() {
+				continue
+			}
+			if !latest.IsCompleted() {
+				target.episode, target.resumePosition = ep, latest.PositionSeconds()
+			} else {
+				target.episode = show.NextEpisode(ep.ID())
+			}
+		}
+	}
+	if target.episode == nil {
+		target.episode = firstEpisode(show)
+	}
+	target.video = target.episode.Video()
+	return target, nil
+}
+
+func (uc *GetPlaybackInfoUseCase) resumePosition(ctx context.Context, profileID, videoID string) int {
+	if profileID == "" {
+		return 0
+	}
+	entry, err := uc.progressRepo.FindByVideoID(ctx, profileID, videoID)
+	if err != nil {
+		if !errors.Is(err, progress.ErrNotFound) {
+			uc.logger.Warn("Failed to load watch progress", "profileID", profileID, "videoID", videoID, "error", err)
+		}
+		return 0
+	}
+	if entry.IsCompleted() {
+		return 0
+	}
+	return entry.PositionSeconds()
+}
+
+func (uc *GetPlaybackInfoUseCase) latestProgress(ctx context.Context, profileID, contentID string) *progress.Progress {
+	if profileID == "" {
+		return nil
+	}
+	entry, err := uc.progressRepo.FindLatestByContentID(ctx, profileID, contentID)
+	if err != nil {
+		if !errors.Is(err, progress.ErrNotFound) {
+			uc.logger.Warn("Failed to load watch progress", "profileID", profileID, "contentID", contentID, "error", err)
+		}
+		return nil
+	}
+	return entry
+}
+
+func firstEpisode(show *tvshow.TvShow) *episode.Episode {
+	var first *episode.Episode
+	for _, ep := range show.Episodes() {
+		if first == nil || ep.Season() < first.Season() || (ep.Season() == first.Season() && ep.Number() < first.Number()) {
+			first = ep
+		}
+	}
+	return first
+}
+
+func newPlaybackEpisodeDTO(contentID string, ep *episode.Episode) *PlaybackEpisodeDTO {
+	return &PlaybackEpisodeDTO{
+		ID:          ep.ID(),
+		VideoID:     ep.Video().ID(),
+		Title:       ep.Title(),
+		Season:      ep.Season(),
+		Number:      ep.Number(),
+		PlaybackURL: "/contents/" + contentID + "/episodes/" + ep.ID() + "/playback",
+	}
+}
@@ -0,0 +1,129 @@
+package video
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/auth"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const playbackSessionParam = "session"
+
+type GetPlaylistInputDTO struct {
+	GetStreamInfoInputDTO
+}
+
+type GetPlaylistOutputDTO struct {
+	Playlist  []byte
+	SessionID string
+}
+
+type GetPlaylistUseCase struct {
+	packageRepo          video.PackageRepository
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	mediaService         media.MediaService
+	urlSigner            auth.URLSigner
+	logger               *log.Logger
+}
+
+func NewGetPlaylistUseCase(
+	packageRepo video.PackageRepository,
+	getStreamInfoUseCase *GetStreamInfoUseCase,
+	mediaService media.MediaService,
+	urlSigner auth.URLSigner,
+	logger *log.Logger,
+) *GetPlaylistUseCase {
+	return &GetPlaylistUseCase{
+		packageRepo:          packageRepo,
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		mediaService:         mediaService,
+		urlSigner:            urlSigner,
+		logger:               logger,
+	}
+}
+
+func (uc *GetPlaylistUseCase) Execute(ctx context.Context, input GetPlaylistInputDTO) (*GetPlaylistOutputDTO, error) {
+	hlsPackage, err := findPackage(ctx, uc.packageRepo, input.VideoID)
+	if err != nil {
+		return nil, err
+	}
+
+	streamInput := input.GetStreamInfoInputDTO
+	streamInput.FixedResolution = true
+	streamInfo, err := uc.getStreamInfoUseCase.Execute(ctx, streamInput)
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, err := uc.mediaService.GetStream(strings.TrimPrefix(hlsPackage.PlaylistURL(), "/"))
+	if err != nil {
+		return nil, fault.New(
+			"failed to open playlist",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	defer file.Close()
+
+	playlist, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fault.New(
+			"failed to read playlist",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	playlist, err = uc.signURIs(playlist, input.GetStreamInfoInputDTO, streamInfo)
+	if err != nil {
+		return nil, fault.New(
+			"failed to sign playlist",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &GetPlaylistOutputDTO{
+		Playlist:  playlist,
+		SessionID: streamInfo.SessionID,
+	}, nil
+}
+
+func (uc *GetPlaylistUseCase) signURIs(playlist []byte, input GetStreamInfoInputDTO, streamInfo *GetStreamInfoOutputDTO) ([]byte, error) {
+	claims := auth.Claims{
+		AccountID:   input.AccountID,
+		ProfileID:   input.ProfileID,
+		DeviceID:    input.DeviceID,
+		PINUnlocked: streamInfo.PINUnlocked,
+	}
+	var query string
+	if streamInfo.SessionID != "" {
+		query = "?" + url.Values{playbackSessionParam: {streamInfo.SessionID}}.Encode()
+	}
+
+	keyURI := hlsKeyURI(input.VideoID)
+	signedKeyURI, _, err := uc.urlSigner.Sign(keyURI+query, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(playlist), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "#EXT-X-KEY:"):
+			lines[i] = strings.Replace(line, `URI="`+keyURI+`"`, `URI="`+signedKeyURI+`"`, 1)
+		case line != "" && !strings.HasPrefix(line, "#"):
+			segmentURI := "/videos/" + input.VideoID + "/hls/" + line
+			if lines[i], _, err = uc.urlSigner.Sign(segmentURI+query, claims); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
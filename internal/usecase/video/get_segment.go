@@ -0,0 +1,86 @@
+package video
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/playback"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+)
+
+// segmentNamePattern matches the segment files written by packaging and
+// nothing else, keeping requests inside the package folder.
+var segmentNamePattern = regexp.MustCompile(`^segment_\d+\.ts$`)
+
+type GetSegmentInputDTO struct {
+	GetStreamInfoInputDTO
+	Segment string
+}
+
+func (req GetSegmentInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.AccountID, validation.Required.Error("accountID is required")),
+		validation.Field(&req.Segment,
+			validation.Required.Error("segment is required"),
+			validation.Match(segmentNamePattern).Error("segment is not a valid segment name"),
+		),
+	)
+}
+
+type GetSegmentOutputDTO struct {
+	FilePath  string
+	SessionID string
+}
+
+type GetSegmentUseCase struct {
+	packageRepo          video.PackageRepository
+	sessionRepo          playback.SessionRepository
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	timeout              time.Duration
+	logger               *log.Logger
+}
+
+func NewGetSegmentUseCase(
+	packageRepo video.PackageRepository,
+	sessionRepo playback.SessionRepository,
+	getStreamInfoUseCase *GetStreamInfoUseCase,
+	timeout time.Duration,
+	logger *log.Logger,
+) *GetSegmentUseCase {
+	return &GetSegmentUseCase{
+		packageRepo:          packageRepo,
+		sessionRepo:          sessionRepo,
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		timeout:              timeout,
+		logger:               logger,
+	}
+}
+
+func (uc *GetSegmentUseCase) Execute(ctx context.Context, input GetSegmentInputDTO) (*GetSegmentOutputDTO, error) {
+	if input.SessionID == "" {
+		if err := checkExtra(ctx, uc.getStreamInfoUseCase, input.GetStreamInfoInputDTO); err != nil {
+			uc.logger.Warn("Refused segment without a session", "videoID", input.VideoID, "accountID", input.AccountID)
+			return nil, err
+		}
+	} else if err := checkSession(ctx, uc.sessionRepo, uc.timeout, input.GetStreamInfoInputDTO); err != nil {
+		uc.logger.Warn("Refused segment", "videoID", input.VideoID, "accountID", input.AccountID, "sessionID", input.SessionID)
+		return nil, err
+	}
+
+	hlsPackage, err := findPackage(ctx, uc.packageRepo, input.VideoID)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := filepath.Dir(strings.TrimPrefix(hlsPackage.PlaylistURL(), "/"))
+	return &GetSegmentOutputDTO{
+		FilePath:  filepath.Join(folder, input.Segment),
+		SessionID: input.SessionID,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package video
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const renditionsFolder = "upload/renditions"
+
+type GetStreamFileUseCase struct {
+	getStreamInfoUseCase *GetStreamInfoUseCase
+	mediaService         media.MediaService
+	logger               *log.Logger
+}
+
+func NewGetStreamFileUseCase(getStreamInfoUseCase *GetStreamInfoUseCase, mediaService media.MediaService, logger *log.Logger) *GetStreamFileUseCase {
+	return &GetStreamFileUseCase{
+		getStreamInfoUseCase: getStreamInfoUseCase,
+		mediaService:         mediaService,
+		logger:               logger,
+	}
+}
+
+func (uc *GetStreamFileUseCase) Execute(ctx context.Context, input GetStreamInfoInputDTO) (*GetStreamInfoOutputDTO, error) {
+	streamInfo, err := uc.getStreamInfoUseCase.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if streamInfo.MaxHeight == 0 {
+		return streamInfo, nil
+	}
+
+	rendition, err := uc.mediaService.Rendition(streamInfo.FilePath, renditionsFolder, streamInfo.MaxHeight)
+	if err != nil {
+		uc.logger.Error("Failed to prepare stream rendition", "videoID", input.VideoID, "height", streamInfo.MaxHeight, "error", err)
+		return nil, fault.New(
+			"failed to prepare stream",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Debug("Streaming scaled down rendition", "videoID", input.VideoID, "height", streamInfo.MaxHeight)
+	streamInfo.FilePath = strings.TrimPrefix(rendition.URL, "/")
+	return streamInfo, nil
+}
@@ -2,16 +2,35 @@ package video
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/availability"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/extra"
+	"github.com/hoyci/fakeflix/internal/domain/profile"
 	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/internal/usecase/playback"
+	"github.com/hoyci/fakeflix/internal/usecase/subscription"
 	"github.com/hoyci/fakeflix/pkg/fault"
 )
 
 type GetStreamInfoInputDTO struct {
-	VideoID string
+	VideoID         string
+	AccountID       string
+	ProfileID       string
+	DeviceID        string
+	ProfilePIN      string
+	PINUnlocked     bool
+	Country         string
+	SessionID       string
+	UserAgent       string
+	Offline         bool
+	FixedResolution bool
 }
 
 func (req GetStreamInfoInputDTO) Validate() error {
@@ -21,25 +40,63 @@ func (req GetStreamInfoInputDTO) Validate() error {
 }
 
 type GetStreamInfoOutputDTO struct {
-	FilePath string
-	FileSize int
+	FilePath    string
+	FileSize    int
+	Duration    int
+	MaxHeight   int
+	Entitlement *subscription.EntitlementOutputDTO
+	SessionID   string
+	Markers     *MarkersOutputDTO
+	PINUnlocked bool
 }
 
 type GetStreamInfoUseCase struct {
-	videoRepo video.Repository
-	logger    *log.Logger
+	videoRepo               video.Repository
+	markerRepo              video.MarkerRepository
+	contentRepo             content.Repository
+	extraRepo               extra.Repository
+	profileRepo             profile.Repository
+	availabilityRepo        availability.Repository
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase
+	openSessionUseCase      *playback.OpenSessionUseCase
+	logger                  *log.Logger
 }
 
-func NewGetStreamInfoUseCase(videoRepo video.Repository, logger *log.Logger) *GetStreamInfoUseCase {
+func NewGetStreamInfoUseCase(
+	videoRepo video.Repository,
+	markerRepo video.MarkerRepository,
+	contentRepo content.Repository,
+	extraRepo extra.Repository,
+	profileRepo profile.Repository,
+	availabilityRepo availability.Repository,
+	checkEntitlementUseCase *subscription.CheckEntitlementUseCase,
+	openSessionUseCase *playback.OpenSessionUseCase,
+	logger *log.Logger,
+) *GetStreamInfoUseCase {
 	return &GetStreamInfoUseCase{
-		videoRepo: videoRepo,
-		logger:    logger,
+		videoRepo:               videoRepo,
+		markerRepo:              markerRepo,
+		contentRepo:             contentRepo,
+		extraRepo:               extraRepo,
+		profileRepo:             profileRepo,
+		availabilityRepo:        availabilityRepo,
+		checkEntitlementUseCase: checkEntitlementUseCase,
+		openSessionUseCase:      openSessionUseCase,
+		logger:                  logger,
 	}
 }
 
 func (uc *GetStreamInfoUseCase) Execute(ctx context.Context, input GetStreamInfoInputDTO) (*GetStreamInfoOutputDTO, error) {
 	uc.logger.Debug("Starting stream execution", "videoID", input.VideoID)
 
+	if input.AccountID == "" {
+		return nil, fault.New(
+			"authentication required",
+			fault.WithKind(fault.KindUnauthenticated),
+			fault.WithCode("authentication_required"),
+		)
+	}
+
 	videoEntity, err := uc.videoRepo.FindByID(ctx, input.VideoID)
 	if err != nil {
 		uc.logger.Error("Failed to find video by videoID", "videoID", input.VideoID, "error", err)
@@ -51,10 +108,188 @@ func (uc *GetStreamInfoUseCase) Execute(ctx context.Context, input GetStreamInfo
 	}
 	uc.logger.Debug("Video founded", "url", videoEntity.URL())
 
+	extraEntity, err := uc.extraRepo.FindByVideoID(ctx, input.VideoID)
+	if err != nil && !errors.Is(err, extra.ErrNotFound) {
+		return nil, fault.New(
+			"failed to load video extra",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	var contentEntity *content.Content
+	if extraEntity != nil {
+		contentEntity, err = uc.contentRepo.FindByID(ctx, extraEntity.ContentID())
+	} else {
+		contentEntity, err = uc.contentRepo.FindByVideoID(ctx, input.VideoID)
+	}
+	if err != nil && !errors.Is(err, content.ErrNotFound) {
+		return nil, fault.New(
+			"failed to load video content",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if contentEntity != nil && !contentEntity.IsPublished() {
+		uc.logger.Warn("Blocked stream of unpublished content", "videoID", input.VideoID, "contentID", contentEntity.ID())
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(fault.KindNotFound),
+		)
+	}
+
+	if contentEntity != nil {
+		if err := uc.checkAvailability(ctx, input, contentEntity.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	var entitlement *subscription.EntitlementOutputDTO
+	if extraEntity == nil {
+		if entitlement, err = uc.checkEntitlement(ctx, input, videoEntity); err != nil {
+			return nil, err
+		}
+	}
+
+	var pinUnlocked bool
+	if input.ProfileID != "" {
+		level := content.MaxMaturityLevel
+		if contentEntity != nil {
+			level = contentEntity.MaturityLevel()
+		}
+		if pinUnlocked, err = uc.checkMaturity(ctx, input, level); err != nil {
+			return nil, err
+		}
+	}
+
+	var maxHeight int
+	if entitlement != nil && !input.Offline && videoEntity.Height() > entitlement.MaxHeight {
+		maxHeight = entitlement.MaxHeight
+	}
+
+	// The session is opened last so that refused streams never take up
+	// one of the plan's concurrent streams.
+	var sessionID string
+	if entitlement != nil && !input.Offline {
+		session, err := uc.openSessionUseCase.Execute(ctx, playback.OpenSessionInputDTO{
+			SessionID:  input.SessionID,
+			AccountID:  input.AccountID,
+			ProfileID:  input.ProfileID,
+			DeviceID:   input.DeviceID,
+			VideoID:    input.VideoID,
+			UserAgent:  input.UserAgent,
+			MaxStreams: entitlement.MaxStreams,
+			MaxDevices: entitlement.MaxDevices,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sessionID = session.ID
+	}
+
+	// Markers only drive player prompts, so failing to load them does not
+	// stop the stream.
+	var markers *MarkersOutputDTO
+	if found, err := uc.markerRepo.FindByVideoID(ctx, input.VideoID); err == nil {
+		markers = newMarkersOutputDTO(found)
+	} else if !errors.Is(err, video.ErrMarkersNotFound) {
+		uc.logger.Warn("Failed to load video markers", "videoID", input.VideoID, "error", err)
+	}
+
 	filePath := strings.TrimPrefix(videoEntity.URL(), "/")
 
 	return &GetStreamInfoOutputDTO{
-		FilePath: filePath,
-		FileSize: videoEntity.SizeInKB(),
+		FilePath:    filePath,
+		FileSize:    videoEntity.SizeInKB(),
+		Duration:    videoEntity.Duration(),
+		MaxHeight:   maxHeight,
+		Entitlement: entitlement,
+		SessionID:   sessionID,
+		Markers:     markers,
+		PINUnlocked: pinUnlocked,
 	}, nil
 }
+
+func (uc *GetStreamInfoUseCase) checkEntitlement(ctx context.Context, input GetStreamInfoInputDTO, videoEntity *video.Video) (*subscription.EntitlementOutputDTO, error) {
+	entitlement, err := uc.checkEntitlementUseCase.Execute(ctx, subscription.CheckEntitlementInputDTO{AccountID: input.AccountID})
+	if err != nil {
+		return nil, err
+	}
+
+	// HLS packages come in the resolution of their video only, so one above
+	// the plan is refused; progressive streams are scaled down instead.
+	if input.FixedResolution && videoEntity.Height() > entitlement.MaxHeight {
+		uc.logger.Warn("Blocked stream above plan resolution", "videoID", input.VideoID, "accountID", input.AccountID, "height", videoEntity.Height(), "plan", entitlement.PlanCode)
+		return nil, fault.New(
+			"video resolution is not included in your plan",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+
+	return entitlement, nil
+}
+
+func (uc *GetStreamInfoUseCase) checkAvailability(ctx context.Context, input GetStreamInfoInputDTO, contentID string) error {
+	rule, err := uc.availabilityRepo.FindByContentID(ctx, contentID)
+	if errors.Is(err, availability.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fault.New(
+			"failed to load content availability",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	switch err := rule.Check(input.Country, time.Now()); {
+	case errors.Is(err, availability.ErrGeoBlocked):
+		uc.logger.Warn("Blocked stream outside licensed countries", "videoID", input.VideoID, "contentID", contentID, "country", input.Country)
+		return fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindGeoBlocked),
+			fault.WithError(err),
+		)
+	case err != nil:
+		return fault.New(
+			"video not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	return nil
+}
+
+func (uc *GetStreamInfoUseCase) checkMaturity(ctx context.Context, input GetStreamInfoInputDTO, level int) (bool, error) {
+	profileEntity, err := uc.profileRepo.FindByID(ctx, input.ProfileID)
+	if err != nil {
+		return false, fault.New(
+			"profile not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	if profileEntity.CanWatch(level, false) {
+		return false, nil
+	}
+	// Signed urls only carry the unlock once the PIN was checked, but it is
+	// still refused if the profile has since become a kids profile or lost
+	// its PIN.
+	if input.PINUnlocked && !profileEntity.IsKids() && profileEntity.HasPIN() {
+		return true, nil
+	}
+	unlocked, err := catalog.CheckPIN(ctx, uc.profileRepo, profileEntity, input.ProfilePIN)
+	if err != nil {
+		return false, err
+	}
+	if !profileEntity.CanWatch(level, unlocked) {
+		uc.logger.Warn("Blocked stream above profile maturity level", "videoID", input.VideoID, "profileID", input.ProfileID, "level", level)
+		return false, fault.New(
+			"content is above the profile maturity level",
+			fault.WithKind(fault.KindForbidden),
+		)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,54 @@
+package video
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+)
+
+type SpanDTO struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+func (s SpanDTO) Validate() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.Start, validation.Min(0)),
+		validation.Field(&s.End, validation.Required, validation.Min(s.Start+1)),
+	)
+}
+
+type MarkersOutputDTO struct {
+	VideoID      string    `json:"video_id"`
+	Intro        *SpanDTO  `json:"intro,omitempty"`
+	Recap        *SpanDTO  `json:"recap,omitempty"`
+	CreditsStart *int      `json:"credits_start,omitempty"`
+	Source       string    `json:"source"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func newMarkersOutputDTO(m *video.Markers) *MarkersOutputDTO {
+	return &MarkersOutputDTO{
+		VideoID:      m.VideoID(),
+		Intro:        toSpanDTO(m.Intro()),
+		Recap:        toSpanDTO(m.Recap()),
+		CreditsStart: m.CreditsStart(),
+		Source:       string(m.Source()),
+		UpdatedAt:    m.UpdatedAt(),
+	}
+}
+
+func toSpanDTO(span *video.Span) *SpanDTO {
+	if span == nil {
+		return nil
+	}
+	return &SpanDTO{Start: span.Start, End: span.End}
+}
+
+func toSpan(span *SpanDTO) *video.Span {
+	if span == nil {
+		return nil
+	}
+	return &video.Span{Start: span.Start, End: span.End}
+}
@@ -0,0 +1,161 @@
+package video
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/internal/infra/media"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+const hlsFolder = "upload/hls"
+
+type PackageVideoInputDTO struct {
+	VideoID string
+}
+
+func (req PackageVideoInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+	)
+}
+
+type PackageVideoOutputDTO struct {
+	VideoID     string    `json:"video_id"`
+	PlaylistURL string    `json:"playlist_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type PackageVideoUseCase struct {
+	videoRepo    video.Repository
+	packageRepo  video.PackageRepository
+	mediaService media.MediaService
+	logger       *log.Logger
+}
+
+func NewPackageVideoUseCase(videoRepo video.Repository, packageRepo video.PackageRepository, mediaService media.MediaService, logger *log.Logger) *PackageVideoUseCase {
+	return &PackageVideoUseCase{
+		videoRepo:    videoRepo,
+		packageRepo:  packageRepo,
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (uc *PackageVideoUseCase) Execute(ctx context.Context, input PackageVideoInputDTO) (*PackageVideoOutputDTO, error) {
+	videoEntity, err := uc.videoRepo.FindByID(ctx, input.VideoID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, video.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	key, iv, err := video.GenerateContentKey()
+	if err != nil {
+		return nil, fault.New(
+			"failed to generate content key",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	previous, err := uc.packageRepo.FindByVideoID(ctx, videoEntity.ID())
+	if err != nil && !errors.Is(err, video.ErrPackageNotFound) {
+		return nil, fault.New(
+			"failed to load video package",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	stage, err := uc.mediaService.PackageHLS(
+		strings.TrimPrefix(videoEntity.URL(), "/"),
+		filepath.Join(hlsFolder, videoEntity.ID()),
+		media.HLSKey{URI: hlsKeyURI(videoEntity.ID()), Key: key, IV: iv},
+	)
+	if err != nil {
+		uc.logger.Error("Failed to package video for hls", "videoID", input.VideoID, "error", err)
+		return nil, fault.New(
+			"failed to package video",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	hlsPackage, err := video.NewHLSPackage(videoEntity.ID(), stage.PlaylistURL, key, iv)
+	if err != nil {
+		stage.Discard()
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.packageRepo.Save(ctx, hlsPackage); err != nil {
+		stage.Discard()
+		return nil, fault.New(
+			"failed to save video package",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if err := stage.Publish(); err != nil {
+		uc.logger.Error("Failed to publish hls package", "videoID", input.VideoID, "error", err)
+		stage.Discard()
+		// The previous segments are still in place, so their key must be too.
+		if previous != nil {
+			if err := uc.packageRepo.Save(ctx, previous); err != nil {
+				uc.logger.Error("Failed to restore previous video package", "videoID", input.VideoID, "error", err)
+			}
+		}
+		return nil, fault.New(
+			"failed to package video",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Video packaged for hls", "videoID", input.VideoID, "playlist", stage.PlaylistURL)
+	return &PackageVideoOutputDTO{
+		VideoID:     hlsPackage.VideoID(),
+		PlaylistURL: hlsPackage.PlaylistURL(),
+		CreatedAt:   hlsPackage.CreatedAt(),
+	}, nil
+}
+
+func hlsKeyURI(videoID string) string {
+	return "/videos/" + videoID + "/hls/key"
+}
+
+func findPackage(ctx context.Context, packageRepo video.PackageRepository, videoID string) (*video.HLSPackage, error) {
+	hlsPackage, err := packageRepo.FindByVideoID(ctx, videoID)
+	if errors.Is(err, video.ErrPackageNotFound) {
+		return nil, fault.New(
+			"video is not available over hls",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+	if err != nil {
+		return nil, fault.New(
+			"failed to load video package",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	return hlsPackage, nil
+}
@@ -0,0 +1,82 @@
+package video
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/video"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type SetMarkersInputDTO struct {
+	VideoID      string   `json:"-"`
+	Intro        *SpanDTO `json:"intro"`
+	Recap        *SpanDTO `json:"recap"`
+	CreditsStart *int     `json:"credits_start"`
+}
+
+func (req SetMarkersInputDTO) Validate() error {
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.VideoID, validation.Required.Error("videoID is required")),
+		validation.Field(&req.Intro),
+		validation.Field(&req.Recap),
+		validation.Field(&req.CreditsStart, validation.Min(0)),
+	); err != nil {
+		return err
+	}
+	if req.Intro == nil && req.Recap == nil && req.CreditsStart == nil {
+		return errors.New("at least one marker is required")
+	}
+	return nil
+}
+
+type SetMarkersUseCase struct {
+	videoRepo  video.Repository
+	markerRepo video.MarkerRepository
+	logger     *log.Logger
+}
+
+func NewSetMarkersUseCase(videoRepo video.Repository, markerRepo video.MarkerRepository, logger *log.Logger) *SetMarkersUseCase {
+	return &SetMarkersUseCase{
+		videoRepo:  videoRepo,
+		markerRepo: markerRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *SetMarkersUseCase) Execute(ctx context.Context, input SetMarkersInputDTO) (*MarkersOutputDTO, error) {
+	videoEntity, err := uc.videoRepo.FindByID(ctx, input.VideoID)
+	if err != nil {
+		kind := fault.KindUnexpected
+		if errors.Is(err, video.ErrNotFound) {
+			kind = fault.KindNotFound
+		}
+		return nil, fault.New(
+			"video not found",
+			fault.WithKind(kind),
+			fault.WithError(err),
+		)
+	}
+
+	markers, err := video.NewMarkers(videoEntity.ID(), videoEntity.Duration(), toSpan(input.Intro), toSpan(input.Recap), input.CreditsStart, video.ManualSource)
+	if err != nil {
+		return nil, fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.markerRepo.Save(ctx, markers); err != nil {
+		return nil, fault.New(
+			"failed to save video markers",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	uc.logger.Info("Video markers set", "videoID", input.VideoID)
+	return newMarkersOutputDTO(markers), nil
+}
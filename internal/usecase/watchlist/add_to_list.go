@@ -0,0 +1,80 @@
+package watchlist
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type AddToListInputDTO struct {
+	ProfileID string
+	ContentID string
+	Position  *int `json:"position"`
+}
+
+func (req AddToListInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+		validation.Field(&req.Position, validation.NilOrNotEmpty, validation.Min(1)),
+	)
+}
+
+type AddToListUseCase struct {
+	watchlistRepo watchlist.Repository
+	contentRepo   content.Repository
+	logger        *log.Logger
+}
+
+func NewAddToListUseCase(watchlistRepo watchlist.Repository, contentRepo content.Repository, logger *log.Logger) *AddToListUseCase {
+	return &AddToListUseCase{
+		watchlistRepo: watchlistRepo,
+		contentRepo:   contentRepo,
+		logger:        logger,
+	}
+}
+
+func (uc *AddToListUseCase) Execute(ctx context.Context, input AddToListInputDTO) error {
+	if _, err := uc.contentRepo.FindByID(ctx, input.ContentID); err != nil {
+		return fault.New(
+			"content not found",
+			fault.WithKind(fault.KindNotFound),
+			fault.WithError(err),
+		)
+	}
+
+	entry, err := watchlist.NewEntry(input.ProfileID, input.ContentID)
+	if err != nil {
+		return fault.New(
+			err.Error(),
+			fault.WithKind(fault.KindValidation),
+			fault.WithError(err),
+		)
+	}
+
+	if err := uc.watchlistRepo.Add(ctx, entry); err != nil {
+		uc.logger.Error("Failed to add content to list", "profileID", input.ProfileID, "contentID", input.ContentID, "error", err)
+		return fault.New(
+			"failed to add content to list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	if input.Position != nil {
+		if err := uc.watchlistRepo.Move(ctx, input.ProfileID, input.ContentID, *input.Position); err != nil {
+			uc.logger.Error("Failed to move content on list", "profileID", input.ProfileID, "contentID", input.ContentID, "error", err)
+			return fault.New(
+				"failed to move content on list",
+				fault.WithKind(fault.KindUnexpected),
+				fault.WithError(err),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package watchlist
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/content"
+	"github.com/hoyci/fakeflix/internal/domain/localization"
+	"github.com/hoyci/fakeflix/internal/domain/rating"
+	"github.com/hoyci/fakeflix/internal/domain/watchlist"
+	"github.com/hoyci/fakeflix/internal/usecase/catalog"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type ListMyListInputDTO struct {
+	ProfileID string
+	Locales   []string
+	Page      int
+	PageSize  int
+}
+
+func (req ListMyListInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.Page, validation.Min(1)),
+		validation.Field(&req.PageSize, validation.Min(1), validation.Max(100)),
+	)
+}
+
+type ListItemDTO struct {
+	Content  catalog.ContentOutputDTO `json:"content"`
+	Position int                      `json:"position"`
+	AddedAt  string                   `json:"added_at"`
+}
+
+type ListMyListOutputDTO struct {
+	Items    []ListItemDTO `json:"items"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"page_size"`
+	Total    int           `json:"total"`
+}
+
+type ListMyListUseCase struct {
+	watchlistRepo   watchlist.Repository
+	contentRepo     content.Repository
+	ratingRepo      rating.Repository
+	translationRepo localization.Repository
+	logger          *log.Logger
+}
+
+func NewListMyListUseCase(
+	watchlistRepo watchlist.Repository,
+	contentRepo content.Repository,
+	ratingRepo rating.Repository,
+	translationRepo localization.Repository,
+	logger *log.Logger,
+) *ListMyListUseCase {
+	return &ListMyListUseCase{
+		watchlistRepo:   watchlistRepo,
+		contentRepo:     contentRepo,
+		ratingRepo:      ratingRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *ListMyListUseCase) Execute(ctx context.Context, input ListMyListInputDTO) (*ListMyListOutputDTO, error) {
+	offset := (input.Page - 1) * input.PageSize
+	entries, total, err := uc.watchlistRepo.List(ctx, input.ProfileID, offset, input.PageSize)
+	if err != nil {
+		uc.logger.Error("Failed to list watchlist", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list my list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	contentIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		contentIDs = append(contentIDs, entry.ContentID())
+	}
+	contents, err := uc.contentRepo.FindByIDs(ctx, contentIDs)
+	if err != nil {
+		uc.logger.Error("Failed to load listed contents", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list my list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	contentsByID := make(map[string]*content.Content, len(contents))
+	for _, contentEntity := range contents {
+		contentsByID[contentEntity.ID()] = contentEntity
+	}
+
+	items := make([]ListItemDTO, 0, len(entries))
+	for i, entry := range entries {
+		contentEntity, ok := contentsByID[entry.ContentID()]
+		if !ok {
+			continue
+		}
+		items = append(items, ListItemDTO{
+			Content:  catalog.NewContentOutputDTO(contentEntity),
+			Position: offset + i + 1,
+			AddedAt:  entry.AddedAt().String(),
+		})
+	}
+
+	refs := make([]*catalog.ContentOutputDTO, len(items))
+	for i := range items {
+		refs[i] = &items[i].Content
+	}
+	if err := catalog.AttachRatings(ctx, uc.ratingRepo, input.ProfileID, refs); err != nil {
+		uc.logger.Error("Failed to load content ratings", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list my list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+	if err := catalog.Localize(ctx, uc.translationRepo, input.Locales, refs); err != nil {
+		uc.logger.Error("Failed to load content translations", "profileID", input.ProfileID, "error", err)
+		return nil, fault.New(
+			"failed to list my list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return &ListMyListOutputDTO{
+		Items:    items,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+		Total:    total,
+	}, nil
+}
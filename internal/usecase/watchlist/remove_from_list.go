@@ -0,0 +1,47 @@
+package watchlist
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hoyci/fakeflix/internal/domain/watchlist"
+	"github.com/hoyci/fakeflix/pkg/fault"
+)
+
+type RemoveFromListInputDTO struct {
+	ProfileID string
+	ContentID string
+}
+
+func (req RemoveFromListInputDTO) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ProfileID, validation.Required.Error("profileID is required")),
+		validation.Field(&req.ContentID, validation.Required.Error("contentID is required")),
+	)
+}
+
+type RemoveFromListUseCase struct {
+	watchlistRepo watchlist.Repository
+	logger        *log.Logger
+}
+
+func NewRemoveFromListUseCase(watchlistRepo watchlist.Repository, logger *log.Logger) *RemoveFromListUseCase {
+	return &RemoveFromListUseCase{
+		watchlistRepo: watchlistRepo,
+		logger:        logger,
+	}
+}
+
+func (uc *RemoveFromListUseCase) Execute(ctx context.Context, input RemoveFromListInputDTO) error {
+	if err := uc.watchlistRepo.Remove(ctx, input.ProfileID, input.ContentID); err != nil {
+		uc.logger.Error("Failed to remove content from list", "profileID", input.ProfileID, "contentID", input.ContentID, "error", err)
+		return fault.New(
+			"failed to remove content from list",
+			fault.WithKind(fault.KindUnexpected),
+			fault.WithError(err),
+		)
+	}
+
+	return nil
+}
@@ -5,15 +5,32 @@
 package fault
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
+	"strings"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+// Error carries what an RFC 9457 problem document is built from. Fields
+// left empty are filled in by the HTTP layer: Status, Code, Type and Title
+// from the Kind, Instance and RequestID from the request being served.
 type Error struct {
 	Message string
-	Code    int
 	Kind    string
-	Err     error
+	// Status overrides the HTTP status the Kind maps to.
+	Status int
+	// Code is a stable, machine readable identifier clients can branch on.
+	Code string
+	// Type is a URI identifying the problem type.
+	Type      string
+	Title     string
+	Instance  string
+	RequestID string
+	// Fields maps request fields to what is wrong with them.
+	Fields map[string]string
+	Err    error
 }
 
 func (e *Error) Error() string {
@@ -32,7 +49,6 @@ type Option func(*Error)
 func New(message string, options ...Option) *Error {
 	err := &Error{
 		Message: message,
-		Code:    http.StatusInternalServerError,
 	}
 	for _, opt := range options {
 		opt(err)
@@ -41,11 +57,79 @@ func New(message string, options ...Option) *Error {
 }
 
 func WithHTTPCode(code int) Option {
+	return func(e *Error) {
+		e.Status = code
+	}
+}
+
+func WithCode(code string) Option {
 	return func(e *Error) {
 		e.Code = code
 	}
 }
 
+func WithType(uri string) Option {
+	return func(e *Error) {
+		e.Type = uri
+	}
+}
+
+func WithTitle(title string) Option {
+	return func(e *Error) {
+		e.Title = title
+	}
+}
+
+func WithInstance(instance string) Option {
+	return func(e *Error) {
+		e.Instance = instance
+	}
+}
+
+func WithRequestID(requestID string) Option {
+	return func(e *Error) {
+		e.RequestID = requestID
+	}
+}
+
+// WithFieldErrors wraps the error returned by a DTO's Validate. Ozzo
+// validation errors are split into Fields, keyed by json field name with
+// nested fields joined by dots, and the message becomes a summary instead
+// of their flattened text. Untagged fields, such as form values and path
+// params, are keyed by their snake_cased Go name.
+func WithFieldErrors(err error) Option {
+	return func(e *Error) {
+		e.Err = err
+
+		var errs validation.Errors
+		if !errors.As(err, &errs) {
+			return
+		}
+		e.Fields = map[string]string{}
+		collectFieldErrors(e.Fields, "", errs)
+		e.Message = "the request has invalid fields"
+	}
+}
+
+func collectFieldErrors(fields map[string]string, prefix string, errs validation.Errors) {
+	for name, err := range errs {
+		if err == nil {
+			continue
+		}
+		key := snakeCase(name)
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		var nested validation.Errors
+		if errors.As(err, &nested) {
+			collectFieldErrors(fields, key, nested)
+			continue
+		}
+		fields[key] = err.Error()
+	}
+}
+
 func WithKind(kind string) Option {
 	return func(e *Error) {
 		e.Kind = kind
@@ -58,6 +142,25 @@ func WithError(err error) Option {
 	}
 }
 
+// snakeCase turns Go field names such as VideoID into video_id. Names that
+// already are json field names come back unchanged.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && !unicode.IsUpper(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (prevLower || nextLower) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 const (
 	KindNotFound        = "NotFound"
 	KindValidation      = "Validation"
@@ -65,4 +168,7 @@ const (
 	KindConflict        = "Conflict"
 	KindUnauthenticated = "Unauthenticated"
 	KindForbidden       = "Forbidden"
+	KindGeoBlocked      = "GeoBlocked"
+	KindPaymentRequired = "PaymentRequired"
+	KindLimitExceeded   = "LimitExceeded"
 )
@@ -3,6 +3,7 @@
 package httputils
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -10,17 +11,102 @@ import (
 	"github.com/hoyci/fakeflix/pkg/fault"
 )
 
-func RespondWithError(w http.ResponseWriter, err error) {
+const (
+	ProblemContentType = "application/problem+json"
+
+	// ProblemTypePrefix prefixes the code of a problem when it has no type
+	// URI of its own.
+	ProblemTypePrefix = "urn:fakeflix:problem:"
+
+	// RequestIDHeader carries the id of a request, both as sent by the
+	// client and as answered by the API.
+	RequestIDHeader = "X-Request-ID"
+)
+
+// Problem is an RFC 9457 problem document extended with a stable error
+// code, the id of the failed request and per-field validation errors.
+type Problem struct {
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail,omitempty"`
+	Instance  string            `json:"instance,omitempty"`
+	Code      string            `json:"code"`
+	RequestID string            `json:"request_id,omitempty"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+type contextKey string
+
+const requestIDContextKey contextKey = "request_id"
+
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey).(string)
+	return requestID
+}
+
+// RespondWithError answers with the problem document of err. Errors that
+// are not faults are reported as unexpected without exposing their text.
+func RespondWithError(w http.ResponseWriter, r *http.Request, err error) {
 	var f *fault.Error
-	if errors.As(err, &f) {
-		statusCode := mapKindToStatusCode(f.Kind)
-		RespondWithJSON(w, statusCode, map[string]string{"error": f.Message})
+	if !errors.As(err, &f) {
+		f = fault.New("an unexpected error occurred", fault.WithKind(fault.KindUnexpected))
+	}
+	RespondWithProblem(w, NewProblem(r, f))
+}
+
+// NewProblem fills the parts of the problem f leaves empty from its kind
+// and from the request it occurred in.
+func NewProblem(r *http.Request, f *fault.Error) Problem {
+	problem := Problem{
+		Type:      f.Type,
+		Title:     f.Title,
+		Status:    f.Status,
+		Detail:    f.Message,
+		Instance:  f.Instance,
+		Code:      f.Code,
+		RequestID: f.RequestID,
+		Errors:    f.Fields,
+	}
+	if problem.Status == 0 {
+		problem.Status = StatusCodeForKind(f.Kind)
+	}
+	if problem.Code == "" {
+		problem.Code = CodeForKind(f.Kind)
+	}
+	if problem.Type == "" {
+		problem.Type = ProblemTypePrefix + problem.Code
+	}
+	if problem.Title == "" {
+		problem.Title = http.StatusText(problem.Status)
+	}
+	if problem.Instance == "" && r != nil {
+		problem.Instance = r.URL.Path
+	}
+	if problem.RequestID == "" && r != nil {
+		problem.RequestID = RequestIDFromContext(r.Context())
+	}
+	return problem
+}
+
+func RespondWithProblem(w http.ResponseWriter, problem Problem) {
+	response, err := json.Marshal(problem)
+	if err != nil {
+		respondWithMarshalError(w)
 		return
 	}
-	RespondWithJSON(w, http.StatusInternalServerError, map[string]string{"error": "an unexpected error occurred"})
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	w.Write(response)
 }
 
-func mapKindToStatusCode(kind string) int {
+// StatusCodeForKind returns the HTTP status errors of a fault kind are
+// answered with.
+func StatusCodeForKind(kind string) int {
 	switch kind {
 	case fault.KindNotFound:
 		return http.StatusNotFound
@@ -32,20 +118,54 @@ func mapKindToStatusCode(kind string) int {
 		return http.StatusUnauthorized
 	case fault.KindForbidden:
 		return http.StatusForbidden
+	case fault.KindGeoBlocked:
+		return http.StatusUnavailableForLegalReasons
+	case fault.KindPaymentRequired:
+		return http.StatusPaymentRequired
+	case fault.KindLimitExceeded:
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
 }
 
+// CodeForKind returns the error code of faults that do not set their own.
+func CodeForKind(kind string) string {
+	switch kind {
+	case fault.KindNotFound:
+		return "not_found"
+	case fault.KindValidation:
+		return "validation_failed"
+	case fault.KindConflict:
+		return "conflict"
+	case fault.KindUnauthenticated:
+		return "unauthenticated"
+	case fault.KindForbidden:
+		return "forbidden"
+	case fault.KindGeoBlocked:
+		return "geo_blocked"
+	case fault.KindPaymentRequired:
+		return "payment_required"
+	case fault.KindLimitExceeded:
+		return "limit_exceeded"
+	default:
+		return "unexpected_error"
+	}
+}
+
 func RespondWithJSON(w http.ResponseWriter, code int, payload any) {
 	response, err := json.Marshal(payload)
 	if err != nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(`{"error": "Error marshaling JSON response"}`))
+		respondWithMarshalError(w)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
 	w.Write(response)
 }
+
+func respondWithMarshalError(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write([]byte(`{"type":"` + ProblemTypePrefix + `unexpected_error","title":"Internal Server Error","status":500,"detail":"Error marshaling JSON response","code":"unexpected_error"}`))
+}